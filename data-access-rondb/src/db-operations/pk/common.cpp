@@ -18,6 +18,7 @@
  */
 
 #include "src/db-operations/pk/common.hpp"
+#include <vector>
 #include <boost/date_time/posix_time/posix_time.hpp>
 #include <boost/beast/core/detail/base64.hpp>
 #include "src/error-strs.h"
@@ -28,33 +29,154 @@
 #include "src/mystring.hpp"
 #include "src/rdrs-const.h"
 
-RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *operation,
-                            PKRRequest *request, Uint32 colIdx) {
+/**
+ * How the value of a column is used by an operation. Primary key columns are set using
+ * NdbOperation::equal(), other columns using NdbOperation::setValue(), index scan bounds
+ * using NdbIndexScanOperation::setBound() and scan filter conditions using NdbScanFilter::cmp()
+ */
+enum ColRole { PK_COL, VALUE_COL, LOWER_BOUND_COL, UPPER_BOUND_COL, FILTER_COL };
+
+struct ColTarget {
+  ColRole role;
+  NdbOperation *operation;  // pk, value and bound columns
+  NdbScanFilter *filter;    // filter columns
+  int type;                 // NdbIndexScanOperation::BoundType or NdbScanFilter::BinaryCondition
+  int colId;
+};
+
+/**
+ * bounds and filter conditions take the value by reference
+ */
+static int SetColValueRef(const ColTarget *target, const char *colName, const void *value,
+                          Uint32 len) {
+  if (target->role == FILTER_COL) {
+    return target->filter->cmp(static_cast<NdbScanFilter::BinaryCondition>(target->type),
+                               target->colId, value, len);
+  }
+  return static_cast<NdbIndexScanOperation *>(target->operation)
+      ->setBound(colName, target->type, value);
+}
+
+template <typename T>
+static int SetColValue(const ColTarget *target, const char *colName, T value) {
+  switch (target->role) {
+  case PK_COL:
+    return target->operation->equal(colName, value);
+  case VALUE_COL:
+    return target->operation->setValue(colName, value);
+  default:
+    return SetColValueRef(target, colName, &value, sizeof(value));
+  }
+}
+
+static int SetColValue(const ColTarget *target, const char *colName, const char *value,
+                       Uint32 len) {
+  switch (target->role) {
+  case PK_COL:
+    return target->operation->equal(colName, value, len);
+  case VALUE_COL:
+    return target->operation->setValue(colName, value, len);
+  default:
+    return SetColValueRef(target, colName, value, len);
+  }
+}
+
+static const char *ColName(PKRRequest *request, ColRole role, Uint32 colIdx) {
+  switch (role) {
+  case PK_COL:
+    return request->PKName(colIdx);
+  case VALUE_COL:
+    return request->ValueName(colIdx);
+  case FILTER_COL:
+    return request->FilterColName(colIdx);
+  default:
+    return request->BoundName(role == LOWER_BOUND_COL, colIdx);
+  }
+}
+
+static const char *ColValueCStr(PKRRequest *request, ColRole role, Uint32 colIdx) {
+  switch (role) {
+  case PK_COL:
+    return request->PKValueCStr(colIdx);
+  case VALUE_COL:
+    return request->ValueCStr(colIdx);
+  case FILTER_COL:
+    return request->FilterValueCStr(colIdx);
+  default:
+    return request->BoundValueCStr(role == LOWER_BOUND_COL, colIdx);
+  }
+}
+
+static Uint16 ColValueLen(PKRRequest *request, ColRole role, Uint32 colIdx) {
+  switch (role) {
+  case PK_COL:
+    return request->PKValueLen(colIdx);
+  case VALUE_COL:
+    return request->ValueLen(colIdx);
+  case FILTER_COL:
+    return request->FilterValueLen(colIdx);
+  default:
+    return request->BoundValueLen(role == LOWER_BOUND_COL, colIdx);
+  }
+}
+
+static int ColValueNDBStr(PKRRequest *request, ColRole role, Uint32 colIdx,
+                          const NdbDictionary::Column *col, char **data) {
+  switch (role) {
+  case PK_COL:
+    return request->PKValueNDBStr(colIdx, col, data);
+  case VALUE_COL:
+    return request->ValueNDBStr(colIdx, col, data);
+  case FILTER_COL:
+    return request->FilterValueNDBStr(colIdx, col, data);
+  default:
+    return request->BoundValueNDBStr(role == LOWER_BOUND_COL, colIdx, col, data);
+  }
+}
+
+static const char *SetColError(ColRole role) {
+  switch (role) {
+  case PK_COL:
+    return ERROR_023;
+  case VALUE_COL:
+    return ERROR_033;
+  case FILTER_COL:
+    return ERROR_031;
+  default:
+    return ERROR_043;
+  }
+}
+
+static RS_Status SetOperationCol(const NdbDictionary::Column *col, const ColTarget *target,
+                                 PKRRequest *request, Uint32 colIdx) {
   // validate the data and set data according to column type
-  char *data;
+  const ColRole role    = target->role;
+  const char *colName   = ColName(request, role, colIdx);
+  const char *valueCStr = ColValueCStr(request, role, colIdx);
+  const Uint16 valueLen = ColValueLen(request, role, colIdx);
+  const char *setErr    = SetColError(role);
 
   switch (col->getType()) {
   case NdbDictionary::Column::Undefined: {
     ///< 4 bytes + 0-3 fraction
-    return RS_CLIENT_ERROR(ERROR_018 + std::string(" Column: ") +
-                           std::string(request->PKName(colIdx)));
+    return RS_CLIENT_ERROR(ERROR_018, std::string(" Column: ") + std::string(colName));
   }
   case NdbDictionary::Column::Tinyint: {
     ///< 8 bit. 1 byte signed integer, can be used in array
     bool success = false;
     try {
-      int num = std::stoi(request->PKValueCStr(colIdx));
+      int num = std::stoi(valueCStr);
       if (num >= -128 && num <= 127) {
-        if (operation->equal(request->PKName(colIdx), static_cast<char>(num)) != 0) {
-          return RS_SERVER_ERROR(ERROR_023);
+        if (SetColValue(target, colName, static_cast<char>(num)) != 0) {
+          return RS_SERVER_ERROR(setErr);
         }
         success = true;
       }
     } catch (...) {
     }
     if (!success) {
-      return RS_CLIENT_ERROR(ERROR_015 + std::string(" Expecting TINYINT. Column: ") +
-                             std::string(request->PKName(colIdx)));
+      return RS_CLIENT_ERROR(ERROR_015, std::string(" Expecting TINYINT. Column: ") +
+                                        std::string(colName));
     } else {
       return RS_OK;
     }
@@ -63,18 +185,18 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     ///< 8 bit. 1 byte unsigned integer, can be used in array
     bool success = false;
     try {
-      int num = std::stoi(request->PKValueCStr(colIdx));
+      int num = std::stoi(valueCStr);
       if (num >= 0 && num <= 255) {
-        if (operation->equal(request->PKName(colIdx), static_cast<char>(num)) != 0) {
-          return RS_SERVER_ERROR(ERROR_023);
+        if (SetColValue(target, colName, static_cast<char>(num)) != 0) {
+          return RS_SERVER_ERROR(setErr);
         }
         success = true;
       }
     } catch (...) {
     }
     if (!success) {
-      return RS_CLIENT_ERROR(ERROR_015 + std::string(" Expecting TINYINT. Column: ") +
-                             std::string(request->PKName(colIdx)));
+      return RS_CLIENT_ERROR(ERROR_015, std::string(" Expecting TINYINT. Column: ") +
+                                        std::string(colName));
     } else {
       return RS_OK;
     }
@@ -83,18 +205,18 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     ///< 16 bit. 2 byte signed integer, can be used in array
     bool success = false;
     try {
-      int num = std::stoi(request->PKValueCStr(colIdx));
+      int num = std::stoi(valueCStr);
       if (num >= -32768 && num <= 32767) {
-        if (operation->equal(request->PKName(colIdx), (Int16)num) != 0) {
-          return RS_SERVER_ERROR(ERROR_023);
+        if (SetColValue(target, colName, (Int16)num) != 0) {
+          return RS_SERVER_ERROR(setErr);
         }
         success = true;
       }
     } catch (...) {
     }
     if (!success) {
-      return RS_CLIENT_ERROR(ERROR_015 + std::string(" Expecting SMALLINT. Column: ") +
-                             std::string(request->PKName(colIdx)));
+      return RS_CLIENT_ERROR(ERROR_015, std::string(" Expecting SMALLINT. Column: ") +
+                                        std::string(colName));
     } else {
       return RS_OK;
     }
@@ -103,18 +225,18 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     ///< 16 bit. 2 byte unsigned integer, can be used in array
     bool success = false;
     try {
-      int num = std::stoi(request->PKValueCStr(colIdx));
+      int num = std::stoi(valueCStr);
       if (num >= 0 && num <= 65535) {
-        if (operation->equal(request->PKName(colIdx), (Uint16)num) != 0) {
-          return RS_SERVER_ERROR(ERROR_023);
+        if (SetColValue(target, colName, (Uint16)num) != 0) {
+          return RS_SERVER_ERROR(setErr);
         }
         success = true;
       }
     } catch (...) {
     }
     if (!success) {
-      return RS_CLIENT_ERROR(ERROR_015 + std::string(" Expecting TINYINT UNSIGNED. Column: ") +
-                             std::string(request->PKName(colIdx)));
+      return RS_CLIENT_ERROR(ERROR_015, std::string(" Expecting TINYINT UNSIGNED. Column: ") +
+                                        std::string(colName));
     } else {
       return RS_OK;
     }
@@ -123,18 +245,18 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     ///< 24 bit. 3 byte signed integer, can be used in array
     bool success = false;
     try {
-      int num = std::stoi(request->PKValueCStr(colIdx));
+      int num = std::stoi(valueCStr);
       if (num >= -8388608 && num <= 8388607) {
-        if (operation->equal(request->PKName(colIdx), static_cast<int>(num)) != 0) {
-          return RS_SERVER_ERROR(ERROR_023);
+        if (SetColValue(target, colName, static_cast<int>(num)) != 0) {
+          return RS_SERVER_ERROR(setErr);
         }
         success = true;
       }
     } catch (...) {
     }
     if (!success) {
-      return RS_CLIENT_ERROR(ERROR_015 + std::string(" Expecting MEDIUMINT. Column: ") +
-                             std::string(request->PKName(colIdx)));
+      return RS_CLIENT_ERROR(ERROR_015, std::string(" Expecting MEDIUMINT. Column: ") +
+                                        std::string(colName));
     } else {
       return RS_OK;
     }
@@ -143,18 +265,18 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     ///< 24 bit. 3 byte unsigned integer, can be used in array
     bool success = false;
     try {
-      int num = std::stoi(request->PKValueCStr(colIdx));
+      int num = std::stoi(valueCStr);
       if (num >= 0 && num <= 16777215) {
-        if (operation->equal(request->PKName(colIdx), (unsigned int)num)) {
-          return RS_SERVER_ERROR(ERROR_023);
+        if (SetColValue(target, colName, (unsigned int)num)) {
+          return RS_SERVER_ERROR(setErr);
         }
         success = true;
       }
     } catch (...) {
     }
     if (!success) {
-      return RS_CLIENT_ERROR(ERROR_015 + std::string(" Expecting MEDIUMINT UNSIGNED. Column: ") +
-                             std::string(request->PKName(colIdx)));
+      return RS_CLIENT_ERROR(ERROR_015, std::string(" Expecting MEDIUMINT UNSIGNED. Column: ") +
+                                        std::string(colName));
     } else {
       return RS_OK;
     }
@@ -162,13 +284,13 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
   case NdbDictionary::Column::Int: {
     ///< 32 bit. 4 byte signed integer, can be used in array
     try {
-      Int32 num = std::stoi(request->PKValueCStr(colIdx));
-      if (operation->equal(request->PKName(colIdx), num) != 0) {
-        return RS_SERVER_ERROR(ERROR_023);
+      Int32 num = std::stoi(valueCStr);
+      if (SetColValue(target, colName, num) != 0) {
+        return RS_SERVER_ERROR(setErr);
       }
     } catch (...) {
-      return RS_CLIENT_ERROR(ERROR_015 + std::string(" Expecting Int. Column: ") +
-                             std::string(request->PKName(colIdx)));
+      return RS_CLIENT_ERROR(ERROR_015, std::string(" Expecting Int. Column: ") +
+                                        std::string(colName));
     }
     return RS_OK;
   }
@@ -176,11 +298,11 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     ///< 32 bit. 4 byte unsigned integer, can be used in array
     bool success = false;
     try {
-      Int64 lresult = std::stoll(request->PKValueCStr(colIdx));
+      Int64 lresult = std::stoll(valueCStr);
       Uint32 result = lresult;
       if (result == lresult) {
-        if (operation->equal(request->PKName(colIdx), result) != 0) {
-          return RS_SERVER_ERROR(ERROR_023);
+        if (SetColValue(target, colName, result) != 0) {
+          return RS_SERVER_ERROR(setErr);
         }
         success = true;
       }
@@ -188,8 +310,8 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     }
 
     if (!success) {
-      return RS_CLIENT_ERROR(ERROR_015 + std::string(" Expecting Unsigned Int. Column: ") +
-                             std::string(request->PKName(colIdx)));
+      return RS_CLIENT_ERROR(ERROR_015, std::string(" Expecting Unsigned Int. Column: ") +
+                                        std::string(colName));
     } else {
       return RS_OK;
     }
@@ -197,13 +319,13 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
   case NdbDictionary::Column::Bigint: {
     ///< 64 bit. 8 byte signed integer, can be used in array
     try {
-      Int64 num = std::stoll(request->PKValueCStr(colIdx));
-      if (operation->equal(request->PKName(colIdx), num) != 0) {
-        return RS_SERVER_ERROR(ERROR_023);
+      Int64 num = std::stoll(valueCStr);
+      if (SetColValue(target, colName, num) != 0) {
+        return RS_SERVER_ERROR(setErr);
       }
     } catch (...) {
-      return RS_CLIENT_ERROR(ERROR_015 + std::string(" Expecting BIGINT. Column: ") +
-                             std::string(request->PKName(colIdx)));
+      return RS_CLIENT_ERROR(ERROR_015, std::string(" Expecting BIGINT. Column: ") +
+                                        std::string(colName));
     }
     return RS_OK;
   }
@@ -211,50 +333,76 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     ///< 64 Bit. 8 byte signed integer, can be used in array
     bool success = false;
     try {
-      const char *numCStr      = request->PKValueCStr(colIdx);
+      const char *numCStr      = valueCStr;
       const std::string numStr = std::string(numCStr);
       if (numStr.find('-') == std::string::npos) {
         Uint64 num = std::stoul(numCStr);
-        if (operation->equal(request->PKName(colIdx), num) != 0) {
-          return RS_SERVER_ERROR(ERROR_023);
+        if (SetColValue(target, colName, num) != 0) {
+          return RS_SERVER_ERROR(setErr);
         }
         success = true;
       }
     } catch (...) {
     }
     if (!success) {
-      return RS_CLIENT_ERROR(ERROR_015 + std::string(" Expecting BIGINT UNSIGNED. Column: ") +
-                             std::string(request->PKName(colIdx)));
+      return RS_CLIENT_ERROR(ERROR_015, std::string(" Expecting BIGINT UNSIGNED. Column: ") +
+                                        std::string(colName));
     } else {
       return RS_OK;
     }
   }
   case NdbDictionary::Column::Float: {
     ///< 32-bit float. 4 bytes float, can be used in array
-    return RS_CLIENT_ERROR(ERROR_017 + std::string(" Column: ") +
-                           std::string(request->PKName(colIdx)));
+    if (role == PK_COL) {
+      return RS_CLIENT_ERROR(ERROR_017, std::string(" Column: ") + std::string(colName));
+    }
+    try {
+      float num = std::stof(valueCStr);
+      int ret   = role == VALUE_COL ? target->operation->setValue(colName, num)
+                                    : SetColValueRef(target, colName, &num, sizeof(num));
+      if (ret != 0) {
+        return RS_SERVER_ERROR(setErr);
+      }
+    } catch (...) {
+      return RS_CLIENT_ERROR(ERROR_015, std::string(" Expecting FLOAT. Column: ") +
+                                        std::string(colName));
+    }
+    return RS_OK;
   }
   case NdbDictionary::Column::Double: {
     ///< 64-bit float. 8 byte float, can be used in array
-    return RS_CLIENT_ERROR(ERROR_017 + std::string(" Column: ") +
-                           std::string(request->PKName(colIdx)));
+    if (role == PK_COL) {
+      return RS_CLIENT_ERROR(ERROR_017, std::string(" Column: ") + std::string(colName));
+    }
+    try {
+      double num = std::stod(valueCStr);
+      int ret   = role == VALUE_COL ? target->operation->setValue(colName, num)
+                                    : SetColValueRef(target, colName, &num, sizeof(num));
+      if (ret != 0) {
+        return RS_SERVER_ERROR(setErr);
+      }
+    } catch (...) {
+      return RS_CLIENT_ERROR(ERROR_015, std::string(" Expecting DOUBLE. Column: ") +
+                                        std::string(colName));
+    }
+    return RS_OK;
   }
   case NdbDictionary::Column::Olddecimal: {
     ///< MySQL < 5.0 signed decimal,  Precision, Scale
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   case NdbDictionary::Column::Olddecimalunsigned: {
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   case NdbDictionary::Column::Decimalunsigned: {
     ///< MySQL >= 5.0 signed decimal,  Precision, Scale
-    const std::string decStr = std::string(request->PKValueCStr(colIdx));
+    const std::string decStr = std::string(valueCStr);
     if (decStr.find('-') != std::string::npos) {
-      return RS_CLIENT_ERROR(ERROR_015 +
+      return RS_CLIENT_ERROR(ERROR_015,
                              std::string(" Expecting Decimalunsigned UNSIGNED. Column: ") +
-                             std::string(request->PKName(colIdx)));
+                             std::string(colName));
     }
     [[fallthrough]];
   }
@@ -262,38 +410,37 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     int precision      = col->getPrecision();
     int scale          = col->getScale();
     int bytesNeeded    = getDecimalColumnSpace(precision, scale);
-    const char *decStr = request->PKValueCStr(colIdx);
+    const char *decStr = valueCStr;
     char decBin[bytesNeeded];
     if (decimal_str2bin(decStr, strlen(decStr), precision, scale, decBin, bytesNeeded) != 0) {
-      return RS_CLIENT_ERROR(ERROR_015 + std::string(" Expecting Decimal with Precision: ") +
-                             std::to_string(precision) + std::string(" and Scale: ") +
-                             std::to_string(scale));
+      return RS_CLIENT_ERROR(ERROR_015, std::string(" Expecting Decimal with Precision: ") +
+                                        std::to_string(precision) + std::string(" and Scale: ") +
+                                        std::to_string(scale));
     }
 
-    if (operation->equal(request->PKName(colIdx), decBin, bytesNeeded) != 0) {
-      return RS_SERVER_ERROR(ERROR_023);
+    if (SetColValue(target, colName, decBin, bytesNeeded) != 0) {
+      return RS_SERVER_ERROR(setErr);
     }
     return RS_OK;
   }
   case NdbDictionary::Column::Char: {
     ///< Len. A fixed array of 1-byte chars
 
-    const int len = request->PKValueLen(colIdx);
+    const int len = valueLen;
     if (len > col->getLength()) {
-      return RS_CLIENT_ERROR(
-          std::string(ERROR_008) +
-          " Data len is greater than column length. Column: " + std::string(col->getName()));
+      return RS_CLIENT_ERROR(ERROR_008, " Data len is greater than column length. Column: " +
+                                        std::string(col->getName()));
     }
 
-    const char *charStr = request->PKValueCStr(colIdx);
+    const char *charStr = valueCStr;
     char pk[col->getLength()];
     for (int i = 0; i < col->getLength(); i++) {
       pk[i] = 0;
     }
     memcpy(pk, charStr, len);
 
-    if (operation->equal(request->PKName(colIdx), pk, col->getLength()) != 0) {
-      return RS_SERVER_ERROR(ERROR_023);
+    if (SetColValue(target, colName, pk, col->getLength()) != 0) {
+      return RS_SERVER_ERROR(setErr);
     }
     return RS_OK;
   }
@@ -302,26 +449,26 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     [[fallthrough]];
   case NdbDictionary::Column::Longvarchar: {
     ///< Length bytes: 2, little-endian
-    const int len = request->PKValueLen(colIdx);
+    const int len = valueLen;
     if (len > col->getLength()) {
-      return RS_CLIENT_ERROR(
-          std::string(ERROR_008) +
-          " Data len is greater than column length. Column: " + std::string(col->getName()));
+      return RS_CLIENT_ERROR(ERROR_008, " Data len is greater than column length. Column: " +
+                                        std::string(col->getName()));
     }
     char *charStr;
-    if (request->PKValueNDBStr(colIdx, col, &charStr) != 0) {
+    int ret = ColValueNDBStr(request, role, colIdx, col, &charStr);
+    if (ret != 0) {
       return RS_CLIENT_ERROR(ERROR_019);
     }
-    if (operation->equal(request->PKName(colIdx), charStr, len) != 0) {
-      return RS_SERVER_ERROR(ERROR_023);
+    if (SetColValue(target, colName, charStr, len) != 0) {
+      return RS_SERVER_ERROR(setErr);
     }
     return RS_OK;
   }
   case NdbDictionary::Column::Binary: {
     ///< Len
     // we get the data in base64
-    const char *encodedStr = request->PKValueCStr(colIdx);
-    size_t decoded_size = boost::beast::detail::base64::decoded_size(request->PKValueLen(colIdx));
+    const char *encodedStr = valueCStr;
+    size_t decoded_size = boost::beast::detail::base64::decoded_size(valueLen);
     int maxlen          = std::max(col->getLength(), static_cast<int>(decoded_size));
 
     char pk[maxlen];
@@ -330,16 +477,15 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     }
 
     std::pair<std::size_t, std::size_t> ret =
-        boost::beast::detail::base64::decode(pk, encodedStr, request->PKValueLen(colIdx));
+        boost::beast::detail::base64::decode(pk, encodedStr, valueLen);
 
     if (static_cast<int>(ret.first) > col->getLength()) {
-      return RS_CLIENT_ERROR(
-          std::string(ERROR_008) +
-          " Data len is greater than column length. Column: " + std::string(col->getName()));
+      return RS_CLIENT_ERROR(ERROR_008, " Data len is greater than column length. Column: " +
+                                        std::string(col->getName()));
     }
 
-    if (operation->equal(request->PKName(colIdx), pk, col->getLength()) != 0) {
-      return RS_SERVER_ERROR(ERROR_023);
+    if (SetColValue(target, colName, pk, col->getLength()) != 0) {
+      return RS_SERVER_ERROR(setErr);
     }
     return RS_OK;
   }
@@ -349,8 +495,8 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
   case NdbDictionary::Column::Longvarbinary: {
     ///< Length bytes: 2, little-endian
 
-    const char *encodedStr = request->PKValueCStr(colIdx);
-    size_t decoded_size = boost::beast::detail::base64::decoded_size(request->PKValueLen(colIdx));
+    const char *encodedStr = valueCStr;
+    size_t decoded_size = boost::beast::detail::base64::decoded_size(valueLen);
     int additional_len  = 1;
     if (col->getType() == NdbDictionary::Column::Longvarbinary) {
       additional_len = 2;
@@ -363,12 +509,11 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     }
 
     std::pair<std::size_t, std::size_t> ret = boost::beast::detail::base64::decode(
-        pk + additional_len, encodedStr, request->PKValueLen(colIdx));
+        pk + additional_len, encodedStr, valueLen);
 
     if (static_cast<int>(ret.first) > col->getLength()) {
-      return RS_CLIENT_ERROR(
-          std::string(ERROR_008) +
-          " Data len is greater than column length. Column: " + std::string(col->getName()));
+      return RS_CLIENT_ERROR(ERROR_008, " Data len is greater than column length. Column: " +
+                                        std::string(col->getName()));
     }
 
     // insert the length at the begenning of the array
@@ -381,72 +526,71 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
       return RS_SERVER_ERROR(ERROR_015);
     }
 
-    if (operation->equal(request->PKName(colIdx), pk, ret.first + additional_len) != 0) {
-      return RS_SERVER_ERROR(ERROR_023);
+    if (SetColValue(target, colName, pk, ret.first + additional_len) != 0) {
+      return RS_SERVER_ERROR(setErr);
     }
     return RS_OK;
   }
   case NdbDictionary::Column::Datetime: {
     ///< Precision down to 1 sec (sizeof(Datetime) == 8 bytes )
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   case NdbDictionary::Column::Date: {
     ///< Precision down to 1 day(sizeof(Date) == 4 bytes )
-    const char *date_str = request->PKValueCStr(colIdx);
-    size_t date_str_len  = request->PKValueLen(colIdx);
+    const char *date_str = valueCStr;
+    size_t date_str_len  = valueLen;
 
     MYSQL_TIME l_time;
     MYSQL_TIME_STATUS status;
     bool ret = str_to_datetime(date_str, date_str_len, &l_time, 0, &status);
     if (ret != 0) {
-      return RS_CLIENT_ERROR(std::string(ERROR_027) + std::string(" Column: ") +
-                             std::string(col->getName()))
+      return RS_CLIENT_ERROR(ERROR_027, std::string(" Column: ") + std::string(col->getName()))
     }
 
     if (l_time.hour != 0 || l_time.minute != 0 || l_time.second != 0 || l_time.second_part != 0) {
-      return RS_CLIENT_ERROR(std::string(ERROR_008) +
-                             " Expecting only date data. Column: " + std::string(col->getName()));
+      return RS_CLIENT_ERROR(ERROR_008, " Expecting only date data. Column: " +
+                                        std::string(col->getName()));
     }
 
     unsigned char packed[col->getSizeInBytes()];
     my_date_to_binary(&l_time, packed);
 
-    if (operation->equal(request->PKName(colIdx), reinterpret_cast<char *>(packed),
-                         col->getSizeInBytes()) != 0) {
-      return RS_SERVER_ERROR(ERROR_023);
+    if (SetColValue(target, colName, reinterpret_cast<char *>(packed),
+                    col->getSizeInBytes()) != 0) {
+      return RS_SERVER_ERROR(setErr);
     }
     return RS_OK;
   }
   case NdbDictionary::Column::Blob: {
     ///< Binary large object (see NdbBlob)
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   case NdbDictionary::Column::Text: {
     ///< Text blob
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   case NdbDictionary::Column::Bit: {
     ///< Bit, length specifies no of bits
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   case NdbDictionary::Column::Time: {
     ///< Time without date
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   case NdbDictionary::Column::Year: {
     ///< Year 1901-2155 (1 byte)
     bool success = false;
     try {
-      Int32 year = std::stoi(request->PKValueCStr(colIdx));
+      Int32 year = std::stoi(valueCStr);
       if (year >= 1901 && year <= 2155) {
         Uint8 year_char = (year - 1900);
-        if (operation->equal(request->PKName(colIdx), year_char) != 0) {
-          return RS_SERVER_ERROR(ERROR_023);
+        if (SetColValue(target, colName, year_char) != 0) {
+          return RS_SERVER_ERROR(setErr);
         }
         success = true;
       }
@@ -454,16 +598,17 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     }
     if (!success) {
       return RS_CLIENT_ERROR(
-          ERROR_015 + std::string(" Expecting YEAR column. Possible values [1901-2155]. Column: ") +
-          std::string(request->PKName(colIdx)));
+                 ERROR_015,
+                 std::string(" Expecting YEAR column. Possible values [1901-2155]. Column: ") +
+                 std::string(colName));
     } else {
       return RS_OK;
     }
   }
   case NdbDictionary::Column::Timestamp: {
     ///< Unix time
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   ///**
   // * Time types in MySQL 5.6 add microsecond fraction.
@@ -474,15 +619,14 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
   // */
   case NdbDictionary::Column::Time2: {
     ///< 3 bytes + 0-3 fraction
-    const char *time_str = request->PKValueCStr(colIdx);
-    size_t time_str_len  = request->PKValueLen(colIdx);
+    const char *time_str = valueCStr;
+    size_t time_str_len  = valueLen;
 
     MYSQL_TIME l_time;
     MYSQL_TIME_STATUS status;
     bool ret = str_to_time(time_str, time_str_len, &l_time, &status, 0);
     if (ret != 0) {
-      return RS_CLIENT_ERROR(std::string(ERROR_027) + std::string(" Column: ") +
-                             std::string(col->getName()))
+      return RS_CLIENT_ERROR(ERROR_027, std::string(" Column: ") + std::string(col->getName()))
     }
 
     size_t packed_len = col->getSizeInBytes();
@@ -492,23 +636,21 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     longlong numaric_date_time = TIME_to_longlong_time_packed(l_time);
     my_time_packed_to_binary(numaric_date_time, packed, precision);
 
-    if (operation->equal(request->PKName(colIdx), reinterpret_cast<char *>(packed), packed_len) !=
-        0) {
-      return RS_SERVER_ERROR(ERROR_023);
+    if (SetColValue(target, colName, reinterpret_cast<char *>(packed), packed_len) != 0) {
+      return RS_SERVER_ERROR(setErr);
     }
     return RS_OK;
   }
   case NdbDictionary::Column::Datetime2: {
     ///< 5 bytes plus 0-3 fraction
-    const char *date_str = request->PKValueCStr(colIdx);
-    size_t date_str_len  = request->PKValueLen(colIdx);
+    const char *date_str = valueCStr;
+    size_t date_str_len  = valueLen;
 
     MYSQL_TIME l_time;
     MYSQL_TIME_STATUS status;
     bool ret = str_to_datetime(date_str, date_str_len, &l_time, 0, &status);
     if (ret != 0) {
-      return RS_CLIENT_ERROR(std::string(ERROR_027) + std::string(" Column: ") +
-                             std::string(col->getName()))
+      return RS_CLIENT_ERROR(ERROR_027, std::string(" Column: ") + std::string(col->getName()))
     }
 
     size_t packed_len = col->getSizeInBytes();
@@ -519,17 +661,16 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
 
     my_datetime_packed_to_binary(numaric_date_time, packed, precision);
 
-    if (operation->equal(request->PKName(colIdx), reinterpret_cast<char *>(packed), packed_len) !=
-        0) {
-      return RS_SERVER_ERROR(ERROR_023);
+    if (SetColValue(target, colName, reinterpret_cast<char *>(packed), packed_len) != 0) {
+      return RS_SERVER_ERROR(setErr);
     }
     return RS_OK;
   }
   case NdbDictionary::Column::Timestamp2: {
     // epoch range 0 , 2147483647
     /// < 4 bytes + 0-3 fraction
-    const char *ts_str = request->PKValueCStr(colIdx);
-    size_t ts_str_len  = request->PKValueLen(colIdx);
+    const char *ts_str = valueCStr;
+    size_t ts_str_len  = valueLen;
     size_t packed_len  = col->getSizeInBytes();
     unsigned char packed[packed_len];
     uint precision = col->getPrecision();
@@ -538,8 +679,7 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     MYSQL_TIME_STATUS status;
     bool ret = str_to_datetime(ts_str, ts_str_len, &l_time, 0, &status);
     if (ret != 0) {
-      return RS_CLIENT_ERROR(std::string(ERROR_027) + std::string(" Column: ") +
-                             std::string(col->getName()))
+      return RS_CLIENT_ERROR(ERROR_027, std::string(" Column: ") + std::string(col->getName()))
     }
 
     time_t epoch = 0;
@@ -552,14 +692,12 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
       boost::posix_time::time_duration dur = bt - start;
       epoch                                = dur.total_seconds();
     } catch (...) {
-      return RS_CLIENT_ERROR(std::string(ERROR_027) + std::string(" Column: ") +
-                             std::string(col->getName()))
+      return RS_CLIENT_ERROR(ERROR_027, std::string(" Column: ") + std::string(col->getName()))
     }
 
     // 1970-01-01 00:00:01' UTC to '2038-01-19 03:14:07' UTC.
     if (epoch <= 0 || epoch > 2147483647) {
-      return RS_CLIENT_ERROR(std::string(ERROR_027) + std::string(" Column: ") +
-                             std::string(col->getName()))
+      return RS_CLIENT_ERROR(ERROR_027, std::string(" Column: ") + std::string(col->getName()))
     }
 
     // TODO(salman) 1 apply timezone changes
@@ -580,12 +718,76 @@ RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *oper
     timeval my_tv{epoch, (Int64)l_time.second_part};
     my_timestamp_to_binary(&my_tv, packed, precision);
 
-    if (operation->equal(request->PKName(colIdx), reinterpret_cast<char *>(packed), packed_len) !=
-        0) {
-      return RS_SERVER_ERROR(ERROR_023);
+    if (SetColValue(target, colName, reinterpret_cast<char *>(packed), packed_len) != 0) {
+      return RS_SERVER_ERROR(setErr);
+    }
+    return RS_OK;
+  }
+  }
+  return RS_OK;
+}
+
+RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *operation,
+                            PKRRequest *request, Uint32 colIdx) {
+  ColTarget target = {PK_COL, operation, nullptr, 0, 0};
+  return SetOperationCol(col, &target, request, colIdx);
+}
+
+RS_Status SetOperationValueCol(const NdbDictionary::Column *col, NdbOperation *operation,
+                               PKRRequest *request, Uint32 colIdx) {
+  if (request->IsNullValue(colIdx)) {
+    if (!col->getNullable()) {
+      return RS_CLIENT_ERROR(ERROR_035, std::string(" Column: ") + std::string(col->getName()));
+    }
+    if (operation->setValue(request->ValueName(colIdx), static_cast<const char *>(nullptr)) != 0) {
+      return RS_SERVER_ERROR(ERROR_033);
+    }
+    return RS_OK;
+  }
+  ColTarget target = {VALUE_COL, operation, nullptr, 0, 0};
+  return SetOperationCol(col, &target, request, colIdx);
+}
+
+RS_Status SetOperationBoundCol(const NdbDictionary::Column *col, NdbIndexScanOperation *operation,
+                               PKRRequest *request, Uint32 colIdx, bool lower, int boundType) {
+  ColTarget target = {lower ? LOWER_BOUND_COL : UPPER_BOUND_COL, operation, nullptr, boundType, 0};
+  return SetOperationCol(col, &target, request, colIdx);
+}
+
+RS_Status SetScanFilterCol(const NdbDictionary::Column *col, NdbScanFilter *filter,
+                           PKRRequest *request, Uint32 colIdx, int cond) {
+  // patterns are compared without the length bytes of the column
+  if (cond == NdbScanFilter::COND_LIKE || cond == NdbScanFilter::COND_NOT_LIKE) {
+    if (col->getType() != NdbDictionary::Column::Char &&
+        col->getType() != NdbDictionary::Column::Varchar &&
+        col->getType() != NdbDictionary::Column::Longvarchar) {
+      return RS_CLIENT_ERROR(ERROR_048, std::string(" LIKE is only supported for string ") +
+                                        "columns. Column: " + std::string(col->getName()));
+    }
+    if (filter->cmp(static_cast<NdbScanFilter::BinaryCondition>(cond), col->getColumnNo(),
+                    request->FilterValueCStr(colIdx), request->FilterValueLen(colIdx)) != 0) {
+      return RS_SERVER_ERROR(ERROR_031);
     }
     return RS_OK;
   }
+
+  ColTarget target = {FILTER_COL, nullptr, filter, cond, col->getColumnNo()};
+  return SetOperationCol(col, &target, request, colIdx);
+}
+
+RS_Status SetOperationReadCol(const NdbDictionary::Column *col, NdbOperation *operation,
+                              std::vector<NdbRecAttr *> *recs, std::vector<NdbBlob *> *blobs) {
+  if (col->getType() == NdbDictionary::Column::Blob ||
+      col->getType() == NdbDictionary::Column::Text) {
+    NdbBlob *blob = operation->getBlobHandle(col->getName());
+    if (blob == nullptr) {
+      return RS_RONDB_SERVER_ERROR(operation->getNdbError(), ERROR_039,
+                                   std::string(" Column: ") + std::string(col->getName()));
+    }
+    blobs->push_back(blob);
+  } else {
+    NdbRecAttr *rec = operation->getValue(col->getName(), nullptr);
+    recs->push_back(rec);
   }
   return RS_OK;
 }
@@ -599,7 +801,7 @@ RS_Status WriteColToRespBuff(const NdbRecAttr *attr, PKRResponse *response) {
   switch (col->getType()) {
   case NdbDictionary::Column::Undefined: {
     ///< 4 bytes + 0-3 fraction
-    return RS_CLIENT_ERROR(ERROR_018 + std::string(" Column: ") + std::string(col->getName()));
+    return RS_CLIENT_ERROR(ERROR_018, std::string(" Column: ") + std::string(col->getName()));
   }
   case NdbDictionary::Column::Tinyint: {
     ///< 8 bit. 1 byte signed integer, can be used in array
@@ -651,13 +853,13 @@ RS_Status WriteColToRespBuff(const NdbRecAttr *attr, PKRResponse *response) {
   }
   case NdbDictionary::Column::Olddecimal: {
     ///< MySQL < 5.0 signed decimal,  Precision, Scale
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   case NdbDictionary::Column::Olddecimalunsigned: {
     ///< MySQL < 5.0 signed decimal,  Precision, Scale
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   case NdbDictionary::Column::Decimal:
     ///< MySQL >= 5.0 signed decimal,  Precision, Scale
@@ -670,7 +872,7 @@ RS_Status WriteColToRespBuff(const NdbRecAttr *attr, PKRResponse *response) {
     int bin_len   = attr->get_size_in_bytes();
     decimal_bin2str(bin, bin_len, precision, scale, decStr, MaxDecimalStrLen);
     return response->Append_string(attr->getColumn()->getName(), std::string(decStr),
-                                   RDRS_FLOAT_DATATYPE);
+                                   RDRS_DECIMAL_DATATYPE);
   }
   case NdbDictionary::Column::Char:
     ///< Len. A fixed array of 1-byte chars
@@ -711,8 +913,8 @@ RS_Status WriteColToRespBuff(const NdbRecAttr *attr, PKRResponse *response) {
   }
   case NdbDictionary::Column::Datetime: {
     ///< Precision down to 1 sec (sizeof(Datetime) == 8 bytes )
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   case NdbDictionary::Column::Date: {
     ///< Precision down to 1 day(sizeof(Date) == 4 bytes )
@@ -725,13 +927,13 @@ RS_Status WriteColToRespBuff(const NdbRecAttr *attr, PKRResponse *response) {
   }
   case NdbDictionary::Column::Blob: {
     ///< Binary large object (see NdbBlob)
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   case NdbDictionary::Column::Text: {
     ///< Text blob
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   case NdbDictionary::Column::Bit: {
     //< Bit, length specifies no of bits
@@ -757,8 +959,8 @@ RS_Status WriteColToRespBuff(const NdbRecAttr *attr, PKRResponse *response) {
   }
   case NdbDictionary::Column::Time: {
     ///< Time without date
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   case NdbDictionary::Column::Year: {
     ///< Year 1901-2155 (1 byte)
@@ -767,8 +969,8 @@ RS_Status WriteColToRespBuff(const NdbRecAttr *attr, PKRResponse *response) {
   }
   case NdbDictionary::Column::Timestamp: {
     ///< Unix time
-    return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                           " Type: " + std::to_string(col->getType()));
+    return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                      " Type: " + std::to_string(col->getType()));
   }
   ///**
   // * Time types in MySQL 5.6 add microsecond fraction.
@@ -838,8 +1040,125 @@ RS_Status WriteColToRespBuff(const NdbRecAttr *attr, PKRResponse *response) {
   }
   }
 
-  return RS_SERVER_ERROR(ERROR_028 + std::string(" Column: ") + std::string(col->getName()) +
-                         " Type: " + std::to_string(col->getType()));
+  return RS_SERVER_ERROR(ERROR_028, std::string(" Column: ") + std::string(col->getName()) +
+                                    " Type: " + std::to_string(col->getType()));
+}
+
+bool IsBinaryOrStringColumn(const NdbDictionary::Column *col) {
+  switch (col->getType()) {
+  case NdbDictionary::Column::Char:
+  case NdbDictionary::Column::Varchar:
+  case NdbDictionary::Column::Longvarchar:
+  case NdbDictionary::Column::Binary:
+  case NdbDictionary::Column::Varbinary:
+  case NdbDictionary::Column::Longvarbinary:
+  case NdbDictionary::Column::Blob:
+  case NdbDictionary::Column::Text:
+    return true;
+  default:
+    return false;
+  }
+}
+
+/**
+ * Append base64 or hex encoded data to the response buffer
+ */
+static RS_Status AppendEncodedData(const char *colName, const char *data, Uint32 bytes,
+                                   DataReturnType drt, PKRResponse *response) {
+  std::string encoded;
+  if (drt == HEX_DRT) {
+    const char hexDigits[] = "0123456789ABCDEF";
+    encoded.reserve(static_cast<size_t>(bytes) * 2);
+    for (Uint32 i = 0; i < bytes; i++) {
+      unsigned char byte = static_cast<unsigned char>(data[i]);
+      encoded.push_back(hexDigits[byte >> 4]);
+      encoded.push_back(hexDigits[byte & 0x0F]);
+    }
+  } else {
+    encoded.resize(boost::beast::detail::base64::encoded_size(bytes));
+    encoded.resize(boost::beast::detail::base64::encode(&encoded[0], data, bytes));
+  }
+  return response->Append_string(colName, encoded, RDRS_STRING_DATATYPE);
+}
+
+RS_Status WriteColToRespBuff(const NdbRecAttr *attr, PKRResponse *response, DataReturnType drt) {
+  switch (drt) {
+  case BASE64_DRT:
+    [[fallthrough]];
+  case HEX_DRT: {
+    const NdbDictionary::Column *col = attr->getColumn();
+    if (attr->isNULL()) {
+      return response->SetColumnDataNull(col->getName());
+    }
+
+    Uint32 bytes;
+    const char *data = nullptr;
+    if (GetByteArray(attr, &data, &bytes) != 0) {
+      return RS_CLIENT_ERROR(ERROR_019);
+    }
+
+    // remove blank spaces that are padded to the string
+    if (col->getType() == NdbDictionary::Column::Char) {
+      while (bytes > 0 && data[bytes - 1] == ' ') {
+        bytes--;
+      }
+    }
+    return AppendEncodedData(col->getName(), data, bytes, drt, response);
+  }
+  case STRING_DRT: {
+    response->SetNumbersAsStrings(true);
+    RS_Status status = WriteColToRespBuff(attr, response);
+    response->SetNumbersAsStrings(false);
+    return status;
+  }
+  default:
+    return WriteColToRespBuff(attr, response);
+  }
+}
+
+RS_Status WriteBlobColToRespBuff(NdbBlob *blob, PKRResponse *response, DataReturnType drt) {
+  const NdbDictionary::Column *col = blob->getColumn();
+
+  int isNull = 0;
+  if (blob->getNull(isNull) != 0) {
+    return RS_RONDB_SERVER_ERROR(blob->getNdbError(), ERROR_039, std::string(" Column: ") +
+                                                                 std::string(col->getName()));
+  }
+
+  if (isNull) {
+    return response->SetColumnDataNull(col->getName());
+  }
+
+  Uint64 length = 0;
+  if (blob->getLength(length) != 0) {
+    return RS_RONDB_SERVER_ERROR(blob->getNdbError(), ERROR_039, std::string(" Column: ") +
+                                                                 std::string(col->getName()));
+  }
+
+  if (length > UINT32_MAX) {
+    return RS_SERVER_ERROR(ERROR_020, std::string(" Column: ") + std::string(col->getName()));
+  }
+
+  // blobs can be large. read the blob parts into a heap buffer
+  Uint32 bytes = static_cast<Uint32>(length);
+  std::vector<char> data(bytes + 1);
+  if (bytes > 0 && blob->readData(data.data(), bytes) != 0) {
+    return RS_RONDB_SERVER_ERROR(blob->getNdbError(), ERROR_039, std::string(" Column: ") +
+                                                                 std::string(col->getName()));
+  }
+
+  if (drt == BASE64_DRT || drt == HEX_DRT) {
+    return AppendEncodedData(col->getName(), data.data(), bytes, drt, response);
+  }
+
+  if (col->getType() == NdbDictionary::Column::Text) {
+    return response->Append_text(col->getName(), data.data(), bytes, col->getCharset());
+  }
+
+  std::string encoded(boost::beast::detail::base64::encoded_size(bytes), '\0');
+  size_t ret = boost::beast::detail::base64::encode(&encoded[0], data.data(), bytes);
+  encoded.resize(ret);
+  return response->Append_string(col->getName(), encoded, RDRS_BINARY_DATATYPE);
 }
 
 int GetByteArray(const NdbRecAttr *attr, const char **first_byte, Uint32 *bytes) {
@@ -20,6 +20,7 @@
 #ifndef DATA_ACCESS_RONDB_SRC_DB_OPERATIONS_PK_COMMON_H_
 #define DATA_ACCESS_RONDB_SRC_DB_OPERATIONS_PK_COMMON_H_
 
+#include <vector>
 #include <NdbDictionary.hpp>
 #include "src/rdrs-dal.h"
 #include "src/db-operations/pk/pkr-request.hpp"
@@ -37,11 +38,86 @@
 RS_Status SetOperationPKCol(const NdbDictionary::Column *col, NdbOperation *operation,
                             PKRRequest *request, Uint32 colIdx);
 
+/**
+ * Set the value of a non primary key column for write operations
+ *
+ * @param[in] col
+ * @param[in] operation
+ * @param[in] request
+ * @param[in] colIdx
+ *
+ * @return status
+ */
+RS_Status SetOperationValueCol(const NdbDictionary::Column *col, NdbOperation *operation,
+                               PKRRequest *request, Uint32 colIdx);
+
+/**
+ * Set the value of an index scan bound column
+ *
+ * @param[in] col
+ * @param[in] operation
+ * @param[in] request
+ * @param[in] colIdx
+ * @param[in] lower. lower or upper bound
+ * @param[in] boundType. NdbIndexScanOperation::BoundType
+ *
+ * @return status
+ */
+RS_Status SetOperationBoundCol(const NdbDictionary::Column *col, NdbIndexScanOperation *operation,
+                               PKRRequest *request, Uint32 colIdx, bool lower, int boundType);
+
+/**
+ * Add a comparison condition to a scan filter. The comparison value
+ * is encoded the same way as the primary key values
+ *
+ * @param[in] col
+ * @param[in] filter
+ * @param[in] request
+ * @param[in] colIdx. index of the filter value in the request
+ * @param[in] cond. NdbScanFilter::BinaryCondition
+ *
+ * @return status
+ */
+RS_Status SetScanFilterCol(const NdbDictionary::Column *col, NdbScanFilter *filter,
+                           PKRRequest *request, Uint32 colIdx, int cond);
+
+/**
+ * Set up reading of a non primary key column. Blob/Text columns are
+ * read using blob handles
+ *
+ * @param[in] col
+ * @param[in] operation
+ * @param[out] recs
+ * @param[out] blobs
+ *
+ * @return status
+ */
+RS_Status SetOperationReadCol(const NdbDictionary::Column *col, NdbOperation *operation,
+                              std::vector<NdbRecAttr *> *recs, std::vector<NdbBlob *> *blobs);
+
+/**
+ * Check if the column stores binary or string data,
+ * e.g., for BASE64_DRT and HEX_DRT data return types
+ */
+bool IsBinaryOrStringColumn(const NdbDictionary::Column *col);
+
 /**
  * it stores the data read from the DB into the response buffer
  */
 RS_Status WriteColToRespBuff(const NdbRecAttr *attr, PKRResponse *response);
 
+/**
+ * it stores the data read from the DB into the response buffer
+ * using the requested data return type
+ */
+RS_Status WriteColToRespBuff(const NdbRecAttr *attr, PKRResponse *response, DataReturnType drt);
+
+/**
+ * it stores the BLOB/TEXT data read from the DB into the response buffer.
+ * By default, TEXT is returned as string and BLOB as base64 encoded string
+ */
+RS_Status WriteBlobColToRespBuff(NdbBlob *blob, PKRResponse *response, DataReturnType drt);
+
   /**
    * return data for array columns
    *
@@ -32,6 +32,7 @@
 #include "src/status.hpp"
 #include "src/rondb-lib/rdrs_date.hpp"
 #include "src/mystring.hpp"
+#include "src/ndb_object_pool.hpp"
 #include <boost/date_time/posix_time/posix_time.hpp>
 #include <boost/beast/core/detail/base64.hpp>
 
@@ -43,15 +44,16 @@ PKROperation::PKROperation(RS_Buffer *req_buff, RS_Buffer *resp_buff, Ndb *ndb_o
 }
 
 PKROperation::PKROperation(Uint32 no_ops, RS_Buffer *req_buffs, RS_Buffer *resp_buffs,
-                           Ndb *ndb_object) {
+                           Ndb *ndb_object, bool isTransactional) {
 
   this->no_ops = no_ops;
   for (Uint32 i = 0; i < no_ops; i++) {
     this->requests.push_back(new PKRRequest(&req_buffs[i]));
     this->responses.push_back(new PKRResponse(&resp_buffs[i]));
   }
-  this->ndb_object = ndb_object;
-  this->isBatch    = true;
+  this->ndb_object      = ndb_object;
+  this->isBatch         = true;
+  this->isTransactional = isTransactional;
 }
 
 PKROperation::~PKROperation() {
@@ -80,11 +82,11 @@ RS_Status PKROperation::SetupTransaction() {
 }
 
 /**
- * Set up read operation
+ * Set up read/write operations
  *
  * @return status
  */
-RS_Status PKROperation::SetupReadOperation() {
+RS_Status PKROperation::SetupOperations() {
   if (operations.size() != 0) {
     return RS_CLIENT_ERROR(ERROR_006);
   }
@@ -92,17 +94,40 @@ RS_Status PKROperation::SetupReadOperation() {
   for (size_t i = 0; i < no_ops; i++) {
     PKRRequest *req                        = requests[i];
     const NdbDictionary::Table *table_dict = all_table_dicts[i];
-    NdbOperation *op                       = transaction->getNdbOperation(table_dict);
+    const NdbDictionary::Index *index_dict = all_index_dicts[i];
+    NdbOperation *op                       = nullptr;
+    if (index_dict != nullptr) {
+      op = transaction->getNdbIndexOperation(index_dict);
+    } else {
+      op = transaction->getNdbOperation(table_dict);
+    }
     if (op == nullptr) {
       return RS_RONDB_SERVER_ERROR(transaction->getNdbError(), ERROR_007);
     } else {
       operations.push_back(op);
     }
 
-    if (op->readTuple(NdbOperation::LM_CommittedRead) != 0) {
+    if (req->OperationType() != RDRS_PK_REQ_ID) {
+      RS_Status status = SetupWriteOperation(req, table_dict, op);
+      if (status.http_code != SUCCESS) {
+        return status;
+      }
+      all_recs.push_back(std::vector<NdbRecAttr *>());  // nothing to read
+      all_blobs.push_back(std::vector<NdbBlob *>());
+      continue;
+    }
+
+    // transactional reads lock the rows till the transaction commits
+    NdbOperation::LockMode lock_mode =
+        isTransactional ? NdbOperation::LM_Read : NdbOperation::LM_CommittedRead;
+    if (op->readTuple(lock_mode) != 0) {
       return RS_SERVER_ERROR(ERROR_022)
     }
 
+    if (isTransactional && op->setAbortOption(NdbOperation::AbortOnError) != 0) {
+      return RS_RONDB_SERVER_ERROR(op->getNdbError(), ERROR_022);
+    }
+
     for (Uint32 i = 0; i < req->PKColumnsCount(); i++) {
       RS_Status status = SetOperationPKCol(table_dict->getColumn(req->PKName(i)), op, req, i);
       if (status.http_code != SUCCESS) {
@@ -110,37 +135,127 @@ RS_Status PKROperation::SetupReadOperation() {
       }
     }
 
+    // blob/text columns are read using blob handles
     std::vector<NdbRecAttr *> recs;
+    std::vector<NdbBlob *> blobs;
     if (req->ReadColumnsCount() > 0) {
       for (Uint32 i = 0; i < req->ReadColumnsCount(); i++) {
-        NdbRecAttr *rec = op->getValue(req->ReadColumnName(i), nullptr);
-        recs.push_back(rec);
+        RS_Status status =
+            SetOperationReadCol(table_dict->getColumn(req->ReadColumnName(i)), op, &recs, &blobs);
+        if (status.http_code != SUCCESS) {
+          return status;
+        }
       }
     } else {
-      std::unordered_map<std::string, const NdbDictionary::Column *> non_pk_cols =
-          all_non_pk_cols[i];
-      std::unordered_map<std::string, const NdbDictionary::Column *>::const_iterator it =
-          non_pk_cols.begin();
+      const ColumnMap &non_pk_cols = all_keys[i]->non_key_cols;
+      ColumnMap::const_iterator it = non_pk_cols.begin();
       while (it != non_pk_cols.end()) {
-        NdbRecAttr *rec = op->getValue(it->first.c_str(), nullptr);
+        RS_Status status = SetOperationReadCol(it->second, op, &recs, &blobs);
+        if (status.http_code != SUCCESS) {
+          return status;
+        }
         it++;
-        recs.push_back(rec);
       }
     }
     all_recs.push_back(recs);
+    all_blobs.push_back(blobs);
+  }
+
+  return RS_OK;
+}
+
+RS_Status PKROperation::SetupWriteOperation(PKRRequest *req,
+                                            const NdbDictionary::Table *table_dict,
+                                            NdbOperation *op) {
+  int ret = 0;
+  switch (req->OperationType()) {
+  case RDRS_PK_INSERT_REQ_ID:
+    ret = op->insertTuple();
+    break;
+  case RDRS_PK_UPDATE_REQ_ID:
+    ret = op->updateTuple();
+    break;
+  case RDRS_PK_UPSERT_REQ_ID:
+    ret = op->writeTuple();
+    break;
+  case RDRS_PK_DELETE_REQ_ID:
+    ret = op->deleteTuple();
+    break;
+  default:
+    return RS_CLIENT_ERROR(ERROR_034, std::string(" Type: ") +
+                                      std::to_string(req->OperationType()));
+  }
+
+  if (ret != 0) {
+    return RS_RONDB_SERVER_ERROR(op->getNdbError(), ERROR_037);
+  }
+
+  // errors such as missing rows or duplicate keys are reported
+  // per operation in the response instead of failing the transaction.
+  // In transactional mode any error aborts the whole transaction
+  NdbOperation::AbortOption abort_option =
+      isTransactional ? NdbOperation::AbortOnError : NdbOperation::AO_IgnoreError;
+  if (op->setAbortOption(abort_option) != 0) {
+    return RS_RONDB_SERVER_ERROR(op->getNdbError(), ERROR_037);
+  }
+
+  for (Uint32 i = 0; i < req->PKColumnsCount(); i++) {
+    RS_Status status = SetOperationPKCol(table_dict->getColumn(req->PKName(i)), op, req, i);
+    if (status.http_code != SUCCESS) {
+      return status;
+    }
+  }
+
+  for (Uint32 i = 0; i < req->ValuesCount(); i++) {
+    RS_Status status = SetOperationValueCol(table_dict->getColumn(req->ValueName(i)), op, req, i);
+    if (status.http_code != SUCCESS) {
+      return status;
+    }
   }
 
   return RS_OK;
 }
 
 RS_Status PKROperation::Execute() {
-  if (transaction->execute(NdbTransaction::Commit) != 0) {
+  // blob data can only be read before the transaction is committed
+  NdbTransaction::ExecType exec_type =
+      HasBlobs() ? NdbTransaction::NoCommit : NdbTransaction::Commit;
+  if (ExecuteTransaction(ndb_object, transaction, exec_type, deadline, &timed_out) != 0) {
+    if (timed_out) {
+      return RS_TIMEOUT_ERROR();
+    }
+    // In transactional mode an operation error rolls back the transaction.
+    // The error is reported in the response of the failed operation
+    if (isTransactional && transaction->getNdbErrorOperation() != nullptr) {
+      failed_op = transaction->getNdbErrorOperation();
+      return RS_OK;
+    }
     return RS_RONDB_SERVER_ERROR(transaction->getNdbError(), ERROR_009);
   }
 
   return RS_OK;
 }
 
+RS_Status PKROperation::Commit() {
+  if (ExecuteTransaction(ndb_object, transaction, NdbTransaction::Commit, deadline, &timed_out) !=
+      0) {
+    if (timed_out) {
+      return RS_TIMEOUT_ERROR();
+    }
+    return RS_RONDB_SERVER_ERROR(transaction->getNdbError(), ERROR_009);
+  }
+  return RS_OK;
+}
+
+bool PKROperation::HasBlobs() {
+  for (size_t i = 0; i < all_blobs.size(); i++) {
+    if (all_blobs[i].size() > 0) {
+      return true;
+    }
+  }
+  return false;
+}
+
 RS_Status PKROperation::CreateResponse() {
   bool found = true;
   for (size_t i = 0; i < no_ops; i++) {
@@ -148,26 +263,34 @@ RS_Status PKROperation::CreateResponse() {
     PKRResponse *resp              = responses[i];
     const NdbOperation *op         = operations[i];
     std::vector<NdbRecAttr *> recs = all_recs[i];
+    std::vector<NdbBlob *> blobs   = all_blobs[i];
 
     found = true;
-    if (op->getNdbError().classification == NdbError::NoError) {
+    if (failed_op != nullptr && op != failed_op) {
+      found = false;
+      resp->SetStatus(FAILED_DEPENDENCY);
+    } else if (op->getNdbError().classification == NdbError::NoError) {
       resp->SetStatus(SUCCESS);
     } else if (op->getNdbError().classification == NdbError::NoDataFound) {
       found = false;
       resp->SetStatus(NOT_FOUND);
     } else {
       found = false;
-      resp->SetStatus(SERVER_ERROR);
+      resp->SetStatus(NdbErrorHttpCode(SERVER_ERROR, op->getNdbError()));
+      // failed operations of batches do not fail the request
+      if (op->getNdbError().classification == NdbError::SchemaError) {
+        schema_changed = true;
+      }
     }
 
     resp->SetDB(req->DB());
     resp->SetTable(req->Table());
     resp->SetOperationID(req->OperationId());
-    resp->SetNoOfColumns(recs.size());
+    resp->SetNoOfColumns(recs.size() + blobs.size());
 
     if (found) {
       // iterate over all columns
-      RS_Status ret = AppendOpRecs(found, req, resp, &recs);
+      RS_Status ret = AppendOpRecs(found, req, resp, &recs, &blobs);
       if (ret.http_code != SUCCESS) {
         return ret;
       }
@@ -176,16 +299,49 @@ RS_Status PKROperation::CreateResponse() {
   }
 
   if (!found && !isBatch) {
+    const NdbError &error = operations[0]->getNdbError();
+    if (error.classification == NdbError::ConstraintViolation) {
+      return RS_RONDB_CLIENT_ERROR(error, ERROR_036);
+    } else if (error.classification != NdbError::NoDataFound) {
+      return RS_RONDB_SERVER_ERROR(error, ERROR_009);
+    }
     return RS_CLIENT_404_ERROR();
   }
   return RS_OK;
 }
 
+static DataReturnType GetDataReturnType(std::unordered_map<std::string, DataReturnType> *drts,
+                                        const char *colName) {
+  std::unordered_map<std::string, DataReturnType>::const_iterator got =
+      drts->find(std::string(colName));
+  if (got == drts->end()) {
+    return DEFAULT_DRT;
+  }
+  return got->second;
+}
+
 RS_Status PKROperation::AppendOpRecs(bool found, PKRRequest *req, PKRResponse *resp,
-                                     std::vector<NdbRecAttr *> *recs) {
+                                     std::vector<NdbRecAttr *> *recs,
+                                     std::vector<NdbBlob *> *blobs) {
+
+  // data return types of the read columns. If the read columns are
+  // not specified then all columns use the default data return type
+  std::unordered_map<std::string, DataReturnType> drts;
+  for (Uint32 i = 0; i < req->ReadColumnsCount(); i++) {
+    drts[std::string(req->ReadColumnName(i))] = req->ReadColumnReturnType(i);
+  }
 
   for (Uint32 i = 0; i < recs->size(); i++) {
-    RS_Status status = WriteColToRespBuff((*recs)[i], resp);
+    DataReturnType drt = GetDataReturnType(&drts, (*recs)[i]->getColumn()->getName());
+    RS_Status status   = WriteColToRespBuff((*recs)[i], resp, drt);
+    if (status.http_code != SUCCESS) {
+      return status;
+    }
+  }
+
+  for (Uint32 i = 0; i < blobs->size(); i++) {
+    DataReturnType drt = GetDataReturnType(&drts, (*blobs)[i]->getColumn()->getName());
+    RS_Status status   = WriteBlobColToRespBuff((*blobs)[i], resp, drt);
     if (status.http_code != SUCCESS) {
       return status;
     }
@@ -194,59 +350,81 @@ RS_Status PKROperation::AppendOpRecs(bool found, PKRRequest *req, PKRResponse *r
 }
 
 RS_Status PKROperation::Init() {
+  TableCache *table_cache = NdbObjectPool::GetInstance()->GetTableCache(ndb_object);
+  if (table_cache == nullptr) {
+    return RS_SERVER_ERROR(ERROR_004);
+  }
 
   for (size_t i = 0; i < no_ops; i++) {
 
     PKRRequest *req = requests[i];
-    std::unordered_map<std::string, const NdbDictionary::Column *> pk_cols;
-    std::unordered_map<std::string, const NdbDictionary::Column *> non_pk_cols;
     if (ndb_object->setCatalogName(req->DB()) != 0) {
-      return RS_CLIENT_ERROR(ERROR_011 + std::string(" Database: ") + std::string(req->DB()) +
-                             " Table: " + req->Table());
-    }
-    const NdbDictionary::Dictionary *dict  = ndb_object->getDictionary();
-    const NdbDictionary::Table *table_dict = dict->getTable(req->Table());
-
-    if (table_dict == nullptr) {
-      return RS_CLIENT_ERROR(ERROR_011 + std::string(" Database: ") + std::string(req->DB()) +
-                             " Table: " + req->Table());
+      return RS_CLIENT_ERROR(ERROR_011, std::string(" Database: ") + std::string(req->DB()) +
+                                        " Table: " + req->Table());
     }
-    all_table_dicts.push_back(table_dict);
 
-    // get all primary key columnns
-    for (int i = 0; i < table_dict->getNoOfPrimaryKeys(); i++) {
-      const char *priName           = table_dict->getPrimaryKey(i);
-      pk_cols[std::string(priName)] = table_dict->getColumn(priName);
+    std::shared_ptr<const TableMetadata> table;
+    RS_Status status = table_cache->GetTable(req->DB(), req->Table(), &table);
+    if (status.http_code != SUCCESS) {
+      return status;
     }
+    all_tables.push_back(table);
+    all_table_dicts.push_back(table->table);
+
+    const KeyMetadata *key = &table->primary_key;
+    if (req->IndexName() != nullptr) {
+      std::string indexName = std::string(req->IndexName()) + RDRS_UNIQUE_INDEX_SUFFIX;
+      auto index            = table->indexes.find(indexName);
+      if (index == table->indexes.end()) {
+        return RS_CLIENT_ERROR(ERROR_040, std::string(" Index: ") + req->IndexName());
+      }
 
-    // get all non primary key columnns
-    for (int i = 0; i < table_dict->getNoOfColumns(); i++) {
-      const NdbDictionary::Column *col = table_dict->getColumn(i);
-      std::string colNameStr(col->getName());
-      std::unordered_map<std::string, const NdbDictionary::Column *>::const_iterator got =
-          pk_cols.find(colNameStr);
-      if (got == pk_cols.end()) {  // not found
-        non_pk_cols[std::string(col->getName())] = table_dict->getColumn(col->getName());
+      if (index->second->getType() != NdbDictionary::Index::UniqueHashIndex) {
+        return RS_CLIENT_ERROR(ERROR_044, std::string(" Index: ") + req->IndexName());
       }
+      key = &table->unique_keys.at(indexName);
     }
-    all_non_pk_cols.push_back(non_pk_cols);
-    all_pk_cols.push_back(pk_cols);
+    all_index_dicts.push_back(key->index);
+    all_keys.push_back(key);
   }
   return RS_OK;
 }
 
+bool PKROperation::InvalidateTables(bool stale_only) {
+  TableCache *table_cache = NdbObjectPool::GetInstance()->GetTableCache(ndb_object);
+  if (table_cache == nullptr) {
+    return false;
+  }
+  bool invalidated = false;
+  for (size_t i = 0; i < all_tables.size(); i++) {
+    if (stale_only) {
+      invalidated |= table_cache->InvalidateStale(requests[i]->DB(), requests[i]->Table(),
+                                                  all_tables[i].get());
+    } else {
+      table_cache->Invalidate(requests[i]->DB(), requests[i]->Table(), all_tables[i].get());
+      invalidated = true;
+    }
+  }
+  return invalidated;
+}
+
 RS_Status PKROperation::ValidateRequest() {
   // Check primary key columns
 
   for (size_t i = 0; i < no_ops; i++) {
-    PKRRequest *req                                                            = requests[i];
-    std::unordered_map<std::string, const NdbDictionary::Column *> pk_cols     = all_pk_cols[i];
-    std::unordered_map<std::string, const NdbDictionary::Column *> non_pk_cols = all_non_pk_cols[i];
-    const NdbDictionary::Table *table_dict                                     = all_table_dicts[i];
+    PKRRequest *req              = requests[i];
+    const ColumnMap &pk_cols     = all_keys[i]->key_cols;
+    const ColumnMap &non_pk_cols = all_keys[i]->non_key_cols;
+
+    bool isUniqueKeyOp = all_index_dicts[i] != nullptr;
+    if (isUniqueKeyOp && req->OperationType() != RDRS_PK_REQ_ID) {
+      return RS_CLIENT_ERROR(ERROR_034, std::string(" Type: ") +
+                                        std::to_string(req->OperationType()));
+    }
 
     if (req->PKColumnsCount() != pk_cols.size()) {
-      return RS_CLIENT_ERROR(ERROR_013 + std::string(" Expecting: ") +
-                             std::to_string(pk_cols.size()) +
+      return RS_CLIENT_ERROR(isUniqueKeyOp ? ERROR_045 : ERROR_013,
+                             std::string(" Expecting: ") + std::to_string(pk_cols.size()) +
                              " Got: " + std::to_string(req->PKColumnsCount()));
     }
 
@@ -254,49 +432,55 @@ RS_Status PKROperation::ValidateRequest() {
       std::unordered_map<std::string, const NdbDictionary::Column *>::const_iterator got =
           pk_cols.find(std::string(req->PKName(i)));
       if (got == pk_cols.end()) {  // not found
-        return RS_CLIENT_ERROR(ERROR_014 + std::string(" Column: ") + std::string(req->PKName(i)));
+        return RS_CLIENT_ERROR(isUniqueKeyOp ? ERROR_046 : ERROR_014,
+                               std::string(" Column: ") + std::string(req->PKName(i)));
+      }
+    }
+
+    // Check columns to write
+    // check that all columns exist
+    // check for writing blob columns
+    if (req->OperationType() != RDRS_PK_REQ_ID) {
+      for (Uint32 i = 0; i < req->ValuesCount(); i++) {
+        std::unordered_map<std::string, const NdbDictionary::Column *>::const_iterator got =
+            non_pk_cols.find(std::string(req->ValueName(i)));
+        if (got == non_pk_cols.end()) {  // not found
+          return RS_CLIENT_ERROR(ERROR_012, std::string(" Column: ") +
+                                            std::string(req->ValueName(i)));
+        }
+
+        NdbDictionary::Column::Type type = got->second->getType();
+        if (type == NdbDictionary::Column::Blob || type == NdbDictionary::Column::Text) {
+          return RS_CLIENT_ERROR(ERROR_038, std::string(" Column: ") + got->first);
+        }
       }
+      continue;
     }
 
     // Check non primary key columns
     // check that all columns exist
     // check that data return type is supported
-    // check for reading blob columns
     if (req->ReadColumnsCount() > 0) {
       for (Uint32 i = 0; i < req->ReadColumnsCount(); i++) {
         std::unordered_map<std::string, const NdbDictionary::Column *>::const_iterator got =
             non_pk_cols.find(std::string(req->ReadColumnName(i)));
         if (got == non_pk_cols.end()) {  // not found
-          return RS_CLIENT_ERROR(ERROR_012 + std::string(" Column: ") +
-                                 std::string(req->ReadColumnName(i)));
+          return RS_CLIENT_ERROR(ERROR_012, std::string(" Column: ") +
+                                            std::string(req->ReadColumnName(i)));
         }
 
         // check that the data return type is supported
-        // for now we only support DataReturnType.DEFAULT
-        if (req->ReadColumnReturnType(i) > __MAX_TYPE_NOT_A_DRT ||
-            DEFAULT_DRT != req->ReadColumnReturnType(i)) {
-          return RS_SERVER_ERROR(ERROR_025 + std::string(" Column: ") +
-                                 std::string(req->ReadColumnName(i)));
+        DataReturnType drt = req->ReadColumnReturnType(i);
+        if (drt > __MAX_TYPE_NOT_A_DRT || drt < DEFAULT_DRT) {
+          return RS_SERVER_ERROR(ERROR_025, std::string(" Column: ") +
+                                            std::string(req->ReadColumnName(i)));
         }
 
-        if (table_dict->getColumn(req->ReadColumnName(i))->getType() ==
-                NdbDictionary::Column::Blob ||
-            table_dict->getColumn(req->ReadColumnName(i))->getType() ==
-                NdbDictionary::Column::Text) {
-          return RS_SERVER_ERROR(ERROR_026 + std::string(" Column: ") +
-                                 std::string(req->ReadColumnName(i)));
-        }
-      }
-    } else {
-      // user wants to read all columns. make sure that we are not reading Blobs
-      std::unordered_map<std::string, const NdbDictionary::Column *>::const_iterator it =
-          non_pk_cols.begin();
-      while (it != non_pk_cols.end()) {
-        NdbDictionary::Column::Type type = it->second->getType();
-        if (type == NdbDictionary::Column::Blob || type == NdbDictionary::Column::Text) {
-          return RS_SERVER_ERROR(ERROR_026 + std::string(" Column: ") + it->first);
+        // only binary and string data can be encoded
+        if ((drt == BASE64_DRT || drt == HEX_DRT) && !IsBinaryOrStringColumn(got->second)) {
+          return RS_CLIENT_ERROR(ERROR_025, std::string(" Column: ") +
+                                            std::string(req->ReadColumnName(i)));
         }
-        it++;
       }
     }
   }
@@ -308,7 +492,20 @@ void PKROperation::CloseTransaction() {
   ndb_object->closeTransaction(transaction);
 }
 
-RS_Status PKROperation::PerformOperation() {
+RS_Status PKROperation::PerformOperation(const Deadline &deadline) {
+  this->deadline   = deadline;
+  RS_Status status = Perform();
+  if (IsSchemaChange(status) || schema_changed) {
+    InvalidateTables(false);
+  } else if (IsUnknownColumnOrIndex(status) && InvalidateTables(true)) {
+    // the column or the index may have been added after the tables were
+    // cached. The request is retried with the tables read again
+    status.classification = NdbError::SchemaError;
+  }
+  return status;
+}
+
+RS_Status PKROperation::Perform() {
   RS_Status status = Init();
   if (status.http_code != SUCCESS) {
     return status;
@@ -325,7 +522,7 @@ RS_Status PKROperation::PerformOperation() {
     return status;
   }
 
-  status = SetupReadOperation();
+  status = SetupOperations();
   if (status.http_code != SUCCESS) {
     this->Abort();
     return status;
@@ -343,12 +540,26 @@ RS_Status PKROperation::PerformOperation() {
     return status;
   }
 
+  // a rolled back transaction can not be committed
+  if (HasBlobs() && failed_op == nullptr) {
+    status = Commit();
+    if (status.http_code != SUCCESS) {
+      this->Abort();
+      return status;
+    }
+  }
+
   CloseTransaction();
   return RS_OK;
 }
 
+bool PKROperation::TimedOut() {
+  return timed_out;
+}
+
 RS_Status PKROperation::Abort() {
-  if (transaction != nullptr) {
+  // the transaction can not be closed while it is executing
+  if (transaction != nullptr && !timed_out) {
     NdbTransaction::CommitStatusType status = transaction->commitStatus();
     if (status == NdbTransaction::CommitStatusType::Started) {
       transaction->execute(NdbTransaction::Rollback);
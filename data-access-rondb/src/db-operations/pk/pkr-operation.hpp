@@ -20,6 +20,7 @@
 #define DATA_ACCESS_RONDB_SRC_PK_READ_PKR_OPERATION_HPP_
 
 #include <stdint.h>
+#include <memory>
 #include <string>
 #include <unordered_map>
 #include <vector>
@@ -27,35 +28,69 @@
 #include "src/db-operations/pk/pkr-request.hpp"
 #include "src/db-operations/pk/pkr-response.hpp"
 #include "src/rdrs-dal.h"
+#include "src/deadline.hpp"
+#include "src/table-cache.hpp"
 
 class PKROperation {
  private:
   Uint32 no_ops;
-  NdbTransaction *transaction = nullptr;
-  Ndb *ndb_object             = nullptr;
-  bool isBatch                = false;
+  NdbTransaction *transaction   = nullptr;
+  Ndb *ndb_object               = nullptr;
+  bool isBatch                  = false;
+  bool isTransactional          = false;    // all or nothing semantics for batched operations
+  const NdbOperation *failed_op = nullptr;  // operation that aborted the transaction
+  Deadline deadline;
+  bool timed_out      = false;  // the transaction is still in progress after the deadline
+  bool schema_changed = false;  // an operation of a batch failed with a schema error
 
   std::vector<PKRRequest *> requests;
   std::vector<PKRResponse *> responses;
   std::vector<NdbOperation *> operations;
   std::vector<std::vector<NdbRecAttr *>> all_recs;  // records that will be read from DB
+  std::vector<std::vector<NdbBlob *>> all_blobs;    // blob/text columns that will be read from DB
+  // cached tables. The references keep the tables while the operation uses them
+  std::vector<std::shared_ptr<const TableMetadata>> all_tables;
   std::vector<const NdbDictionary::Table *> all_table_dicts;
-  std::vector<std::unordered_map<std::string, const NdbDictionary::Column *>> all_non_pk_cols;
-  std::vector<std::unordered_map<std::string, const NdbDictionary::Column *>> all_pk_cols;
+  std::vector<const NdbDictionary::Index *> all_index_dicts;  // nullptr for primary key ops
+  // for unique key reads the key columns are the unique index columns
+  std::vector<const KeyMetadata *> all_keys;
 
  public:
   PKROperation(RS_Buffer *req_buff, RS_Buffer *resp_buff, Ndb *ndb_object);
 
-  PKROperation(Uint32 noOps, RS_Buffer *req_buffs, RS_Buffer *resp_buffs, Ndb *ndb_object);
+  PKROperation(Uint32 noOps, RS_Buffer *req_buffs, RS_Buffer *resp_buffs, Ndb *ndb_object,
+               bool isTransactional = false);
 
   ~PKROperation();
 
   /**
    * perform the operation
+   *
+   * @param deadline the operation fails with GATEWAY_TIMEOUT after the deadline
+   */
+  RS_Status PerformOperation(const Deadline &deadline);
+
+  /**
+   * @return true if the operation timed out while the transaction was executing.
+   * The Ndb object can not be reused then
    */
-  RS_Status PerformOperation();
+  bool TimedOut();
 
  private:
+  /**
+   * perform the operation before the deadline
+   */
+  RS_Status Perform();
+
+  /**
+   * Invalidate the cached tables after a schema change, so that the
+   * next operation reads them again
+   *
+   * @param stale_only only invalidate the tables that were not read recently
+   * @return true if a table was invalidated
+   */
+  bool InvalidateTables(bool stale_only);
+
   /**
    * start a transaction
    *
@@ -64,10 +99,17 @@ class PKROperation {
   RS_Status SetupTransaction();
 
   /**
-   * setup pk read operation
+   * setup pk read/write operations
    * @returns status
    */
-  RS_Status SetupReadOperation();
+  RS_Status SetupOperations();
+
+  /**
+   * setup pk write/delete operation
+   * @returns status
+   */
+  RS_Status SetupWriteOperation(PKRRequest *req, const NdbDictionary::Table *table_dict,
+                                NdbOperation *op);
 
   /**
    * Set primary key column values
@@ -82,6 +124,19 @@ class PKROperation {
    */
   RS_Status Execute();
 
+  /**
+   * Commit transaction. Transactions reading blobs are executed
+   * with NoCommit and are committed after the blob data is read
+   *
+   * @return status
+   */
+  RS_Status Commit();
+
+  /**
+   * Check if any of the operations reads blob columns
+   */
+  bool HasBlobs();
+
   /**
    * Close transaction
    */
@@ -116,6 +171,6 @@ class PKROperation {
    * @return status
    */
   RS_Status AppendOpRecs(bool found, PKRRequest *req, PKRResponse *resp,
-                         std::vector<NdbRecAttr *> *recs);
+                         std::vector<NdbRecAttr *> *recs, std::vector<NdbBlob *> *blobs);
 };
 #endif  // DATA_ACCESS_RONDB_SRC_PK_READ_PKR_OPERATION_HPP_
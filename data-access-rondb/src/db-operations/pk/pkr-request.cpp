@@ -55,41 +55,53 @@ Uint32 PKRRequest::PKColumnsCount() {
 }
 
 Uint32 PKRRequest::PKTupleOffset(const int n) {
+  return KVTupleOffset(PK_REQ_PK_COLS_IDX, n);
+}
+
+Uint32 PKRRequest::ValueTupleOffset(const int n) {
+  return KVTupleOffset(PK_REQ_VALUES_IDX, n);
+}
+
+Uint32 PKRRequest::BoundTupleOffset(bool lower, const int n) {
+  return KVTupleOffset(lower ? PK_REQ_LOWER_IDX : PK_REQ_UPPER_IDX, n);
+}
+
+Uint32 PKRRequest::FilterTupleOffset(const int n) {
+  return KVTupleOffset(PK_REQ_FILTER_VALUES_IDX, n);
+}
+
+Uint32 PKRRequest::KVTupleOffset(const Uint32 headerIdx, const int n) {
   // [count][kv offset1]...[kv offset n][k offset][v offset] [ bytes ... ] [koffset][v offset]...
   //                                      ^
   //          ............................|                                 ^
   //                         ...............................................|
   //
 
-  Uint32 offset = (reinterpret_cast<Uint32 *>(req->buffer))[PK_REQ_PK_COLS_IDX];
+  Uint32 offset = (reinterpret_cast<Uint32 *>(req->buffer))[headerIdx];
   Uint32 kvOffset =
       (reinterpret_cast<Uint32 *>(req->buffer))[(offset / ADDRESS_SIZE) + 1 + n];  // +1 for count
   return kvOffset;
 }
 
-const char *PKRRequest::PKName(Uint32 index) {
-  Uint32 kvOffset = PKTupleOffset(index);
-  Uint32 kOffset  = (reinterpret_cast<Uint32 *>(req->buffer))[kvOffset / 4];
+const char *PKRRequest::KVName(Uint32 kvOffset) {
+  Uint32 kOffset = (reinterpret_cast<Uint32 *>(req->buffer))[kvOffset / 4];
   return req->buffer + kOffset;
 }
 
-const char *PKRRequest::PKValueCStr(Uint32 index) {
-  Uint32 kvOffset = PKTupleOffset(index);
-  Uint32 vOffset  = (reinterpret_cast<Uint32 *>(req->buffer))[(kvOffset / 4) + 1];
+const char *PKRRequest::KVValueCStr(Uint32 kvOffset) {
+  Uint32 vOffset = (reinterpret_cast<Uint32 *>(req->buffer))[(kvOffset / 4) + 1];
 
   return req->buffer + vOffset + 2;  // skip first 2 bytes that contain size of string
 }
 
-Uint16 PKRRequest::PKValueLen(Uint32 index) {
-  Uint32 kvOffset           = PKTupleOffset(index);
+Uint16 PKRRequest::KVValueLen(Uint32 kvOffset) {
   Uint32 vOffset            = (reinterpret_cast<Uint32 *>(req->buffer))[(kvOffset / 4) + 1];
   unsigned char *data_start = (unsigned char *)req->buffer + vOffset;
   Uint16 len                = ((Uint16)data_start[1] * (Uint16)256) + (Uint16)data_start[0];
   return len;
 }
 
-int PKRRequest::PKValueNDBStr(Uint32 index, const NdbDictionary::Column *col, char **data) {
-  Uint32 kvOffset  = PKTupleOffset(index);
+int PKRRequest::KVValueNDBStr(Uint32 kvOffset, const NdbDictionary::Column *col, char **data) {
   Uint32 vOffset   = (reinterpret_cast<Uint32 *>(req->buffer))[(kvOffset / 4) + 1];
   char *data_start = req->buffer + vOffset;
 
@@ -124,6 +136,22 @@ int PKRRequest::PKValueNDBStr(Uint32 index, const NdbDictionary::Column *col, ch
   }
 }
 
+const char *PKRRequest::PKName(Uint32 index) {
+  return KVName(PKTupleOffset(index));
+}
+
+const char *PKRRequest::PKValueCStr(Uint32 index) {
+  return KVValueCStr(PKTupleOffset(index));
+}
+
+Uint16 PKRRequest::PKValueLen(Uint32 index) {
+  return KVValueLen(PKTupleOffset(index));
+}
+
+int PKRRequest::PKValueNDBStr(Uint32 index, const NdbDictionary::Column *col, char **data) {
+  return KVValueNDBStr(PKTupleOffset(index), col, data);
+}
+
 Uint32 PKRRequest::ReadColumnsCount() {
   Uint32 offset = (reinterpret_cast<Uint32 *>(req->buffer))[PK_REQ_READ_COLS_IDX];
   if (offset == 0) {
@@ -159,6 +187,39 @@ DataReturnType PKRRequest::ReadColumnReturnType(const Uint32 n) {
   return static_cast<DataReturnType>(type);
 }
 
+Uint32 PKRRequest::ValuesCount() {
+  Uint32 offset = (reinterpret_cast<Uint32 *>(req->buffer))[PK_REQ_VALUES_IDX];
+  if (offset == 0) {
+    return 0;
+  } else {
+    Uint32 count = (reinterpret_cast<Uint32 *>(req->buffer))[offset / ADDRESS_SIZE];
+    return count;
+  }
+}
+
+const char *PKRRequest::ValueName(Uint32 index) {
+  return KVName(ValueTupleOffset(index));
+}
+
+bool PKRRequest::IsNullValue(Uint32 index) {
+  // NULL values do not have a value offset
+  Uint32 kvOffset = ValueTupleOffset(index);
+  Uint32 vOffset  = (reinterpret_cast<Uint32 *>(req->buffer))[(kvOffset / 4) + 1];
+  return vOffset == 0;
+}
+
+const char *PKRRequest::ValueCStr(Uint32 index) {
+  return KVValueCStr(ValueTupleOffset(index));
+}
+
+Uint16 PKRRequest::ValueLen(Uint32 index) {
+  return KVValueLen(ValueTupleOffset(index));
+}
+
+int PKRRequest::ValueNDBStr(Uint32 index, const NdbDictionary::Column *col, char **data) {
+  return KVValueNDBStr(ValueTupleOffset(index), col, data);
+}
+
 const char *PKRRequest::OperationId() {
   Uint32 offset = (reinterpret_cast<Uint32 *>(req->buffer))[PK_REQ_OP_ID_IDX];
   if (offset != 0) {
@@ -167,3 +228,85 @@ const char *PKRRequest::OperationId() {
     return nullptr;
   }
 }
+
+const char *PKRRequest::IndexName() {
+  Uint32 offset = (reinterpret_cast<Uint32 *>(req->buffer))[PK_REQ_INDEX_IDX];
+  if (offset != 0) {
+    return req->buffer + offset;
+  } else {
+    return nullptr;
+  }
+}
+
+Uint32 PKRRequest::BoundColumnsCount(bool lower) {
+  Uint32 offset = (reinterpret_cast<Uint32 *>(req->buffer))[lower ? PK_REQ_LOWER_IDX
+                                                                   : PK_REQ_UPPER_IDX];
+  if (offset == 0) {  // unbounded
+    return 0;
+  } else {
+    Uint32 count = (reinterpret_cast<Uint32 *>(req->buffer))[offset / ADDRESS_SIZE];
+    return count;
+  }
+}
+
+const char *PKRRequest::BoundName(bool lower, Uint32 index) {
+  return KVName(BoundTupleOffset(lower, index));
+}
+
+Uint16 PKRRequest::BoundValueLen(bool lower, Uint32 index) {
+  return KVValueLen(BoundTupleOffset(lower, index));
+}
+
+const char *PKRRequest::BoundValueCStr(bool lower, Uint32 index) {
+  return KVValueCStr(BoundTupleOffset(lower, index));
+}
+
+int PKRRequest::BoundValueNDBStr(bool lower, Uint32 index, const NdbDictionary::Column *col,
+                                 char **data) {
+  return KVValueNDBStr(BoundTupleOffset(lower, index), col, data);
+}
+
+Uint32 PKRRequest::ScanFlags() {
+  return (reinterpret_cast<Uint32 *>(req->buffer))[PK_REQ_FLAGS_IDX];
+}
+
+Uint32 PKRRequest::Limit() {
+  return (reinterpret_cast<Uint32 *>(req->buffer))[PK_REQ_LIMIT_IDX];
+}
+
+Uint32 PKRRequest::FilterNodesCount() {
+  Uint32 offset = (reinterpret_cast<Uint32 *>(req->buffer))[PK_REQ_FILTER_IDX];
+  if (offset == 0) {  // not filtered
+    return 0;
+  } else {
+    Uint32 count = (reinterpret_cast<Uint32 *>(req->buffer))[offset / ADDRESS_SIZE];
+    return count;
+  }
+}
+
+Uint32 PKRRequest::FilterNodeType(Uint32 n) {
+  // [count][type][arg][type][arg]...
+  Uint32 offset = (reinterpret_cast<Uint32 *>(req->buffer))[PK_REQ_FILTER_IDX];
+  return (reinterpret_cast<Uint32 *>(req->buffer))[(offset / ADDRESS_SIZE) + 1 + (n * 2)];
+}
+
+Uint32 PKRRequest::FilterNodeArg(Uint32 n) {
+  Uint32 offset = (reinterpret_cast<Uint32 *>(req->buffer))[PK_REQ_FILTER_IDX];
+  return (reinterpret_cast<Uint32 *>(req->buffer))[(offset / ADDRESS_SIZE) + 2 + (n * 2)];
+}
+
+const char *PKRRequest::FilterColName(Uint32 index) {
+  return KVName(FilterTupleOffset(index));
+}
+
+Uint16 PKRRequest::FilterValueLen(Uint32 index) {
+  return KVValueLen(FilterTupleOffset(index));
+}
+
+const char *PKRRequest::FilterValueCStr(Uint32 index) {
+  return KVValueCStr(FilterTupleOffset(index));
+}
+
+int PKRRequest::FilterValueNDBStr(Uint32 index, const NdbDictionary::Column *col, char **data) {
+  return KVValueNDBStr(FilterTupleOffset(index), col, data);
+}
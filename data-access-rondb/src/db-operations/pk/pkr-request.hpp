@@ -35,6 +35,74 @@ class PKRRequest {
    */
   Uint32 PKTupleOffset(const int n);
 
+  /**
+   * Get offset of nth column/value pair to write
+   *
+   * @param n nth key/value pair
+   * @return offset
+   */
+  Uint32 ValueTupleOffset(const int n);
+
+  /**
+   * Get offset of nth column/value pair of an index bound
+   *
+   * @param lower lower or upper bound
+   * @param n nth key/value pair
+   * @return offset
+   */
+  Uint32 BoundTupleOffset(bool lower, const int n);
+
+  /**
+   * Get offset of nth key/value pair in a key/value section
+   *
+   * @param headerIdx header index of the key/value section
+   * @param n nth key/value pair
+   * @return offset
+   */
+  Uint32 KVTupleOffset(const Uint32 headerIdx, const int n);
+
+  /**
+   * Get offset of nth column/value pair of the scan filter
+   *
+   * @param n nth key/value pair
+   * @return offset
+   */
+  Uint32 FilterTupleOffset(const int n);
+
+  /**
+   * Get the name stored in a key/value pair
+   *
+   * @param kvOffset key/value pair offset
+   * @return name
+   */
+  const char *KVName(Uint32 kvOffset);
+
+  /**
+   * Get the value stored in a key/value pair
+   *
+   * @param kvOffset key/value pair offset
+   * @return c-string for the value
+   */
+  const char *KVValueCStr(Uint32 kvOffset);
+
+  /**
+   * Get the length of the value stored in a key/value pair
+   *
+   * @param kvOffset key/value pair offset
+   * @return length of the string
+   */
+  Uint16 KVValueLen(Uint32 kvOffset);
+
+  /**
+   * Get the value stored in a key/value pair as NDB string
+   *
+   * @param kvOffset[in]. key/value pair offset
+   * @param col[in]. ndb column
+   * @param data[out]. data
+   * @return 0 if successfull
+   */
+  int KVValueNDBStr(Uint32 kvOffset, const NdbDictionary::Column *col, char **data);
+
  public:
   explicit PKRRequest(const RS_Buffer *request);
 
@@ -130,12 +198,187 @@ class PKRRequest {
    */
   DataReturnType ReadColumnReturnType(const Uint32 n);
 
+  /**
+   * Get number of columns to write
+   * @return number of columns to write
+   */
+  Uint32 ValuesCount();
+
+  /**
+   * Get name of the column to write
+   *
+   * @param n. index
+   * @return column name
+   */
+  const char *ValueName(Uint32 n);
+
+  /**
+   * Check if the value to write is NULL
+   *
+   * @param n. index
+   * @return true if the value is NULL
+   */
+  bool IsNullValue(Uint32 n);
+
+  /**
+   * Get length of the value to write
+   *
+   * @param n. index
+   * @return length of the string
+   */
+  Uint16 ValueLen(Uint32 n);
+
+  /**
+   * Get value to write.
+   *
+   * @param n. index
+   * @return c-string for column value
+   */
+  const char *ValueCStr(Uint32 n);
+
+  /**
+   * Get value to write
+   *
+   * @param n[in]. index
+   * @param col[in]. ndb column
+   * @param data[out]. data
+   * @return 0 if successfull
+   */
+  int ValueNDBStr(Uint32 index, const NdbDictionary::Column *col, char **data);
+
   /**
    * Get operation ID
    *
    * @return operation ID
    */
   const char *OperationId();
+
+  /**
+   * Get the name of the index to scan
+   *
+   * @return index name
+   */
+  const char *IndexName();
+
+  /**
+   * Get number of columns of the lower or upper index bound
+   *
+   * @param lower. lower or upper bound
+   * @return number of bound columns
+   */
+  Uint32 BoundColumnsCount(bool lower);
+
+  /**
+   * Get bound column name
+   *
+   * @param lower. lower or upper bound
+   * @param n. index
+   * @return column name
+   */
+  const char *BoundName(bool lower, Uint32 n);
+
+  /**
+   * Get length of the bound column value
+   *
+   * @param lower. lower or upper bound
+   * @param n. index
+   * @return length of the string
+   */
+  Uint16 BoundValueLen(bool lower, Uint32 n);
+
+  /**
+   * Get bound column value.
+   *
+   * @param lower. lower or upper bound
+   * @param n. index
+   * @return c-string for column value
+   */
+  const char *BoundValueCStr(bool lower, Uint32 n);
+
+  /**
+   * Get bound column value
+   *
+   * @param lower[in]. lower or upper bound
+   * @param n[in]. index
+   * @param col[in]. ndb column
+   * @param data[out]. data
+   * @return 0 if successfull
+   */
+  int BoundValueNDBStr(bool lower, Uint32 n, const NdbDictionary::Column *col, char **data);
+
+  /**
+   * Get scan flags, e.g., RDRS_SCAN_DESCENDING
+   *
+   * @return scan flags
+   */
+  Uint32 ScanFlags();
+
+  /**
+   * Get maximum number of rows to return
+   *
+   * @return limit
+   */
+  Uint32 Limit();
+
+  /**
+   * Get number of nodes of the scan filter. The nodes are stored in
+   * prefix order. Logical nodes are followed by their operands
+   *
+   * @return number of filter nodes. 0 if the scan is not filtered
+   */
+  Uint32 FilterNodesCount();
+
+  /**
+   * Get type of the nth filter node, e.g., RDRS_FILTER_AND
+   *
+   * @param n. index
+   * @return node type
+   */
+  Uint32 FilterNodeType(Uint32 n);
+
+  /**
+   * Get argument of the nth filter node. For logical nodes it is the
+   * number of operands and for comparisons it is the index of the
+   * filter column/value pair
+   *
+   * @param n. index
+   * @return node argument
+   */
+  Uint32 FilterNodeArg(Uint32 n);
+
+  /**
+   * Get filter column name
+   *
+   * @param n. index
+   * @return column name
+   */
+  const char *FilterColName(Uint32 n);
+
+  /**
+   * Get length of the filter value
+   *
+   * @param n. index
+   * @return length of the string
+   */
+  Uint16 FilterValueLen(Uint32 n);
+
+  /**
+   * Get filter value.
+   *
+   * @param n. index
+   * @return c-string for column value
+   */
+  const char *FilterValueCStr(Uint32 n);
+
+  /**
+   * Get filter value in NDB format.
+   *
+   * @param n[in]. index
+   * @param col[in]. ndb column
+   * @param data[out]. data
+   * @return 0 if successfull
+   */
+  int FilterValueNDBStr(Uint32 n, const NdbDictionary::Column *col, char **data);
 };
 
 #endif  // DATA_ACCESS_RONDB_SRC_PK_READ_PKR_REQUEST_HPP_
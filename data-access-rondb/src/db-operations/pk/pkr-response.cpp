@@ -23,15 +23,19 @@
 #include <iostream>
 #include <sstream>
 #include <cassert>
+#include <cstdlib>
+#include <vector>
 #include "src/rondb-lib/rdrs_string.hpp"
 #include "src/mystring.hpp"
 #include "src/rdrs-const.h"
 
-PKRResponse::PKRResponse(const RS_Buffer *respBuff) {
+PKRResponse::PKRResponse(RS_Buffer *respBuff) {
   this->resp        = respBuff;
   this->writeHeader = PK_RESP_HEADER_END;
   this->WriteHeaderField(PK_RESP_OP_TYPE_IDX, RDRS_PK_RESP_ID);
   this->WriteHeaderField(PK_RESP_CAPACITY_IDX, resp->size);
+  this->WriteHeaderField(PK_RESP_ROWS_IDX, 0);
+  this->WriteHeaderField(PK_RESP_NEXT_IDX, 0);
 }
 
 RS_Status PKRResponse::WriteHeaderField(Uint32 index, Uint32 value) {
@@ -84,18 +88,77 @@ bool PKRResponse::HasCapacity(char *str) {
   return true;
 }
 
-RS_Status PKRResponse::Append_cstring(const char *str) {
-  Uint32 strl = strlen(str) + 1;  // for null terminator
-  if (strl > GetRemainingCapacity()) {
+RS_Status PKRResponse::EnsureCapacity(Uint32 bytes) {
+  if (bytes <= GetRemainingCapacity()) {
+    return RS_OK;
+  }
+
+  // leave some free space for the remaining columns to reduce the
+  // number of reallocations. the new size is rounded up for 4 bytes alignment
+  Uint64 newSize = static_cast<Uint64>(writeHeader) + bytes + resp->size;
+  newSize        = (newSize + ADDRESS_SIZE - 1) / ADDRESS_SIZE * ADDRESS_SIZE;
+  if (newSize > UINT32_MAX) {
     return RS_SERVER_ERROR(ERROR_016);
   }
 
+  char *newBuffer = static_cast<char *>(malloc(newSize));
+  if (newBuffer == nullptr) {
+    return RS_SERVER_ERROR(ERROR_016);
+  }
+  std::memcpy(newBuffer, resp->buffer, writeHeader);
+
+  // pool buffers belong to the caller. A buffer allocated by a previous
+  // attempt of a retried operation is replaced
+  if (resp->allocated) {
+    free(resp->buffer);
+  }
+  resp->buffer    = newBuffer;
+  resp->size      = newSize;
+  resp->allocated = 1;
+  this->WriteHeaderField(PK_RESP_CAPACITY_IDX, resp->size);
+  return RS_OK;
+}
+
+RS_Status PKRResponse::Append_cstring(const char *str) {
+  Uint32 strl      = strlen(str) + 1;  // for null terminator
+  RS_Status status = EnsureCapacity(strl);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
   std::memcpy(resp->buffer + writeHeader, str, strl);
   writeHeader += strl;
   return RS_OK;
 }
 
 RS_Status PKRResponse::SetNoOfColumns(Uint32 cols) {
+  Uint32 colAddr   = 0;
+  RS_Status status = StartColumns(cols, &colAddr);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  WriteHeaderField(PK_RESP_COLS_IDX, colAddr);
+  this->rows.push_back(colAddr);
+  return RS_OK;
+}
+
+RS_Status PKRResponse::SetNextKey(Uint32 cols) {
+  Uint32 colAddr   = 0;
+  RS_Status status = StartColumns(cols, &colAddr);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  WriteHeaderField(PK_RESP_NEXT_IDX, colAddr);
+  return RS_OK;
+}
+
+void PKRResponse::ClearNextKey() {
+  WriteHeaderField(PK_RESP_NEXT_IDX, 0);
+}
+
+RS_Status PKRResponse::StartColumns(Uint32 cols, Uint32 *colAddr) {
 
   if (this->writeHeader % ADDRESS_SIZE != 0) {  // 4 bytes alignment
     this->writeHeader += ADDRESS_SIZE - this->writeHeader % ADDRESS_SIZE;
@@ -106,21 +169,52 @@ RS_Status PKRResponse::SetNoOfColumns(Uint32 cols) {
   // thrid index is for isNULL
   // forth index is for data type, e.g., string or non-string data
   Uint32 spaceNeeded4Pointers = 1 * ADDRESS_SIZE + (cols * ADDRESS_SIZE * 4);  // +1 for col count
-  if (spaceNeeded4Pointers > GetRemainingCapacity()) {
-    return RS_SERVER_ERROR(ERROR_016);
+  RS_Status status            = EnsureCapacity(spaceNeeded4Pointers);
+  if (status.http_code != SUCCESS) {
+    return status;
   }
 
-  Uint32 colAddr = (this->writeHeader);
-  WriteHeaderField(PK_RESP_COLS_IDX, colAddr);
-
-  Uint32 *b = reinterpret_cast<Uint32 *>(this->resp->buffer + colAddr);
+  *colAddr  = (this->writeHeader);
+  Uint32 *b = reinterpret_cast<Uint32 *>(this->resp->buffer + *colAddr);
   b[0]      = cols;
 
   this->writeHeader = (this->writeHeader + spaceNeeded4Pointers);
+  this->colsAddr    = *colAddr;
   this->colsToWrite = cols;
+  this->colsWritten = 0;
   return RS_OK;
 }
 
+RS_Status PKRResponse::SetRows() {
+  // [count][row 1 offset]...[row n offset]
+  // each row offset points to the columns of the row
+  if (this->writeHeader % ADDRESS_SIZE != 0) {  // 4 bytes alignment
+    this->writeHeader += ADDRESS_SIZE - this->writeHeader % ADDRESS_SIZE;
+  }
+
+  Uint32 spaceNeeded = ADDRESS_SIZE + (rows.size() * ADDRESS_SIZE);  // +1 for row count
+  RS_Status status   = EnsureCapacity(spaceNeeded);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  Uint32 rowsAddr = this->writeHeader;
+  WriteHeaderField(PK_RESP_ROWS_IDX, rowsAddr);
+
+  Uint32 *b = reinterpret_cast<Uint32 *>(this->resp->buffer + rowsAddr);
+  b[0]      = rows.size();
+  for (Uint32 i = 0; i < rows.size(); i++) {
+    b[i + 1] = rows[i];
+  }
+
+  this->writeHeader += spaceNeeded;
+  return RS_OK;
+}
+
+void PKRResponse::SetNumbersAsStrings(bool enable) {
+  this->numbersAsStrings = enable;
+}
+
 RS_Status PKRResponse::SetColumnDataNull(const char *colName) {
   return SetColumnDataInt(colName, nullptr, RDRS_UNKNOWN_DATATYPE);
 }
@@ -134,29 +228,39 @@ RS_Status PKRResponse::SetColumnDataInt(const char *colName, const char *value,
   // second index is for column value
   // thrid index is for isNULL
   // forth index is for data type, e.g., string, int, date etc
-  Uint32 *b    = reinterpret_cast<Uint32 *>(this->resp->buffer);
-  Uint32 start = b[PK_RESP_COLS_IDX];
-  start += ADDRESS_SIZE;  // skip the count
-
-  int indexWritten = (start + (colsWritten * 4 * ADDRESS_SIZE)) / ADDRESS_SIZE;
+  if (numbersAsStrings &&
+      (type == RDRS_INTEGER_DATATYPE || type == RDRS_UNSIGNED_INTEGER_DATATYPE ||
+       type == RDRS_FLOAT_DATATYPE || type == RDRS_DECIMAL_DATATYPE)) {
+    type = RDRS_STRING_DATATYPE;
+  }
 
   Uint32 nameAddress = this->writeHeader;
   RS_Status status   = Append_cstring(colName);
   if (status.http_code != SUCCESS) {
     return status;
   }
-  b[indexWritten + 0] = nameAddress;
 
+  Uint32 valueAddress = this->writeHeader;
+  if (value != nullptr) {
+    status = Append_cstring(value);
+    if (status.http_code != SUCCESS) {
+      return status;
+    }
+  }
+
+  // appending data may have reallocated the buffer
+  Uint32 *b    = reinterpret_cast<Uint32 *>(this->resp->buffer);
+  Uint32 start = colsAddr;
+  start += ADDRESS_SIZE;  // skip the count
+
+  int indexWritten = (start + (colsWritten * 4 * ADDRESS_SIZE)) / ADDRESS_SIZE;
+
+  b[indexWritten + 0] = nameAddress;
   if (value == nullptr) {
     b[indexWritten + 1] = 0;                      // value address not set
     b[indexWritten + 2] = 1;                      // isNULL
     b[indexWritten + 3] = RDRS_UNKNOWN_DATATYPE;  // data type
   } else {
-    Uint32 valueAddress = this->writeHeader;
-    RS_Status status    = Append_cstring(value);
-    if (status.http_code != SUCCESS) {
-      return status;
-    }
     b[indexWritten + 1] = valueAddress;  // value address
     b[indexWritten + 2] = 0;             // isNULL
     b[indexWritten + 3] = type;          // data type
@@ -187,10 +291,6 @@ void *PKRResponse::GetWritePointer() {
 }
 
 RS_Status PKRResponse::Append_string(const char *colName, std::string value, Uint32 type) {
-  if ((value.length() + 1) > GetRemainingCapacity()) {  // +1 null terminator
-    return RS_SERVER_ERROR(ERROR_016);
-  }
-
   return SetColumnData(colName, value.c_str(), type);
 }
 
@@ -243,7 +343,7 @@ RS_Status PKRResponse::Append_d64(const char *colName, double num) {
 RS_Status PKRResponse::Append_iu64(const char *colName, Uint64 num) {
   try {
     std::string numStr = std::to_string(num);
-    return this->SetColumnData(colName, numStr.c_str(), RDRS_INTEGER_DATATYPE);
+    return this->SetColumnData(colName, numStr.c_str(), RDRS_UNSIGNED_INTEGER_DATATYPE);
   } catch (...) {
     return RS_SERVER_ERROR(ERROR_015);
   }
@@ -260,53 +360,57 @@ RS_Status PKRResponse::Append_i64(const char *colName, Int64 num) {
 
 RS_Status PKRResponse::Append_char(const char *colName, const char *fromBuff, Uint32 fromBuffLen,
                                    CHARSET_INFO *fromCS) {
+  return Append_charInt(colName, fromBuff, fromBuffLen, fromCS, true);
+}
+
+RS_Status PKRResponse::Append_text(const char *colName, const char *fromBuff, Uint32 fromBuffLen,
+                                   CHARSET_INFO *fromCS) {
+  return Append_charInt(colName, fromBuff, fromBuffLen, fromCS, false);
+}
+
+RS_Status PKRResponse::Append_charInt(const char *colName, const char *fromBuff,
+                                      Uint32 fromBuffLen, CHARSET_INFO *fromCS, bool trimPadding) {
 
   Uint32 extraSpace     = 1;  // +1 for null terminator
   Uint32 estimatedBytes = fromBuffLen + extraSpace;
 
-  if (estimatedBytes > GetRemainingCapacity()) {
-    return RS_SERVER_ERROR(ERROR_010 + std::string(" Response buffer remaining capacity: ") +
-                           std::to_string(GetRemainingCapacity()) + std::string(" Required: ") +
-                           std::to_string(estimatedBytes));
-  }
-
   // from_buffer -> printable string  -> escaped string
-  char tempBuff[estimatedBytes];
+  // TEXT columns can be large, so the temporary buffer is allocated on the heap
+  std::vector<char> tempBuff(estimatedBytes);
   const char *well_formed_error_pos;
   const char *cannot_convert_error_pos;
   const char *from_end_pos;
   const char *error_pos;
 
   /* convert_to_printable(tempBuff, tempBuffLen, fromBuffer, fromLength, fromCS, 0); */
-  int bytesFormed = well_formed_copy_nchars(fromCS, tempBuff, estimatedBytes, fromCS, fromBuff,
-                                            fromBuffLen, UINT32_MAX, &well_formed_error_pos,
-                                            &cannot_convert_error_pos, &from_end_pos);
+  int bytesFormed = well_formed_copy_nchars(fromCS, tempBuff.data(), estimatedBytes, fromCS,
+                                            fromBuff, fromBuffLen, UINT32_MAX,
+                                            &well_formed_error_pos, &cannot_convert_error_pos,
+                                            &from_end_pos);
 
   error_pos = well_formed_error_pos ? well_formed_error_pos : cannot_convert_error_pos;
   if (error_pos) {
     char printable_buff[32];
     convert_to_printable(printable_buff, sizeof(printable_buff), error_pos,
                          fromBuff + fromBuffLen - error_pos, fromCS, 6);
-    return RS_SERVER_ERROR(ERROR_008 + std::string(" Invalid string: ") +
-                           std::string(printable_buff));
+    return RS_SERVER_ERROR(ERROR_008, std::string(" Invalid string: ") +
+                                      std::string(printable_buff));
   } else if (from_end_pos < fromBuff + fromBuffLen) {
     /*
       result is longer than UINT_MAX32 and doesn't fit into String
     */
-    return RS_SERVER_ERROR(ERROR_021 + std::string(" Buffer size: ") +
-                           std::to_string(estimatedBytes) + std::string(". Bytes left to copy: ") +
-                           std::to_string((fromBuff + fromBuffLen) - from_end_pos));
+    return RS_SERVER_ERROR(ERROR_021, std::string(" Buffer size: ") +
+                                      std::to_string(estimatedBytes) +
+                                      std::string(". Bytes left to copy: ") +
+                                      std::to_string((fromBuff + fromBuffLen) - from_end_pos));
   }
-  std::string wellFormedString = std::string(tempBuff, bytesFormed);
+  std::string wellFormedString = std::string(tempBuff.data(), bytesFormed);
   // remove blank spaces that are padded to the string
   size_t endpos = wellFormedString.find_last_not_of(" ");
-  if (std::string::npos != endpos) {
+  if (trimPadding && std::string::npos != endpos) {
     wellFormedString = wellFormedString.substr(0, endpos + 1);
   }
 
   std::string escapedstr = escape_string(wellFormedString);
-  if ((escapedstr.length() + extraSpace) >= GetRemainingCapacity()) {  // +2 for quotation marks
-    return RS_SERVER_ERROR(ERROR_010);
-  }
   return this->SetColumnData(colName, escapedstr.c_str(), RDRS_STRING_DATATYPE);
 }
@@ -22,19 +22,23 @@
 #include <stdint.h>
 #include <cstring>
 #include <string>
+#include <vector>
 #include "src/rdrs-dal.h"
 #include "src/status.hpp"
 #include "src/error-strs.h"
 
 class PKRResponse {
  private:
-  const RS_Buffer *resp;
-  Uint32 writeHeader = 0;
-  Uint32 colsWritten = 0;
-  Uint32 colsToWrite = 0;
+  RS_Buffer *resp;
+  Uint32 writeHeader    = 0;
+  Uint32 colsAddr       = 0;  // address of the columns that are being written
+  Uint32 colsWritten    = 0;
+  Uint32 colsToWrite    = 0;
+  bool numbersAsStrings = false;  // return numeric data as strings
+  std::vector<Uint32> rows;       // addresses of the rows appended by scan operations
 
  public:
-  explicit PKRResponse(const RS_Buffer *respBuff);
+  explicit PKRResponse(RS_Buffer *respBuff);
 
   /**
    * Write header fields.
@@ -75,6 +79,33 @@ class PKRResponse {
    */
   RS_Status SetNoOfColumns(Uint32 cols);
 
+  /**
+   * Scan operations return multiple rows. Each row is
+   * started by calling SetNoOfColumns. This function
+   * must be called after the last row is appended and
+   * before closing the response
+   */
+  RS_Status SetRows();
+
+  /**
+   * Start the key columns of the row where a paged scan
+   * continues. The columns are appended like the columns
+   * of a row but they are not part of the rows section
+   */
+  RS_Status SetNextKey(Uint32 cols);
+
+  /**
+   * Remove the key columns set by SetNextKey, e.g.,
+   * if there are no more rows to scan
+   */
+  void ClearNextKey();
+
+  /**
+   * Return the numeric data of the following columns
+   * as strings, e.g., for STRING_DRT data return type
+   */
+  void SetNumbersAsStrings(bool enable);
+
   /**
    * Set data to null for this column
    */
@@ -173,6 +204,13 @@ class PKRResponse {
   RS_Status Append_char(const char *colName, const char *from_buffer, Uint32 from_length,
                         CHARSET_INFO *from_cs);
 
+  /**
+   * Append to response buffer. Unlike Append_char
+   * trailing spaces are not removed
+   */
+  RS_Status Append_text(const char *colName, const char *from_buffer, Uint32 from_length,
+                        CHARSET_INFO *from_cs);
+
   /**
    * Append to response buffer. Append
    */
@@ -184,6 +222,28 @@ class PKRResponse {
    */
   RS_Status SetColumnDataInt(const char *colName, const char *value, Uint32 type);
 
+  /**
+   * Reserve space for the column pointers of a row
+   *
+   * @param[in] cols. number of columns
+   * @param[out] colAddr. address of the columns
+   */
+  RS_Status StartColumns(Uint32 cols, Uint32 *colAddr);
+
+  /**
+   * Append to response buffer internal method
+   */
+  RS_Status Append_charInt(const char *colName, const char *from_buffer, Uint32 from_length,
+                           CHARSET_INFO *from_cs, bool trimPadding);
+
+  /**
+   * Make sure that the buffer can hold the given number of
+   * bytes. If needed, the response buffer is replaced with a larger
+   * buffer and marked as allocated. The caller of the native layer is
+   * responsible for freeing the new buffer
+   */
+  RS_Status EnsureCapacity(Uint32 bytes);
+
   /**
    * Check capacity if the buffer can hold the
    * data string
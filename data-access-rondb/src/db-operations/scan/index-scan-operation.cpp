@@ -0,0 +1,540 @@
+/*
+ * Copyright (C) 2022 Hopsworks AB
+ *
+ * This program is free software; you can redistribute it and/or
+ * modify it under the terms of the GNU General Public License
+ * as published by the Free Software Foundation; either version 2
+ * of the License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301,
+ * USA.
+ */
+
+#include "src/db-operations/scan/index-scan-operation.hpp"
+#include <cstring>
+#include <NdbDictionary.hpp>
+#include "src/db-operations/pk/common.hpp"
+#include "src/error-strs.h"
+#include "src/logger.hpp"
+#include "src/ndb_object_pool.hpp"
+#include "src/rdrs-const.h"
+#include "src/status.hpp"
+
+IndexScanOperation::IndexScanOperation(RS_Buffer *req_buff, RS_Buffer *resp_buff,
+                                       Ndb *ndb_object) {
+  this->request    = new PKRRequest(req_buff);
+  this->response   = new PKRResponse(resp_buff);
+  this->ndb_object = ndb_object;
+}
+
+IndexScanOperation::~IndexScanOperation() {
+  delete request;
+  delete response;
+}
+
+RS_Status IndexScanOperation::Init() {
+  if (ndb_object->setCatalogName(request->DB()) != 0) {
+    return RS_CLIENT_ERROR(ERROR_011, std::string(" Database: ") + std::string(request->DB()) +
+                                      " Table: " + request->Table());
+  }
+
+  TableCache *table_cache = NdbObjectPool::GetInstance()->GetTableCache(ndb_object);
+  if (table_cache == nullptr) {
+    return RS_SERVER_ERROR(ERROR_004);
+  }
+  RS_Status status = table_cache->GetTable(request->DB(), request->Table(), &table);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+  table_dict = table->table;
+
+  // the primary key of MySQL tables is also an ordered index
+  const char *index_name = IsTableScan() ? RDRS_PRIMARY_INDEX : request->IndexName();
+  if (index_name == nullptr) {
+    return RS_CLIENT_ERROR(ERROR_040);
+  }
+  auto cached_index = table->indexes.find(std::string(index_name));
+  if (cached_index != table->indexes.end()) {
+    index = cached_index->second;
+  }
+  if (index == nullptr && IsTableScan()) {
+    return RS_CLIENT_ERROR(ERROR_047, std::string(" Table: ") + request->Table());
+  }
+  if (index == nullptr) {
+    return RS_CLIENT_ERROR(ERROR_040, std::string(" Index: ") + request->IndexName());
+  }
+
+  if (index->getType() != NdbDictionary::Index::OrderedIndex) {
+    return RS_CLIENT_ERROR(ERROR_041, std::string(" Index: ") + request->IndexName());
+  }
+
+  // data return types of the read columns. If the read columns are
+  // not specified then all columns use the default data return type
+  for (Uint32 i = 0; i < request->ReadColumnsCount(); i++) {
+    drts[std::string(request->ReadColumnName(i))] = request->ReadColumnReturnType(i);
+  }
+  return RS_OK;
+}
+
+RS_Status IndexScanOperation::ValidateRequest() {
+  // bound columns must be a prefix of the index columns
+  bool bounds[] = {true, false};
+  for (bool lower : bounds) {
+    if (request->BoundColumnsCount(lower) > index->getNoOfColumns()) {
+      return RS_CLIENT_ERROR(ERROR_042, std::string(" Index has ") +
+                                        std::to_string(index->getNoOfColumns()) + " columns");
+    }
+
+    for (Uint32 i = 0; i < request->BoundColumnsCount(lower); i++) {
+      const char *colName = request->BoundName(lower, i);
+      if (strcmp(colName, index->getColumn(i)->getName()) != 0) {
+        return RS_CLIENT_ERROR(ERROR_042, std::string(" Column: ") + std::string(colName) +
+                                          ". Expecting: " + index->getColumn(i)->getName());
+      }
+    }
+  }
+
+  if (request->FilterNodesCount() > 0) {
+    Uint32 node      = 0;
+    RS_Status status = ValidateFilter(&node);
+    if (status.http_code != SUCCESS) {
+      return status;
+    }
+
+    if (node != request->FilterNodesCount()) {
+      return RS_CLIENT_ERROR(ERROR_048, std::string(" Unexpected filter nodes"));
+    }
+  }
+
+  // check that the read columns exist and the data return types are supported
+  for (Uint32 i = 0; i < request->ReadColumnsCount(); i++) {
+    const NdbDictionary::Column *col = table_dict->getColumn(request->ReadColumnName(i));
+    if (col == nullptr) {
+      return RS_CLIENT_ERROR(ERROR_012, std::string(" Column: ") +
+                                        std::string(request->ReadColumnName(i)));
+    }
+
+    DataReturnType drt = request->ReadColumnReturnType(i);
+    if (drt > __MAX_TYPE_NOT_A_DRT || drt < DEFAULT_DRT) {
+      return RS_SERVER_ERROR(ERROR_025, std::string(" Column: ") +
+                                        std::string(request->ReadColumnName(i)));
+    }
+
+    if ((drt == BASE64_DRT || drt == HEX_DRT) && !IsBinaryOrStringColumn(col)) {
+      return RS_CLIENT_ERROR(ERROR_025, std::string(" Column: ") +
+                                        std::string(request->ReadColumnName(i)));
+    }
+  }
+  return RS_OK;
+}
+
+RS_Status IndexScanOperation::ValidateFilter(Uint32 *node) {
+  if (*node >= request->FilterNodesCount()) {
+    return RS_CLIENT_ERROR(ERROR_048, std::string(" Missing filter operands"));
+  }
+
+  Uint32 type = request->FilterNodeType(*node);
+  Uint32 arg  = request->FilterNodeArg(*node);
+  (*node)++;
+
+  switch (type) {
+  case RDRS_FILTER_AND:
+  case RDRS_FILTER_OR:
+  case RDRS_FILTER_NOT: {
+    if (arg == 0 || (type == RDRS_FILTER_NOT && arg != 1)) {
+      return RS_CLIENT_ERROR(ERROR_048, std::string(" Wrong number of operands"));
+    }
+    for (Uint32 i = 0; i < arg; i++) {
+      RS_Status status = ValidateFilter(node);
+      if (status.http_code != SUCCESS) {
+        return status;
+      }
+    }
+    return RS_OK;
+  }
+  case RDRS_FILTER_EQ:
+  case RDRS_FILTER_NE:
+  case RDRS_FILTER_LT:
+  case RDRS_FILTER_LE:
+  case RDRS_FILTER_GT:
+  case RDRS_FILTER_GE:
+  case RDRS_FILTER_LIKE:
+  case RDRS_FILTER_IS_NULL:
+  case RDRS_FILTER_IS_NOT_NULL: {
+    const char *colName              = request->FilterColName(arg);
+    const NdbDictionary::Column *col = table_dict->getColumn(colName);
+    if (col == nullptr) {
+      return RS_CLIENT_ERROR(ERROR_012, std::string(" Column: ") + std::string(colName));
+    }
+
+    if (col->getType() == NdbDictionary::Column::Blob ||
+        col->getType() == NdbDictionary::Column::Text) {
+      return RS_CLIENT_ERROR(ERROR_048, std::string(" BLOB/TEXT columns can not be filtered.") +
+                                        " Column: " + std::string(colName));
+    }
+    return RS_OK;
+  }
+  default:
+    return RS_CLIENT_ERROR(ERROR_048, std::string(" Type: ") + std::to_string(type));
+  }
+}
+
+RS_Status IndexScanOperation::SetFilter(NdbScanFilter *filter, Uint32 *node) {
+  Uint32 type = request->FilterNodeType(*node);
+  Uint32 arg  = request->FilterNodeArg(*node);
+  (*node)++;
+
+  // NOT is a NAND group with a single operand
+  NdbScanFilter::Group group = NdbScanFilter::AND;
+  int cond                   = NdbScanFilter::COND_EQ;
+  switch (type) {
+  case RDRS_FILTER_AND:
+    group = NdbScanFilter::AND;
+    break;
+  case RDRS_FILTER_OR:
+    group = NdbScanFilter::OR;
+    break;
+  case RDRS_FILTER_NOT:
+    group = NdbScanFilter::NAND;
+    break;
+  case RDRS_FILTER_EQ:
+    cond = NdbScanFilter::COND_EQ;
+    break;
+  case RDRS_FILTER_NE:
+    cond = NdbScanFilter::COND_NE;
+    break;
+  case RDRS_FILTER_LT:
+    cond = NdbScanFilter::COND_LT;
+    break;
+  case RDRS_FILTER_LE:
+    cond = NdbScanFilter::COND_LE;
+    break;
+  case RDRS_FILTER_GT:
+    cond = NdbScanFilter::COND_GT;
+    break;
+  case RDRS_FILTER_GE:
+    cond = NdbScanFilter::COND_GE;
+    break;
+  case RDRS_FILTER_LIKE:
+    cond = NdbScanFilter::COND_LIKE;
+    break;
+  case RDRS_FILTER_IS_NULL:
+  case RDRS_FILTER_IS_NOT_NULL: {
+    const NdbDictionary::Column *col = table_dict->getColumn(request->FilterColName(arg));
+    int ret = type == RDRS_FILTER_IS_NULL ? filter->isnull(col->getColumnNo())
+                                          : filter->isnotnull(col->getColumnNo());
+    if (ret != 0) {
+      return RS_RONDB_SERVER_ERROR(filter->getNdbError(), ERROR_031);
+    }
+    return RS_OK;
+  }
+  default:
+    return RS_CLIENT_ERROR(ERROR_048, std::string(" Type: ") + std::to_string(type));
+  }
+
+  if (type == RDRS_FILTER_AND || type == RDRS_FILTER_OR || type == RDRS_FILTER_NOT) {
+    if (filter->begin(group) != 0) {
+      return RS_RONDB_SERVER_ERROR(filter->getNdbError(), ERROR_031);
+    }
+    for (Uint32 i = 0; i < arg; i++) {
+      RS_Status status = SetFilter(filter, node);
+      if (status.http_code != SUCCESS) {
+        return status;
+      }
+    }
+    if (filter->end() != 0) {
+      return RS_RONDB_SERVER_ERROR(filter->getNdbError(), ERROR_031);
+    }
+    return RS_OK;
+  }
+
+  return SetScanFilterCol(table_dict->getColumn(request->FilterColName(arg)), filter, request, arg,
+                          cond);
+}
+
+bool IndexScanOperation::IsTableScan() {
+  return request->OperationType() == RDRS_TABLE_SCAN_REQ_ID;
+}
+
+RS_Status IndexScanOperation::SetupScan() {
+  transaction = ndb_object->startTransaction(table_dict);
+  if (transaction == nullptr) {
+    return RS_RONDB_SERVER_ERROR(ndb_object->getNdbError(), ERROR_005);
+  }
+
+  scan_op = transaction->getNdbIndexScanOperation(index);
+  if (scan_op == nullptr) {
+    return RS_RONDB_SERVER_ERROR(transaction->getNdbError(), ERROR_029);
+  }
+
+  // rows are merged from all partitions in index order
+  Uint32 scan_flags = NdbScanOperation::SF_OrderBy;
+  if ((request->ScanFlags() & RDRS_SCAN_DESCENDING) != 0) {
+    scan_flags |= NdbScanOperation::SF_Descending;
+  }
+
+  if (scan_op->readTuples(NdbOperation::LM_CommittedRead, scan_flags) != 0) {
+    return RS_RONDB_SERVER_ERROR(scan_op->getNdbError(), ERROR_030);
+  }
+
+  RS_Status status = SetBound(true);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  status = SetBound(false);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  // filtering is pushed down to the data nodes
+  if (request->FilterNodesCount() > 0) {
+    NdbScanFilter filter(scan_op);
+    if (filter.begin(NdbScanFilter::AND) != 0) {
+      return RS_RONDB_SERVER_ERROR(filter.getNdbError(), ERROR_031);
+    }
+
+    Uint32 node = 0;
+    status      = SetFilter(&filter, &node);
+    if (status.http_code != SUCCESS) {
+      return status;
+    }
+
+    if (filter.end() != 0) {
+      return RS_RONDB_SERVER_ERROR(filter.getNdbError(), ERROR_031);
+    }
+  }
+
+  // the primary key of the last row is returned to continue the scan
+  if (IsTableScan()) {
+    for (int i = 0; i < table_dict->getNoOfPrimaryKeys(); i++) {
+      NdbRecAttr *rec = scan_op->getValue(table_dict->getPrimaryKey(i), nullptr);
+      if (rec == nullptr) {
+        return RS_RONDB_SERVER_ERROR(scan_op->getNdbError(), ERROR_019);
+      }
+      key_recs.push_back(rec);
+    }
+  }
+
+  // blob/text columns are read using blob handles
+  if (request->ReadColumnsCount() > 0) {
+    for (Uint32 i = 0; i < request->ReadColumnsCount(); i++) {
+      status = SetOperationReadCol(table_dict->getColumn(request->ReadColumnName(i)), scan_op,
+                                   &recs, &blobs);
+      if (status.http_code != SUCCESS) {
+        return status;
+      }
+    }
+  } else {
+    // read all columns including the primary key columns
+    for (int i = 0; i < table_dict->getNoOfColumns(); i++) {
+      status = SetOperationReadCol(table_dict->getColumn(i), scan_op, &recs, &blobs);
+      if (status.http_code != SUCCESS) {
+        return status;
+      }
+    }
+  }
+  return RS_OK;
+}
+
+RS_Status IndexScanOperation::SetBound(bool lower) {
+  Uint32 count   = request->BoundColumnsCount(lower);
+  bool inclusive = (request->ScanFlags() & (lower ? RDRS_SCAN_LOWER_BOUND_INCLUSIVE
+                                                  : RDRS_SCAN_UPPER_BOUND_INCLUSIVE)) != 0;
+
+  // only the last column of a multi column bound can be exclusive
+  for (Uint32 i = 0; i < count; i++) {
+    int bound_type;
+    if (lower) {
+      bound_type = (i == count - 1 && !inclusive) ? NdbIndexScanOperation::BoundLT
+                                                  : NdbIndexScanOperation::BoundLE;
+    } else {
+      bound_type = (i == count - 1 && !inclusive) ? NdbIndexScanOperation::BoundGT
+                                                  : NdbIndexScanOperation::BoundGE;
+    }
+
+    RS_Status status = SetOperationBoundCol(table_dict->getColumn(request->BoundName(lower, i)),
+                                            scan_op, request, i, lower, bound_type);
+    if (status.http_code != SUCCESS) {
+      return status;
+    }
+  }
+  return RS_OK;
+}
+
+RS_Status IndexScanOperation::Execute() {
+  if (ExecuteTransaction(ndb_object, transaction, NdbTransaction::NoCommit, deadline, &timed_out) !=
+      0) {
+    if (timed_out) {
+      return RS_TIMEOUT_ERROR();
+    }
+    return RS_RONDB_SERVER_ERROR(transaction->getNdbError(), ERROR_009);
+  }
+  return RS_OK;
+}
+
+static DataReturnType GetDataReturnType(std::unordered_map<std::string, DataReturnType> *drts,
+                                        const char *colName) {
+  std::unordered_map<std::string, DataReturnType>::const_iterator got =
+      drts->find(std::string(colName));
+  if (got == drts->end()) {
+    return DEFAULT_DRT;
+  }
+  return got->second;
+}
+
+RS_Status IndexScanOperation::CreateResponse() {
+  response->SetStatus(SUCCESS);
+  response->SetDB(request->DB());
+  response->SetTable(request->Table());
+  response->SetOperationID(request->OperationId());
+
+  // a limit of 0 returns all the rows
+  Uint32 limit = request->Limit();
+  Uint32 count = 0;
+  int check    = 0;
+  while ((limit == 0 || count < limit) && (check = scan_op->nextResult(true)) == 0) {
+    // the rows are fetched in batches. The scan is stopped between the rows
+    if (deadline.Expired()) {
+      return RS_TIMEOUT_ERROR();
+    }
+
+    RS_Status status = response->SetNoOfColumns(recs.size() + blobs.size());
+    if (status.http_code != SUCCESS) {
+      return status;
+    }
+
+    for (Uint32 i = 0; i < recs.size(); i++) {
+      DataReturnType drt = GetDataReturnType(&drts, recs[i]->getColumn()->getName());
+      status             = WriteColToRespBuff(recs[i], response, drt);
+      if (status.http_code != SUCCESS) {
+        return status;
+      }
+    }
+
+    for (Uint32 i = 0; i < blobs.size(); i++) {
+      DataReturnType drt = GetDataReturnType(&drts, blobs[i]->getColumn()->getName());
+      status             = WriteBlobColToRespBuff(blobs[i], response, drt);
+      if (status.http_code != SUCCESS) {
+        return status;
+      }
+    }
+    count++;
+
+    // the key must be saved before fetching the next row
+    if (!key_recs.empty() && count == limit) {
+      status = response->SetNextKey(key_recs.size());
+      if (status.http_code != SUCCESS) {
+        return status;
+      }
+      for (Uint32 i = 0; i < key_recs.size(); i++) {
+        status = WriteColToRespBuff(key_recs[i], response, DEFAULT_DRT);
+        if (status.http_code != SUCCESS) {
+          return status;
+        }
+      }
+    }
+  }
+
+  if (check == -1) {
+    return RS_RONDB_SERVER_ERROR(scan_op->getNdbError(), ERROR_009);
+  }
+
+  // the scan is continued only if there are more rows
+  if (!key_recs.empty() && limit != 0 && count == limit) {
+    check = scan_op->nextResult(true);
+    if (check == -1) {
+      return RS_RONDB_SERVER_ERROR(scan_op->getNdbError(), ERROR_009);
+    } else if (check == 1) {
+      response->ClearNextKey();
+    }
+  }
+
+  RS_Status status = response->SetRows();
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+  response->Close();
+  return RS_OK;
+}
+
+RS_Status IndexScanOperation::PerformOperation(const Deadline &deadline) {
+  this->deadline   = deadline;
+  RS_Status status = Perform();
+  if (table == nullptr) {
+    return status;
+  }
+
+  TableCache *table_cache = NdbObjectPool::GetInstance()->GetTableCache(ndb_object);
+  if (table_cache == nullptr) {
+    return status;
+  }
+  if (IsSchemaChange(status)) {
+    // the next scan reads the table again
+    table_cache->Invalidate(request->DB(), request->Table(), table.get());
+  } else if (IsUnknownColumnOrIndex(status) &&
+             table_cache->InvalidateStale(request->DB(), request->Table(), table.get())) {
+    // the column or the index may have been added after the table was
+    // cached. The scan is retried with the table read again
+    status.classification = NdbError::SchemaError;
+  }
+  return status;
+}
+
+RS_Status IndexScanOperation::Perform() {
+  RS_Status status = Init();
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  status = ValidateRequest();
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  status = SetupScan();
+  if (status.http_code != SUCCESS) {
+    this->Abort();
+    return status;
+  }
+
+  status = Execute();
+  if (status.http_code != SUCCESS) {
+    this->Abort();
+    return status;
+  }
+
+  status = CreateResponse();
+  if (status.http_code != SUCCESS) {
+    this->Abort();
+    return status;
+  }
+
+  scan_op->close();
+  ndb_object->closeTransaction(transaction);
+  return RS_OK;
+}
+
+bool IndexScanOperation::TimedOut() {
+  return timed_out;
+}
+
+RS_Status IndexScanOperation::Abort() {
+  // the transaction can not be closed while it is executing
+  if (transaction != nullptr && !timed_out) {
+    NdbTransaction::CommitStatusType status = transaction->commitStatus();
+    if (status == NdbTransaction::CommitStatusType::Started) {
+      transaction->execute(NdbTransaction::Rollback);
+    }
+    ndb_object->closeTransaction(transaction);
+  }
+
+  return RS_OK;
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright (C) 2022 Hopsworks AB
+ *
+ * This program is free software; you can redistribute it and/or
+ * modify it under the terms of the GNU General Public License
+ * as published by the Free Software Foundation; either version 2
+ * of the License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301,
+ * USA.
+ */
+#ifndef DATA_ACCESS_RONDB_SRC_DB_OPERATIONS_SCAN_INDEX_SCAN_OPERATION_HPP_
+#define DATA_ACCESS_RONDB_SRC_DB_OPERATIONS_SCAN_INDEX_SCAN_OPERATION_HPP_
+
+#include <stdint.h>
+#include <memory>
+#include <string>
+#include <unordered_map>
+#include <vector>
+#include <NdbApi.hpp>
+#include "src/db-operations/pk/pkr-request.hpp"
+#include "src/db-operations/pk/pkr-response.hpp"
+#include "src/rdrs-dal.h"
+#include "src/deadline.hpp"
+#include "src/table-cache.hpp"
+
+/**
+ * Scans an ordered index and returns the rows within the lower
+ * and upper bounds of the index. The request and response use
+ * the same buffer layout as the primary key operations.
+ *
+ * Table scans are index scans on the primary key. The rows can be
+ * filtered in the data nodes and the key of the last returned row
+ * is returned so that the scan can be continued from that row
+ */
+class IndexScanOperation {
+ private:
+  PKRRequest *request                    = nullptr;
+  PKRResponse *response                  = nullptr;
+  Ndb *ndb_object                        = nullptr;
+  NdbTransaction *transaction            = nullptr;
+  NdbIndexScanOperation *scan_op         = nullptr;
+  std::shared_ptr<const TableMetadata> table;  // cached table, kept while the scan uses it
+  const NdbDictionary::Table *table_dict = nullptr;
+  const NdbDictionary::Index *index      = nullptr;
+  Deadline deadline;
+  bool timed_out = false;  // the transaction is still in progress after the deadline
+
+  std::vector<NdbRecAttr *> recs;  // records that will be read from DB
+  std::vector<NdbBlob *> blobs;    // blob/text columns that will be read from DB
+  std::vector<NdbRecAttr *> key_recs;  // primary key columns of table scans
+  std::unordered_map<std::string, DataReturnType> drts;
+
+ public:
+  IndexScanOperation(RS_Buffer *req_buff, RS_Buffer *resp_buff, Ndb *ndb_object);
+
+  ~IndexScanOperation();
+
+  /**
+   * perform the operation
+   */
+  RS_Status PerformOperation(const Deadline &deadline);
+
+  /**
+   * @return true if the operation timed out while the transaction was executing.
+   * The Ndb object can not be reused then
+   */
+  bool TimedOut();
+
+ private:
+  /**
+   * perform the scan before the deadline
+   */
+  RS_Status Perform();
+
+  /**
+   * load table and index
+   * @return status
+   */
+  RS_Status Init();
+
+  /**
+   * Validate request
+   * @return status
+   */
+  RS_Status ValidateRequest();
+
+  /**
+   * start a transaction and set up the scan operation
+   *
+   * @return status
+   */
+  RS_Status SetupScan();
+
+  /**
+   * set the lower or upper bound of the scan
+   *
+   * @return status
+   */
+  RS_Status SetBound(bool lower);
+
+  /**
+   * validate the filter node and its operands
+   *
+   * @param[in/out] node. index of the node. On return it points to the next node
+   * @return status
+   */
+  RS_Status ValidateFilter(Uint32 *node);
+
+  /**
+   * add the filter node and its operands to the scan filter
+   *
+   * @param[in] filter
+   * @param[in/out] node. index of the node. On return it points to the next node
+   * @return status
+   */
+  RS_Status SetFilter(NdbScanFilter *filter, Uint32 *node);
+
+  /**
+   * is this a paged table scan
+   */
+  bool IsTableScan();
+
+  /**
+   * Execute transaction
+   *
+   * @return status
+   */
+  RS_Status Execute();
+
+  /**
+   * fetch the rows and create response
+   *
+   * @return status
+   */
+  RS_Status CreateResponse();
+
+  /**
+   * abort operation
+   */
+  RS_Status Abort();
+};
+#endif  // DATA_ACCESS_RONDB_SRC_DB_OPERATIONS_SCAN_INDEX_SCAN_OPERATION_HPP_
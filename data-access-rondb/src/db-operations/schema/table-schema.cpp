@@ -0,0 +1,351 @@
+/*
+ * Copyright (C) 2022 Hopsworks AB
+ *
+ * This program is free software; you can redistribute it and/or
+ * modify it under the terms of the GNU General Public License
+ * as published by the Free Software Foundation; either version 2
+ * of the License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301,
+ * USA.
+ */
+
+#include "src/db-operations/schema/table-schema.hpp"
+#include <cstdlib>
+#include <cstring>
+#include <set>
+#include <string>
+#include <vector>
+#include <NdbDictionary.hpp>
+#include "src/error-strs.h"
+#include "src/rdrs-const.h"
+#include "src/status.hpp"
+
+// copies a string and truncates it if it is too long
+static void CopyName(char *dst, const char *src, size_t size) {
+  strncpy(dst, src, size - 1);
+  dst[size - 1] = 0;
+}
+
+// number of characters of char columns. The length is in bytes
+static int CharLength(const NdbDictionary::Column *col) {
+  const CHARSET_INFO *cs = col->getCharset();
+  if (cs == nullptr || cs->mbmaxlen == 0) {
+    return col->getLength();
+  }
+  return col->getLength() / cs->mbmaxlen;
+}
+
+static std::string WithPrecision(const char *type, int precision) {
+  if (precision == 0) {
+    return type;
+  }
+  return std::string(type) + "(" + std::to_string(precision) + ")";
+}
+
+std::string NdbTypeName(const NdbDictionary::Column *col) {
+  switch (col->getType()) {
+  case NdbDictionary::Column::Tinyint:
+    return "Tinyint";
+  case NdbDictionary::Column::Tinyunsigned:
+    return "Tinyunsigned";
+  case NdbDictionary::Column::Smallint:
+    return "Smallint";
+  case NdbDictionary::Column::Smallunsigned:
+    return "Smallunsigned";
+  case NdbDictionary::Column::Mediumint:
+    return "Mediumint";
+  case NdbDictionary::Column::Mediumunsigned:
+    return "Mediumunsigned";
+  case NdbDictionary::Column::Int:
+    return "Int";
+  case NdbDictionary::Column::Unsigned:
+    return "Unsigned";
+  case NdbDictionary::Column::Bigint:
+    return "Bigint";
+  case NdbDictionary::Column::Bigunsigned:
+    return "Bigunsigned";
+  case NdbDictionary::Column::Float:
+    return "Float";
+  case NdbDictionary::Column::Double:
+    return "Double";
+  case NdbDictionary::Column::Olddecimal:
+    return "Olddecimal";
+  case NdbDictionary::Column::Olddecimalunsigned:
+    return "Olddecimalunsigned";
+  case NdbDictionary::Column::Decimal:
+    return "Decimal";
+  case NdbDictionary::Column::Decimalunsigned:
+    return "Decimalunsigned";
+  case NdbDictionary::Column::Char:
+    return "Char";
+  case NdbDictionary::Column::Varchar:
+    return "Varchar";
+  case NdbDictionary::Column::Longvarchar:
+    return "Longvarchar";
+  case NdbDictionary::Column::Binary:
+    return "Binary";
+  case NdbDictionary::Column::Varbinary:
+    return "Varbinary";
+  case NdbDictionary::Column::Longvarbinary:
+    return "Longvarbinary";
+  case NdbDictionary::Column::Datetime:
+    return "Datetime";
+  case NdbDictionary::Column::Date:
+    return "Date";
+  case NdbDictionary::Column::Blob:
+    return "Blob";
+  case NdbDictionary::Column::Text:
+    return "Text";
+  case NdbDictionary::Column::Bit:
+    return "Bit";
+  case NdbDictionary::Column::Time:
+    return "Time";
+  case NdbDictionary::Column::Year:
+    return "Year";
+  case NdbDictionary::Column::Timestamp:
+    return "Timestamp";
+  case NdbDictionary::Column::Time2:
+    return "Time2";
+  case NdbDictionary::Column::Datetime2:
+    return "Datetime2";
+  case NdbDictionary::Column::Timestamp2:
+    return "Timestamp2";
+  default:
+    return "Undefined";
+  }
+}
+
+std::string MySQLTypeName(const NdbDictionary::Column *col) {
+  std::string decimal =
+      "(" + std::to_string(col->getPrecision()) + "," + std::to_string(col->getScale()) + ")";
+
+  switch (col->getType()) {
+  case NdbDictionary::Column::Tinyint:
+    return "tinyint";
+  case NdbDictionary::Column::Tinyunsigned:
+    return "tinyint unsigned";
+  case NdbDictionary::Column::Smallint:
+    return "smallint";
+  case NdbDictionary::Column::Smallunsigned:
+    return "smallint unsigned";
+  case NdbDictionary::Column::Mediumint:
+    return "mediumint";
+  case NdbDictionary::Column::Mediumunsigned:
+    return "mediumint unsigned";
+  case NdbDictionary::Column::Int:
+    return "int";
+  case NdbDictionary::Column::Unsigned:
+    return "int unsigned";
+  case NdbDictionary::Column::Bigint:
+    return "bigint";
+  case NdbDictionary::Column::Bigunsigned:
+    return "bigint unsigned";
+  case NdbDictionary::Column::Float:
+    return "float";
+  case NdbDictionary::Column::Double:
+    return "double";
+  case NdbDictionary::Column::Olddecimal:
+  case NdbDictionary::Column::Decimal:
+    return "decimal" + decimal;
+  case NdbDictionary::Column::Olddecimalunsigned:
+  case NdbDictionary::Column::Decimalunsigned:
+    return "decimal" + decimal + " unsigned";
+  case NdbDictionary::Column::Char:
+    return "char(" + std::to_string(CharLength(col)) + ")";
+  case NdbDictionary::Column::Varchar:
+  case NdbDictionary::Column::Longvarchar:
+    return "varchar(" + std::to_string(CharLength(col)) + ")";
+  case NdbDictionary::Column::Binary:
+    return "binary(" + std::to_string(col->getLength()) + ")";
+  case NdbDictionary::Column::Varbinary:
+  case NdbDictionary::Column::Longvarbinary:
+    return "varbinary(" + std::to_string(col->getLength()) + ")";
+  case NdbDictionary::Column::Date:
+    return "date";
+  case NdbDictionary::Column::Datetime:
+    return "datetime";
+  case NdbDictionary::Column::Datetime2:
+    return WithPrecision("datetime", col->getPrecision());
+  case NdbDictionary::Column::Time:
+    return "time";
+  case NdbDictionary::Column::Time2:
+    return WithPrecision("time", col->getPrecision());
+  case NdbDictionary::Column::Timestamp:
+    return "timestamp";
+  case NdbDictionary::Column::Timestamp2:
+    return WithPrecision("timestamp", col->getPrecision());
+  case NdbDictionary::Column::Year:
+    return "year";
+  case NdbDictionary::Column::Bit:
+    return "bit(" + std::to_string(col->getLength()) + ")";
+  case NdbDictionary::Column::Blob:
+    return "blob";
+  case NdbDictionary::Column::Text:
+    return "text";
+  default:
+    return "unknown";
+  }
+}
+
+static void ColumnSchema(const NdbDictionary::Column *col, Column_Schema *schema) {
+  memset(schema, 0, sizeof(Column_Schema));
+  CopyName(schema->name, col->getName(), sizeof(schema->name));
+  CopyName(schema->ndb_type, NdbTypeName(col).c_str(), sizeof(schema->ndb_type));
+  CopyName(schema->mysql_type, MySQLTypeName(col).c_str(), sizeof(schema->mysql_type));
+  schema->nullable    = col->getNullable();
+  schema->primary_key = col->getPrimaryKey();
+
+  switch (col->getType()) {
+  case NdbDictionary::Column::Char:
+  case NdbDictionary::Column::Varchar:
+  case NdbDictionary::Column::Longvarchar:
+    schema->length = CharLength(col);
+    break;
+  case NdbDictionary::Column::Binary:
+  case NdbDictionary::Column::Varbinary:
+  case NdbDictionary::Column::Longvarbinary:
+  case NdbDictionary::Column::Bit:
+    schema->length = col->getLength();
+    break;
+  case NdbDictionary::Column::Olddecimal:
+  case NdbDictionary::Column::Olddecimalunsigned:
+  case NdbDictionary::Column::Decimal:
+  case NdbDictionary::Column::Decimalunsigned:
+    schema->precision = col->getPrecision();
+    schema->scale     = col->getScale();
+    break;
+  case NdbDictionary::Column::Time2:
+  case NdbDictionary::Column::Datetime2:
+  case NdbDictionary::Column::Timestamp2:
+    schema->precision = col->getPrecision();
+    break;
+  }
+
+  const CHARSET_INFO *cs = col->getCharset();
+  if (cs != nullptr && cs->csname != nullptr) {
+    CopyName(schema->charset, cs->csname, sizeof(schema->charset));
+  }
+}
+
+// reads the ordered and unique indexes of the table. Unique indexes are
+// returned without the $unique suffix of their NDB name
+static RS_Status IndexSchemas(const NdbDictionary::Dictionary *dict,
+                              const NdbDictionary::Table *table_dict,
+                              std::vector<Index_Schema> *indexes) {
+  NdbDictionary::Dictionary::List list;
+  if (dict->listIndexes(list, table_dict->getName()) != 0) {
+    return RS_RONDB_SERVER_ERROR(dict->getNdbError(), ERROR_052);
+  }
+
+  for (unsigned i = 0; i < list.count; i++) {
+    const NdbDictionary::Index *index =
+        dict->getIndex(list.elements[i].name, table_dict->getName());
+    if (index == nullptr) {
+      return RS_RONDB_SERVER_ERROR(dict->getNdbError(), ERROR_052);
+    }
+
+    Index_Schema schema;
+    memset(&schema, 0, sizeof(Index_Schema));
+    std::string name(index->getName());
+    if (index->getType() == NdbDictionary::Index::UniqueHashIndex) {
+      schema.type   = UNIQUE_INDEX;
+      size_t suffix = name.rfind(RDRS_UNIQUE_INDEX_SUFFIX);
+      if (suffix != std::string::npos && suffix + strlen(RDRS_UNIQUE_INDEX_SUFFIX) == name.size()) {
+        name = name.substr(0, suffix);
+      }
+    } else if (index->getType() == NdbDictionary::Index::OrderedIndex) {
+      schema.type = ORDERED_INDEX;
+    } else {
+      continue;
+    }
+    CopyName(schema.name, name.c_str(), sizeof(schema.name));
+
+    for (unsigned c = 0; c < index->getNoOfColumns() && c < SCHEMA_MAX_INDEX_COLUMNS; c++) {
+      CopyName(schema.columns[c], index->getColumn(c)->getName(), sizeof(schema.columns[c]));
+      schema.no_columns++;
+    }
+    indexes->push_back(schema);
+  }
+  return RS_OK;
+}
+
+RS_Status GetTableSchema(Ndb *ndb_object, const char *database, const char *table,
+                         Table_Schema *schema) {
+  if (ndb_object->setCatalogName(database) != 0) {
+    return RS_CLIENT_ERROR(ERROR_011, std::string(" Database: ") + std::string(database) +
+                                      " Table: " + table);
+  }
+
+  // the table is read from the data nodes, as the dictionary cache is not
+  // updated after ALTER TABLE
+  NdbDictionary::Dictionary *dict = ndb_object->getDictionary();
+  dict->invalidateTable(table);
+  const NdbDictionary::Table *table_dict = dict->getTable(table);
+  if (table_dict == nullptr) {
+    return RS_CLIENT_ERROR(ERROR_011, std::string(" Database: ") + std::string(database) +
+                                      " Table: " + table);
+  }
+
+  std::vector<Index_Schema> indexes;
+  RS_Status status = IndexSchemas(dict, table_dict, &indexes);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  schema->no_columns = table_dict->getNoOfColumns();
+  schema->columns    = (Column_Schema *)malloc(schema->no_columns * sizeof(Column_Schema));
+  for (unsigned i = 0; i < schema->no_columns; i++) {
+    ColumnSchema(table_dict->getColumn(static_cast<int>(i)), &schema->columns[i]);
+  }
+
+  schema->no_indexes = indexes.size();
+  schema->indexes    = (Index_Schema *)malloc(schema->no_indexes * sizeof(Index_Schema));
+  for (unsigned i = 0; i < schema->no_indexes; i++) {
+    schema->indexes[i] = indexes[i];
+  }
+  return RS_OK;
+}
+
+// tables of the ndbcluster engine, e.g., ndb_schema, and blob tables are
+// internal
+static bool IsInternalTable(const NdbDictionary::Dictionary::List::Element &element) {
+  return element.database == nullptr || element.name == nullptr ||
+         strcmp(element.database, "mysql") == 0 || strncmp(element.name, "NDB$", 4) == 0;
+}
+
+RS_Status ListObjects(Ndb *ndb_object, const char *database, Object_List *list) {
+  const NdbDictionary::Dictionary *dict = ndb_object->getDictionary();
+  NdbDictionary::Dictionary::List objects;
+  if (dict->listObjects(objects, NdbDictionary::Object::UserTable, false) != 0) {
+    return RS_RONDB_SERVER_ERROR(dict->getNdbError(), ERROR_053);
+  }
+
+  std::set<std::string> names;
+  for (unsigned i = 0; i < objects.count; i++) {
+    const NdbDictionary::Dictionary::List::Element &element = objects.elements[i];
+    if (element.state != NdbDictionary::Object::StateOnline || IsInternalTable(element)) {
+      continue;
+    }
+    if (database == nullptr) {
+      names.insert(element.database);
+    } else if (strcmp(element.database, database) == 0) {
+      names.insert(element.name);
+    }
+  }
+
+  list->no_names = names.size();
+  list->names    = (char(*)[SCHEMA_NAME_LEN])malloc(list->no_names * SCHEMA_NAME_LEN);
+  unsigned i     = 0;
+  for (const std::string &name : names) {
+    CopyName(list->names[i++], name.c_str(), SCHEMA_NAME_LEN);
+  }
+  return RS_OK;
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (C) 2022 Hopsworks AB
+ *
+ * This program is free software; you can redistribute it and/or
+ * modify it under the terms of the GNU General Public License
+ * as published by the Free Software Foundation; either version 2
+ * of the License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301,
+ * USA.
+ */
+#ifndef DATA_ACCESS_RONDB_SRC_DB_OPERATIONS_SCHEMA_TABLE_SCHEMA_HPP_
+#define DATA_ACCESS_RONDB_SRC_DB_OPERATIONS_SCHEMA_TABLE_SCHEMA_HPP_
+
+#include <string>
+#include <NdbApi.hpp>
+#include "src/rdrs-dal.h"
+
+/**
+ * Reads the columns and indexes of a table from the dictionary.
+ * The columns and indexes of the schema are allocated with malloc
+ *
+ * @param[in] ndb_object
+ * @param[in] database
+ * @param[in] table
+ * @param[out] schema
+ * @return status
+ */
+RS_Status GetTableSchema(Ndb *ndb_object, const char *database, const char *table,
+                         Table_Schema *schema);
+
+/**
+ * Lists the user tables of a database, or the databases that have user tables
+ * if the database is null. The names are sorted and allocated with malloc.
+ * The internal tables, e.g., the blob tables, are not listed
+ *
+ * @param[in] ndb_object
+ * @param[in] database. null to list the databases
+ * @param[out] list
+ * @return status
+ */
+RS_Status ListObjects(Ndb *ndb_object, const char *database, Object_List *list);
+
+/**
+ * @return the name of the NDB column type, e.g., Varchar
+ */
+std::string NdbTypeName(const NdbDictionary::Column *col);
+
+/**
+ * @return the MySQL type of the column, e.g., varchar(100) or int unsigned
+ */
+std::string MySQLTypeName(const NdbDictionary::Column *col);
+
+#endif  // DATA_ACCESS_RONDB_SRC_DB_OPERATIONS_SCHEMA_TABLE_SCHEMA_HPP_
@@ -0,0 +1,64 @@
+/*
+ * Copyright (C) 2022 Hopsworks AB
+ *
+ * This program is free software; you can redistribute it and/or
+ * modify it under the terms of the GNU General Public License
+ * as published by the Free Software Foundation; either version 2
+ * of the License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301,
+ * USA.
+ */
+
+#include "src/deadline.hpp"
+
+Deadline::Deadline(unsigned int timeout_ms) {
+  is_set = timeout_ms > 0;
+  time   = std::chrono::steady_clock::now() + std::chrono::milliseconds(timeout_ms);
+}
+
+bool Deadline::IsSet() const {
+  return is_set;
+}
+
+bool Deadline::Expired() const {
+  return is_set && std::chrono::steady_clock::now() >= time;
+}
+
+unsigned int Deadline::RemainingMS() const {
+  if (!is_set) {
+    return 0;
+  }
+  auto remaining = std::chrono::duration_cast<std::chrono::milliseconds>(
+      time - std::chrono::steady_clock::now());
+  return remaining.count() > 0 ? static_cast<unsigned int>(remaining.count()) : 1;
+}
+
+static void ExecuteCallback(int result, NdbTransaction *transaction, void *data) {
+  *static_cast<int *>(data) = result;
+}
+
+int ExecuteTransaction(Ndb *ndb_object, NdbTransaction *transaction,
+                       NdbTransaction::ExecType exec_type, const Deadline &deadline,
+                       bool *timed_out) {
+  *timed_out = false;
+  if (!deadline.IsSet()) {
+    return transaction->execute(exec_type);
+  }
+
+  int result = 0;
+  transaction->executeAsynchPrepare(exec_type, ExecuteCallback, &result);
+  // returns the number of completed transactions
+  if (ndb_object->sendPollNdb(deadline.RemainingMS(), 1, 1) == 0) {
+    *timed_out = true;
+    return -1;
+  }
+  return result;
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (C) 2022 Hopsworks AB
+ *
+ * This program is free software; you can redistribute it and/or
+ * modify it under the terms of the GNU General Public License
+ * as published by the Free Software Foundation; either version 2
+ * of the License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301,
+ * USA.
+ */
+
+#ifndef DATA_ACCESS_RONDB_SRC_DEADLINE_HPP_
+#define DATA_ACCESS_RONDB_SRC_DEADLINE_HPP_
+
+#include <NdbApi.hpp>
+#include <chrono>
+
+/**
+ * Deadline of a request. A timeout of 0 means no deadline
+ */
+class Deadline {
+ private:
+  bool is_set;
+  std::chrono::steady_clock::time_point time;
+
+ public:
+  explicit Deadline(unsigned int timeout_ms = 0);
+
+  bool IsSet() const;
+
+  bool Expired() const;
+
+  /**
+   * @return milliseconds left until the deadline, at least 1. 0 if the deadline is not set
+   */
+  unsigned int RemainingMS() const;
+};
+
+/**
+ * Executes the transaction like NdbTransaction::execute(). The execution is
+ * abandoned if it is not complete by the deadline, and timed_out is set.
+ * The transaction is still in progress then. It must not be closed and the
+ * Ndb object must not be reused
+ *
+ * @return 0 if successful, -1 otherwise
+ */
+int ExecuteTransaction(Ndb *ndb_object, NdbTransaction *transaction,
+                       NdbTransaction::ExecType exec_type, const Deadline &deadline,
+                       bool *timed_out);
+
+#endif  // DATA_ACCESS_RONDB_SRC_DEADLINE_HPP_
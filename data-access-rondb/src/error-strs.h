@@ -56,6 +56,27 @@ extern "C" {
 #define ERROR_030 "Failed to set lock mode."
 #define ERROR_031 "Failed to set filter."
 #define ERROR_032 "Failed to load index."
+#define ERROR_033 "Failed to set NdbOperation::setValue()."
+#define ERROR_034 "Invalid operation type."
+#define ERROR_035 "Column is not nullable."
+#define ERROR_036 "Constraint violation."
+#define ERROR_037 "Failed to start write operation."
+#define ERROR_038 "Writing BLOB/TEXT column is not supported yet."
+#define ERROR_039 "Failed to read BLOB/TEXT column."
+#define ERROR_040 "Index does not exist."
+#define ERROR_041 "Only ordered indexes can be scanned."
+#define ERROR_042 "Wrong index bound column."
+#define ERROR_043 "Failed to set index bound."
+#define ERROR_044 "Only unique indexes can be used for unique key reads."
+#define ERROR_045 "Wrong number of unique-key columns."
+#define ERROR_046 "Wrong unique-key column."
+#define ERROR_047 "Table scans require a primary key."
+#define ERROR_048 "Invalid scan filter."
+#define ERROR_049 "Not connected to RonDB."
+#define ERROR_050 "Request timed out."
+#define ERROR_051 "Request cancelled."
+#define ERROR_052 "Failed to read the indexes of the table."
+#define ERROR_053 "Failed to list the tables."
 
 #ifdef __cplusplus
 }
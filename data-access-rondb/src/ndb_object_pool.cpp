@@ -25,12 +25,23 @@
 
 NdbObjectPool *NdbObjectPool::__instance = nullptr;
 
-void NdbObjectPool::InitPool() {
-  __instance                              = new NdbObjectPool();
-  __instance->stats.ndb_objects_available = 0;
-  __instance->stats.ndb_objects_count     = 0;
-  __instance->stats.ndb_objects_created   = 0;
-  __instance->stats.ndb_objects_deleted   = 0;
+void NdbObjectPool::InitPool(Ndb_cluster_connection **connections, unsigned int count,
+                             LoadBalancing load_balancing) {
+  __instance                   = new NdbObjectPool();
+  __instance->__load_balancing = load_balancing;
+  __instance->__next           = 0;
+  for (unsigned int i = 0; i < count; i++) {
+    std::unique_ptr<Partition> partition(new Partition());
+    partition->connection                  = connections[i];
+    partition->stats.ndb_objects_available = 0;
+    partition->stats.ndb_objects_count     = 0;
+    partition->stats.ndb_objects_created   = 0;
+    partition->stats.ndb_objects_deleted   = 0;
+    partition->in_use                      = 0;
+    partition->requests                    = 0;
+    partition->table_cache.reset(new TableCache(connections[i]));
+    __instance->__partitions.push_back(std::move(partition));
+  }
 }
 
 NdbObjectPool *NdbObjectPool::GetInstance() {
@@ -42,51 +53,168 @@ NdbObjectPool *NdbObjectPool::GetInstance() {
   return __instance;
 }
 
-RS_Status NdbObjectPool::GetNdbObject(Ndb_cluster_connection *ndb_connection, Ndb **ndb_object) {
-  std::lock_guard<std::mutex> guard(__mutex);
-  RS_Status ret_status = RS_OK;
-  if (__ndb_objects.empty()) {
+NdbObjectPool::Partition *NdbObjectPool::SelectPartition() {
+  if (__load_balancing == LEAST_LOAD) {
+    Partition *selected = __partitions[0].get();
+    for (size_t i = 1; i < __partitions.size(); i++) {
+      if (__partitions[i]->in_use < selected->in_use) {
+        selected = __partitions[i].get();
+      }
+    }
+    return selected;
+  }
+
+  unsigned int next = __next.fetch_add(1, std::memory_order_relaxed);
+  return __partitions[next % __partitions.size()].get();
+}
+
+NdbObjectPool::Partition *NdbObjectPool::FindPartition(Ndb *object) {
+  Ndb_cluster_connection *connection = &object->get_ndb_cluster_connection();
+  for (size_t i = 0; i < __partitions.size(); i++) {
+    if (__partitions[i]->connection == connection) {
+      return __partitions[i].get();
+    }
+  }
+  return nullptr;
+}
+
+RS_Status NdbObjectPool::GetNdbObject(Ndb **ndb_object) {
+  Partition *partition = SelectPartition();
+  partition->in_use++;
+  partition->requests++;
 
-    *ndb_object = new Ndb(ndb_connection);
+  std::lock_guard<std::mutex> guard(partition->mutex);
+  if (partition->ndb_objects.empty()) {
+
+    *ndb_object = new Ndb(partition->connection);
     int retCode = (*ndb_object)->init();
     if (retCode != 0) {
-      ret_status = RS_SERVER_ERROR(ERROR_004 + std::string(" RetCode: ") + std::to_string(retCode));
+      delete *ndb_object;
+      *ndb_object = nullptr;
+      partition->in_use--;
+      return RS_SERVER_ERROR(ERROR_004, std::string(" RetCode: ") + std::to_string(retCode));
     }
-    __atomic_fetch_add(&stats.ndb_objects_created, 1, __ATOMIC_SEQ_CST);
-    __atomic_fetch_add(&stats.ndb_objects_count, 1, __ATOMIC_SEQ_CST);
+    __atomic_fetch_add(&partition->stats.ndb_objects_created, 1, __ATOMIC_SEQ_CST);
+    __atomic_fetch_add(&partition->stats.ndb_objects_count, 1, __ATOMIC_SEQ_CST);
   } else {
-    *ndb_object = __ndb_objects.front();
-    __ndb_objects.pop_front();
+    *ndb_object = partition->ndb_objects.front();
+    partition->ndb_objects.pop_front();
   }
-  return ret_status;
+  return RS_OK;
 }
 
 void NdbObjectPool::ReturnResource(Ndb *object) {
-  std::lock_guard<std::mutex> guard(__mutex);
+  Partition *partition = FindPartition(object);
+  if (partition == nullptr) {
+    ERROR("Ndb object does not belong to any of the cluster connections");
+    return;
+  }
+  partition->in_use--;
+
+  std::lock_guard<std::mutex> guard(partition->mutex);
   // reset transaction and cleanup
-  __ndb_objects.push_back(object);
+  partition->ndb_objects.push_back(object);
+}
+
+void NdbObjectPool::DeleteResource(Ndb *object) {
+  Partition *partition = FindPartition(object);
+  if (partition == nullptr) {
+    ERROR("Ndb object does not belong to any of the cluster connections");
+    return;
+  }
+  partition->in_use--;
+
+  delete object;
+  __atomic_fetch_add(&partition->stats.ndb_objects_deleted, 1, __ATOMIC_SEQ_CST);
+  __atomic_fetch_sub(&partition->stats.ndb_objects_count, 1, __ATOMIC_SEQ_CST);
+}
+
+TableCache *NdbObjectPool::GetTableCache(Ndb *object) {
+  Partition *partition = FindPartition(object);
+  if (partition == nullptr) {
+    ERROR("Ndb object does not belong to any of the cluster connections");
+    return nullptr;
+  }
+  return partition->table_cache.get();
 }
 
 RonDB_Stats NdbObjectPool::GetStats() {
-  std::lock_guard<std::mutex> guard(__mutex);
+  RonDB_Stats stats;
+  stats.ndb_objects_available    = 0;
+  stats.ndb_objects_count        = 0;
+  stats.ndb_objects_created      = 0;
+  stats.ndb_objects_deleted      = 0;
+  stats.ndb_op_retries           = 0;
+  stats.ndb_op_retries_exhausted = 0;
 
-  stats.ndb_objects_available = __ndb_objects.size();
+  for (auto &partition : __partitions) {
+    std::lock_guard<std::mutex> guard(partition->mutex);
+    stats.ndb_objects_available += partition->ndb_objects.size();
+    stats.ndb_objects_count += partition->stats.ndb_objects_count;
+    stats.ndb_objects_created += partition->stats.ndb_objects_created;
+    stats.ndb_objects_deleted += partition->stats.ndb_objects_deleted;
+  }
 
   return stats;
 }
 
+void NdbObjectPool::GetConnectionStats(RonDB_Connection_Stats *stats) {
+  for (size_t i = 0; i < __partitions.size(); i++) {
+    Partition *partition = __partitions[i].get();
+    std::lock_guard<std::mutex> guard(partition->mutex);
+    stats[i].node_id               = partition->connection->node_id();
+    stats[i].ndb_objects_count     = partition->stats.ndb_objects_count;
+    stats[i].ndb_objects_available = partition->ndb_objects.size();
+    stats[i].ndb_objects_in_use    = partition->in_use;
+    stats[i].requests              = partition->requests;
+  }
+}
+
+unsigned int NdbObjectPool::GetConnectionsCount() {
+  return __partitions.size();
+}
+
 RS_Status NdbObjectPool::Close() {
-  std::lock_guard<std::mutex> guard(__mutex);
+  for (auto &partition : __partitions) {
+    partition->table_cache->Clear();
+    std::lock_guard<std::mutex> guard(partition->mutex);
+
+    while (partition->ndb_objects.size() > 0) {
+      Ndb *ndb_object = partition->ndb_objects.front();
+      partition->ndb_objects.pop_front();
+      delete ndb_object;
+    }
 
-  while (__ndb_objects.size() > 0) {
-    Ndb *ndb_object = __ndb_objects.front();
-    __ndb_objects.pop_front();
-    delete ndb_object;
+    partition->stats.ndb_objects_available = 0;
+    partition->stats.ndb_objects_count     = 0;
+    partition->stats.ndb_objects_created   = 0;
+    partition->stats.ndb_objects_deleted   = 0;
   }
+  return RS_OK;
+}
+
+RS_Status NdbObjectPool::Purge() {
+  for (auto &partition : __partitions) {
+    partition->table_cache->Clear();
+    std::lock_guard<std::mutex> guard(partition->mutex);
+
+    while (partition->ndb_objects.size() > 0) {
+      Ndb *ndb_object = partition->ndb_objects.front();
+      partition->ndb_objects.pop_front();
+      delete ndb_object;
+      __atomic_fetch_add(&partition->stats.ndb_objects_deleted, 1, __ATOMIC_SEQ_CST);
+      __atomic_fetch_sub(&partition->stats.ndb_objects_count, 1, __ATOMIC_SEQ_CST);
+    }
 
-  stats.ndb_objects_available = 0;
-  stats.ndb_objects_count     = 0;
-  stats.ndb_objects_created   = 0;
-  stats.ndb_objects_deleted   = 0;
+    partition->stats.ndb_objects_available = 0;
+  }
   return RS_OK;
 }
+
+void NdbObjectPool::SetConnections(Ndb_cluster_connection **connections) {
+  for (size_t i = 0; i < __partitions.size(); i++) {
+    std::lock_guard<std::mutex> guard(__partitions[i]->mutex);
+    __partitions[i]->connection = connections[i];
+    __partitions[i]->table_cache.reset(new TableCache(connections[i]));
+  }
+}
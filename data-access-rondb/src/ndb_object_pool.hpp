@@ -21,27 +21,52 @@
 #define DATA_ACCESS_RONDB_SRC_NDB_OBJECT_POOL_HPP_
 
 #include <NdbApi.hpp>
+#include <atomic>
 #include <list>
+#include <memory>
 #include <mutex>
+#include <vector>
 #include "rdrs-dal.h"
+#include "src/table-cache.hpp"
 
+/**
+ * Pool of Ndb objects. The pool is partitioned per cluster connection and
+ * the requests are distributed over the connections
+ */
 class NdbObjectPool {
  private:
-  std::list<Ndb *> __ndb_objects;
-  std::mutex __mutex;
-  RonDB_Stats stats; 
+  // Ndb objects of one cluster connection
+  struct Partition {
+    Ndb_cluster_connection *connection;
+    std::list<Ndb *> ndb_objects;
+    std::mutex mutex;
+    RonDB_Stats stats;
+    std::atomic<unsigned int> in_use;
+    std::atomic<unsigned long long> requests;
+    std::unique_ptr<TableCache> table_cache;
+  };
+
+  std::vector<std::unique_ptr<Partition>> __partitions;
+  LoadBalancing __load_balancing;
+  std::atomic<unsigned int> __next;
 
   static NdbObjectPool *__instance; 
   NdbObjectPool() {
   }
 
+  Partition *SelectPartition();
+  Partition *FindPartition(Ndb *object);
+
  public:
   /**
    * Static method for initializing instance pool 
    *
-   * @return ObjectPool instance.
+   * @param connections cluster connections
+   * @param count number of connections
+   * @param load_balancing how the requests are distributed over the connections
    */
-  static void InitPool();
+  static void InitPool(Ndb_cluster_connection **connections, unsigned int count,
+                       LoadBalancing load_balancing);
 
   /**
    * Static method for accessing class instance.
@@ -54,11 +79,11 @@ class NdbObjectPool {
    * Returns Ndb object
    *
    * New resource will be created if all the resources
-   * were used at the time of the request.
+   * of the selected connection were used at the time of the request.
    *
    * @return Status and Resource instance.
    */
-  RS_Status GetNdbObject(Ndb_cluster_connection *ndb_connection, Ndb **ndb_object);
+  RS_Status GetNdbObject(Ndb **ndb_object);
 
   /**
    * Return resource back to the pool.
@@ -68,6 +93,23 @@ class NdbObjectPool {
    */
   void ReturnResource(Ndb *object);
 
+  /**
+   * Delete a resource instead of returning it to the pool, e.g.,
+   * if a transaction is still in progress after a request timed out.
+   *
+   * @param object Resource instance.
+   * @return void
+   */
+  void DeleteResource(Ndb *object);
+
+  /**
+   * Get the table cache of the cluster connection of an Ndb object
+   *
+   * @param object Resource instance.
+   * @return the table cache. nullptr if the object does not belong to the pool
+   */
+  TableCache *GetTableCache(Ndb *object);
+
   /**
    * Get status
    *
@@ -76,10 +118,35 @@ class NdbObjectPool {
   RonDB_Stats GetStats();
 
   /**
-   * Purge. Delete all Ndb objects
+   * Get status of each connection
+   *
+   * @param[out] stats array of at least GetConnectionsCount() elements
+   */
+  void GetConnectionStats(RonDB_Connection_Stats *stats);
+
+  /**
+   * Number of connections
+   */
+  unsigned int GetConnectionsCount();
+
+  /**
+   * Purge. Delete all Ndb objects and the cached tables
    *
    */
   RS_Status Close();
 
+  /**
+   * Delete the free Ndb objects, e.g., after the connection to
+   * the cluster is lost. The cached tables are removed. The stats are kept
+   *
+   */
+  RS_Status Purge();
+
+  /**
+   * Replace the connections after reconnecting. Must be called after Purge()
+   *
+   * @param connections new cluster connections. Same count as in InitPool()
+   */
+  void SetConnections(Ndb_cluster_connection **connections);
 };
 #endif  // DATA_ACCESS_RONDB_SRC_NDB_OBJECT_POOL_HPP_
@@ -34,28 +34,80 @@ extern "C" {
 #define RDRS_BATCH_REQ_ID  3
 #define RDRS_BATCH_RESP_ID 4
 
+// Primary Key Write Request Type Identifiers
+// Responses to write requests use RDRS_PK_RESP_ID
+#define RDRS_PK_INSERT_REQ_ID 5
+#define RDRS_PK_UPDATE_REQ_ID 6
+#define RDRS_PK_UPSERT_REQ_ID 7
+#define RDRS_PK_DELETE_REQ_ID 8
+
+// Index Scan Request Type Identifier
+// Responses to scan requests use RDRS_PK_RESP_ID. The
+// rows are stored in the rows section of the response
+#define RDRS_INDEX_SCAN_REQ_ID 9
+
+// Index Scan Flags
+#define RDRS_SCAN_LOWER_BOUND_INCLUSIVE 1
+#define RDRS_SCAN_UPPER_BOUND_INCLUSIVE 2
+#define RDRS_SCAN_DESCENDING            4
+
+// Table Scan Request Type Identifier
+// Table scans are ordered by the primary key so that a scan can be
+// continued from the last returned row. Responses use RDRS_PK_RESP_ID
+#define RDRS_TABLE_SCAN_REQ_ID 10
+#define RDRS_PRIMARY_INDEX     "PRIMARY"
+
+// Scan filter node types
+// Logical nodes are followed by their operands
+#define RDRS_FILTER_AND         1
+#define RDRS_FILTER_OR          2
+#define RDRS_FILTER_NOT         3
+#define RDRS_FILTER_EQ          4
+#define RDRS_FILTER_NE          5
+#define RDRS_FILTER_LT          6
+#define RDRS_FILTER_LE          7
+#define RDRS_FILTER_GT          8
+#define RDRS_FILTER_GE          9
+#define RDRS_FILTER_LIKE        10
+#define RDRS_FILTER_IS_NULL     11
+#define RDRS_FILTER_IS_NOT_NULL 12
+
+// Unique key reads use the hash part of MySQL unique indexes.
+// MySQL stores it as a separate index with this suffix
+#define RDRS_UNIQUE_INDEX_SUFFIX "$unique"
+
 // Data types
 // Everyting is a string.
 // However for RDRS_STRING_DATATYPE the string
 // is enclosed in quotes. This is now JSON works
-#define RDRS_UNKNOWN_DATATYPE  0
-#define RDRS_STRING_DATATYPE   1
-#define RDRS_INTEGER_DATATYPE  2
-#define RDRS_FLOAT_DATATYPE    3
-#define RDRS_BINARY_DATATYPE   4
-#define RDRS_DATETIME_DATATYPE 5
-#define RDRS_BIT_DATATYPE      6
+#define RDRS_UNKNOWN_DATATYPE          0
+#define RDRS_STRING_DATATYPE           1
+#define RDRS_INTEGER_DATATYPE          2
+#define RDRS_FLOAT_DATATYPE            3
+#define RDRS_BINARY_DATATYPE           4
+#define RDRS_DATETIME_DATATYPE         5
+#define RDRS_BIT_DATATYPE              6
+#define RDRS_DECIMAL_DATATYPE          7
+#define RDRS_UNSIGNED_INTEGER_DATATYPE 8
 
 // Primary Key Read Request Header Indexes
-#define PK_REQ_OP_TYPE_IDX   0
-#define PK_REQ_CAPACITY_IDX  1
-#define PK_REQ_LENGTH_IDX    2
-#define PK_REQ_DB_IDX        3
-#define PK_REQ_TABLE_IDX     4
-#define PK_REQ_PK_COLS_IDX   5
-#define PK_REQ_READ_COLS_IDX 6
-#define PK_REQ_OP_ID_IDX     7
-#define PK_REQ_HEADER_END    32
+#define PK_REQ_OP_TYPE_IDX       0
+#define PK_REQ_CAPACITY_IDX      1
+#define PK_REQ_LENGTH_IDX        2
+#define PK_REQ_DB_IDX            3
+#define PK_REQ_TABLE_IDX         4
+#define PK_REQ_PK_COLS_IDX       5
+#define PK_REQ_READ_COLS_IDX     6
+#define PK_REQ_OP_ID_IDX         7
+#define PK_REQ_VALUES_IDX        8
+#define PK_REQ_INDEX_IDX         9
+#define PK_REQ_LOWER_IDX         10
+#define PK_REQ_UPPER_IDX         11
+#define PK_REQ_FLAGS_IDX         12
+#define PK_REQ_LIMIT_IDX         13
+#define PK_REQ_FILTER_IDX        14
+#define PK_REQ_FILTER_VALUES_IDX 15
+#define PK_REQ_HEADER_END        64
 
 // Primary Key Read Response Header Indexes
 #define PK_RESP_OP_TYPE_IDX   0
@@ -66,7 +118,9 @@ extern "C" {
 #define PK_RESP_TABLE_IDX     5
 #define PK_RESP_COLS_IDX      6
 #define PK_RESP_OP_ID_IDX     7
-#define PK_RESP_HEADER_END    32
+#define PK_RESP_ROWS_IDX      8
+#define PK_RESP_NEXT_IDX      9
+#define PK_RESP_HEADER_END    40
 
 // Primary Key Read Request Header Indexes
 
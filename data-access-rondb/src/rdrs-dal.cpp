@@ -25,35 +25,40 @@
 #include <iostream>
 #include <iterator>
 #include <sstream>
+#include <algorithm>
+#include <vector>
 #include <NdbApi.hpp>
 #include "src/error-strs.h"
 #include "src/logger.hpp"
 #include "src/db-operations/pk/pkr-operation.hpp"
+#include "src/db-operations/scan/index-scan-operation.hpp"
+#include "src/db-operations/schema/table-schema.hpp"
 #include "src/status.hpp"
 #include "src/ndb_object_pool.hpp"
+#include "src/retry.hpp"
 #include "src/db-operations/pk/common.hpp"
 
 int GetAvailableAPINode(const char *connection_string);
 
-Ndb_cluster_connection *ndb_connection;
+// connections to the cluster. The Ndb object pool is partitioned per connection
+std::vector<Ndb_cluster_connection *> ndb_connections;
+
+// connection parameters saved for reconnecting
+static std::string saved_connection_string;
+static bool saved_find_available_node_id  = false;
+static unsigned int saved_connection_pool_size = 1;
 
 /**
- * Initialize NDB connection
+ * Create a new connection to the cluster and wait until the cluster is ready
  * @param connection_string NDB connection string {url}:{port}
  * @param find_available_node_ID if set to 1 then we will first find an available node id to
  * connect to
+ * @param connect_retries number of retries for connecting to the mgm server
+ * @param[out] connection new connection
  * @return status
  */
-RS_Status init(const char *connection_string, _Bool find_available_node_id) {
-
-  int retCode = 0;
-  DEBUG(std::string("Connecting to ") + connection_string);
-
-  retCode = ndb_init();
-  if (retCode != 0) {
-    return RS_SERVER_ERROR(ERROR_001 + std::string(" RetCode: ") + std::to_string(retCode));
-  }
-
+static RS_Status new_connection(const char *connection_string, bool find_available_node_id,
+                                int connect_retries, Ndb_cluster_connection **connection) {
   int node_id = -1;
   if (find_available_node_id == true) {
     node_id = GetAvailableAPINode(connection_string);
@@ -62,33 +67,145 @@ RS_Status init(const char *connection_string, _Bool find_available_node_id) {
     }
   }
 
+  Ndb_cluster_connection *conn;
   if (node_id != -1) {
-    ndb_connection = new Ndb_cluster_connection(connection_string, node_id);
+    conn = new Ndb_cluster_connection(connection_string, node_id);
   } else {
-    ndb_connection = new Ndb_cluster_connection(connection_string);
+    conn = new Ndb_cluster_connection(connection_string);
   }
-  retCode = ndb_connection->connect();
+
+  int retCode = conn->connect(connect_retries, 1, 0);
   if (retCode != 0) {
-    return RS_SERVER_ERROR(ERROR_002 + std::string(" RetCode: ") + std::to_string(retCode));
+    delete conn;
+    return RS_SERVER_ERROR(ERROR_002, std::string(" RetCode: ") + std::to_string(retCode));
   }
 
-  retCode = ndb_connection->wait_until_ready(30, 0);
+  retCode = conn->wait_until_ready(30, 0);
   if (retCode != 0) {
-    return RS_SERVER_ERROR(ERROR_003 + std::string(" RetCode: ") + std::to_string(retCode));
+    delete conn;
+    return RS_SERVER_ERROR(ERROR_003, std::string(" RetCode: ") + std::to_string(retCode));
+  }
+
+  *connection = conn;
+  return RS_OK;
+}
+
+/**
+ * Create the connections to the cluster. Each connection uses its own API node id
+ * @param connect_retries number of retries for connecting to the mgm server
+ * @param[out] connections new connections
+ * @return status
+ */
+static RS_Status new_connections(int connect_retries,
+                                 std::vector<Ndb_cluster_connection *> *connections) {
+  for (unsigned int i = 0; i < saved_connection_pool_size; i++) {
+    Ndb_cluster_connection *connection = nullptr;
+    RS_Status status = new_connection(saved_connection_string.c_str(),
+                                      saved_find_available_node_id, connect_retries, &connection);
+    if (status.http_code != SUCCESS) {
+      for (Ndb_cluster_connection *created : *connections) {
+        delete created;
+      }
+      connections->clear();
+      return status;
+    }
+    connections->push_back(connection);
+  }
+  return RS_OK;
+}
+
+/**
+ * Initialize NDB connections
+ * @param connection_string NDB connection string {url}:{port}
+ * @param connection_pool_size number of connections to the cluster
+ * @param load_balancing how the operations are distributed over the connections
+ * @param find_available_node_ID if set to 1 then we will first find an available node id to
+ * connect to
+ * @return status
+ */
+RS_Status init(const char *connection_string, unsigned int connection_pool_size,
+               LoadBalancing load_balancing, _Bool find_available_node_id) {
+
+  int retCode = 0;
+  DEBUG(std::string("Connecting to ") + connection_string);
+
+  retCode = ndb_init();
+  if (retCode != 0) {
+    return RS_SERVER_ERROR(ERROR_001, std::string(" RetCode: ") + std::to_string(retCode));
+  }
+
+  if (connection_pool_size == 0) {
+    connection_pool_size = 1;
+  }
+  saved_connection_string      = connection_string;
+  saved_find_available_node_id = find_available_node_id;
+  saved_connection_pool_size   = connection_pool_size;
+
+  RS_Status status = new_connections(30, &ndb_connections);
+  if (status.http_code != SUCCESS) {
+    return status;
   }
 
   // Initialize NDB Object Pool
-  NdbObjectPool::InitPool();
+  NdbObjectPool::InitPool(ndb_connections.data(), ndb_connections.size(), load_balancing);
 
   DEBUG("Connected.");
   return RS_OK;
 }
 
+/**
+ * Drop the connections to the cluster after they are lost. The Ndb objects
+ * of the lost connections are deleted. The caller must make sure that no
+ * operations are in progress
+ */
+RS_Status disconnect_cluster() {
+  try {
+    NdbObjectPool::GetInstance()->Purge();
+    for (Ndb_cluster_connection *connection : ndb_connections) {
+      delete connection;
+    }
+    ndb_connections.clear();
+  } catch (...) {
+    WARN("Exception in disconnect");
+  }
+  return RS_OK;
+}
+
+/**
+ * Connect to the cluster again after disconnect_cluster(), using the
+ * parameters passed to init(). Makes a single attempt
+ */
+RS_Status reconnect_cluster() {
+  if (!ndb_connections.empty()) {
+    return RS_OK;
+  }
+
+  DEBUG(std::string("Reconnecting to ") + saved_connection_string);
+  std::vector<Ndb_cluster_connection *> connections;
+  RS_Status status = new_connections(0, &connections);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  ndb_connections = connections;
+  NdbObjectPool::GetInstance()->SetConnections(ndb_connections.data());
+  DEBUG("Reconnected.");
+  return RS_OK;
+}
+
+RS_Status set_retry_policy(Retry_Policy policy) {
+  SetRetryPolicy(policy);
+  return RS_OK;
+}
+
 RS_Status shutdown_connection() {
   try {
     // ndb_end(0); // causes seg faults when called repeated from unit tests*/
     NdbObjectPool::GetInstance()->Close();
-    delete ndb_connection;
+    for (Ndb_cluster_connection *connection : ndb_connections) {
+      delete connection;
+    }
+    ndb_connections.clear();
   } catch (...) {
     WARN("Exception in Shutdown");
   }
@@ -107,17 +224,44 @@ RS_Status closeNDBObject(Ndb *ndb_object) {
   return RS_OK;
 }
 
-RS_Status pk_read(RS_Buffer *reqBuff, RS_Buffer *respBuff) {
+/**
+ * Releases the NDB Object used by an operation. The object is deleted
+ * if the operation timed out, as its transaction may still be in progress
+ *
+ * @param[int] ndb_object
+ * @param[int] timed_out
+ */
+static void releaseNDBObject(Ndb *ndb_object, bool timed_out) {
+  if (timed_out) {
+    NdbObjectPool::GetInstance()->DeleteResource(ndb_object);
+  } else {
+    closeNDBObject(ndb_object);
+  }
+}
+
+/**
+ * Performs a single primary key operation. The type of
+ * the operation is set in the request buffer
+ *
+ * @return status
+ */
+RS_Status pk_operation(RS_Buffer *reqBuff, RS_Buffer *respBuff, unsigned int timeout_ms) {
   Ndb *ndb_object  = nullptr;
-  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(ndb_connection, &ndb_object);
+  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(&ndb_object);
   if (status.http_code != SUCCESS) {
     return status;
   }
 
-  PKROperation pkread(reqBuff, respBuff, ndb_object);
-
-  status = pkread.PerformOperation();
-  closeNDBObject(ndb_object);
+  bool timed_out = false;
+  status         = RetryOperation(
+      [&](const Deadline &deadline) {
+        PKROperation pkread(reqBuff, respBuff, ndb_object);
+        RS_Status ret = pkread.PerformOperation(deadline);
+        timed_out     = pkread.TimedOut();
+        return ret;
+      },
+      timeout_ms);
+  releaseNDBObject(ndb_object, timed_out);
   if (status.http_code != SUCCESS) {
     return status;
   }
@@ -125,20 +269,112 @@ RS_Status pk_read(RS_Buffer *reqBuff, RS_Buffer *respBuff) {
   return RS_OK;
 }
 
+RS_Status pk_read(RS_Buffer *reqBuff, RS_Buffer *respBuff, unsigned int timeout_ms) {
+  return pk_operation(reqBuff, respBuff, timeout_ms);
+}
+
+RS_Status pk_write(RS_Buffer *reqBuff, RS_Buffer *respBuff, unsigned int timeout_ms) {
+  return pk_operation(reqBuff, respBuff, timeout_ms);
+}
+
 /**
  * Batched primary key read operation
  */
 
-RS_Status pk_batch_read(unsigned int no_req, RS_Buffer *req_buffs, RS_Buffer *resp_buffs) {
+RS_Status pk_batch_read(unsigned int no_req, RS_Buffer *req_buffs, RS_Buffer *resp_buffs,
+                        unsigned int timeout_ms) {
+  Ndb *ndb_object  = nullptr;
+  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(&ndb_object);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  bool timed_out = false;
+  status         = RetryOperation(
+      [&](const Deadline &deadline) {
+        PKROperation pkread(no_req, req_buffs, resp_buffs, ndb_object);
+        RS_Status ret = pkread.PerformOperation(deadline);
+        timed_out     = pkread.TimedOut();
+        return ret;
+      },
+      timeout_ms);
+  releaseNDBObject(ndb_object, timed_out);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  return RS_OK;
+}
+
+/**
+ * Transactional batch of primary key read/write operations
+ */
+
+RS_Status pk_batch_tx(unsigned int no_req, RS_Buffer *req_buffs, RS_Buffer *resp_buffs,
+                      unsigned int timeout_ms) {
   Ndb *ndb_object  = nullptr;
-  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(ndb_connection, &ndb_object);
+  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(&ndb_object);
   if (status.http_code != SUCCESS) {
     return status;
   }
 
-  PKROperation pkread(no_req, req_buffs, resp_buffs, ndb_object);
+  bool timed_out = false;
+  status         = RetryOperation(
+      [&](const Deadline &deadline) {
+        PKROperation pkop(no_req, req_buffs, resp_buffs, ndb_object, true);
+        RS_Status ret = pkop.PerformOperation(deadline);
+        timed_out     = pkop.TimedOut();
+        return ret;
+      },
+      timeout_ms);
+  releaseNDBObject(ndb_object, timed_out);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
 
-  status = pkread.PerformOperation();
+  return RS_OK;
+}
+
+/**
+ * Ordered index scan operation. Also used for table scans
+ * which are ordered by the primary key
+ */
+
+RS_Status index_scan(RS_Buffer *reqBuff, RS_Buffer *respBuff, unsigned int timeout_ms) {
+  Ndb *ndb_object  = nullptr;
+  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(&ndb_object);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  bool timed_out = false;
+  status         = RetryOperation(
+      [&](const Deadline &deadline) {
+        IndexScanOperation scan(reqBuff, respBuff, ndb_object);
+        RS_Status ret = scan.PerformOperation(deadline);
+        timed_out     = scan.TimedOut();
+        return ret;
+      },
+      timeout_ms);
+  releaseNDBObject(ndb_object, timed_out);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  return RS_OK;
+}
+
+/**
+ * Get the columns and indexes of a table
+ */
+RS_Status table_schema(const char *database, const char *table, Table_Schema *schema) {
+  Ndb *ndb_object  = nullptr;
+  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(&ndb_object);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  status = GetTableSchema(ndb_object, database, table, schema);
   closeNDBObject(ndb_object);
   if (status.http_code != SUCCESS) {
     return status;
@@ -147,6 +383,36 @@ RS_Status pk_batch_read(unsigned int no_req, RS_Buffer *req_buffs, RS_Buffer *re
   return RS_OK;
 }
 
+/**
+ * List the databases that have tables
+ */
+RS_Status list_databases(Object_List *list) {
+  Ndb *ndb_object  = nullptr;
+  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(&ndb_object);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  status = ListObjects(ndb_object, nullptr, list);
+  closeNDBObject(ndb_object);
+  return status;
+}
+
+/**
+ * List the tables of a database
+ */
+RS_Status list_tables(const char *database, Object_List *list) {
+  Ndb *ndb_object  = nullptr;
+  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(&ndb_object);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  status = ListObjects(ndb_object, database, list);
+  closeNDBObject(ndb_object);
+  return status;
+}
+
 /**
  * Deallocate pointer array
  */
@@ -156,10 +422,55 @@ RS_Status get_rondb_stats(RonDB_Stats *stats) {
   stats->ndb_objects_deleted   = ret.ndb_objects_deleted;
   stats->ndb_objects_count     = ret.ndb_objects_count;
   stats->ndb_objects_available = ret.ndb_objects_available;
+  GetRetryStats(&stats->ndb_op_retries, &stats->ndb_op_retries_exhausted);
+
+  return RS_OK;
+}
+
+/**
+ * Get the status of the connection to the RonDB cluster
+ */
+RS_Status get_cluster_status(RonDB_Cluster_Status *status) {
+  status->connected        = false;
+  status->data_nodes       = 0;
+  status->alive_data_nodes = 0;
+
+  if (ndb_connections.empty()) {
+    return RS_OK;
+  }
+
+  // the number of alive data nodes is the lowest of all connections
+  status->connected  = true;
+  status->data_nodes = ndb_connections[0]->no_db_nodes();
+  int alive          = ndb_connections[0]->get_no_ready();
+  for (size_t i = 1; i < ndb_connections.size(); i++) {
+    alive = std::min(alive, ndb_connections[i]->get_no_ready());
+  }
+  if (alive > 0) {
+    status->alive_data_nodes = alive;
+  }
 
   return RS_OK;
 }
 
+/**
+ * Number of cluster connections
+ */
+unsigned int get_connection_count() {
+  return NdbObjectPool::GetInstance()->GetConnectionsCount();
+}
+
+/**
+ * Get the stats of each cluster connection
+ */
+RS_Status get_connection_stats(RonDB_Connection_Stats *stats) {
+  if (ndb_connections.empty()) {
+    return RS_SERVER_ERROR(ERROR_049);
+  }
+  NdbObjectPool::GetInstance()->GetConnectionStats(stats);
+  return RS_OK;
+}
+
 static int LastConnectedInodeID = -1;
 /*
  * NDB API does not support gracefull disconnection form the
@@ -173,25 +484,33 @@ static int LastConnectedInodeID = -1;
 int GetAvailableAPINode(const char *connection_string) {
   NdbMgmHandle h;
 
+  // failures are not fatal as the mgm server may be down while reconnecting
   h = ndb_mgm_create_handle();
   if (h == 0) {
-    PANIC("Failed to create mgm handle");
+    ERROR("Failed to create mgm handle");
     return -1;
   }
 
   if (ndb_mgm_set_connectstring(h, connection_string) == -1) {
-    PANIC("Failed set mgm connect string");
+    ERROR("Failed set mgm connect string");
+    ndb_mgm_destroy_handle(&h);
     return -1;
   }
 
   if (ndb_mgm_connect(h, 0, 0, 0)) {
-    PANIC("Failed to connect to mgm node");
+    ERROR("Failed to connect to mgm node");
+    ndb_mgm_destroy_handle(&h);
     return -1;
   }
 
   // look for api nodes only
   ndb_mgm_node_type node_types[2]   = {NDB_MGM_NODE_TYPE_API, NDB_MGM_NODE_TYPE_UNKNOWN};
   struct ndb_mgm_cluster_state *ret = ndb_mgm_get_status2(h, node_types);
+  ndb_mgm_destroy_handle(&h);
+  if (ret == nullptr) {
+    ERROR("Failed to get the status of the API nodes");
+    return -1;
+  }
 
   if (ret->no_of_nodes > 1) {
     int max_node_id = ret->node_states[0].node_id;
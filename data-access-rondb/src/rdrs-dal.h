@@ -27,10 +27,15 @@ extern "C" {
 #include <stdbool.h>
 
 typedef enum HTTP_CODE {
-  SUCCESS      = 200,
-  CLIENT_ERROR = 400,
-  NOT_FOUND    = 404,
-  SERVER_ERROR = 500
+  SUCCESS             = 200,
+  CLIENT_ERROR        = 400,
+  NOT_FOUND           = 404,
+  CONFLICT            = 409,  // constraint violation, e.g., duplicate primary key
+  FAILED_DEPENDENCY   = 424,  // rolled back as another operation in the transaction failed
+  TOO_MANY_REQUESTS   = 429,  // RonDB is overloaded or out of temporary resources
+  SERVER_ERROR        = 500,
+  SERVICE_UNAVAILABLE = 503,  // data nodes are restarting or shutting down
+  GATEWAY_TIMEOUT     = 504   // RonDB timed out
 } HTTP_CODE;
 
 // Status 
@@ -42,6 +47,8 @@ typedef struct RS_Status {
   int classification;               // NdbError.ndberror_classification_enum
   int code;                         // NdbError.code
   int mysql_code;                   // NdbError.mysql_code
+  int error_code;                   // number of the error in error-strs.h, e.g., 11 for ERROR_011.
+                                    // 0 if the error is not in the catalog
   char message[RS_STATUS_MSG_LEN];  // error message.
   int err_line_no;                  // error line number
   char err_file_name[RS_STATUS_FILE_NAME_LEN];  // error file name.
@@ -57,18 +64,23 @@ typedef struct RS_LOG_MSG {
 // Data return type. You can change the return type for the column data
 // int/floats/decimal are returned as JSON Number type (default),
 // varchar/char are returned as strings (default) and varbinary as base64 (default)
-// Right now only default return type is supported
+// BASE64_DRT and HEX_DRT encode the data of binary and string columns.
+// STRING_DRT returns numbers as strings
 typedef enum DataReturnType {
   DEFAULT_DRT = 1,
-  // BASE64 = 2;
+  BASE64_DRT  = 2,
+  HEX_DRT     = 3,
+  STRING_DRT  = 4,
 
-  __MAX_TYPE_NOT_A_DRT = 1
+  __MAX_TYPE_NOT_A_DRT = 4
 } DataReturnType;
 
 // Buffer that contain request or response objects
 typedef struct RS_Buffer {
-  unsigned int size;  // Buffer size
-  char *buffer;       // Buffer
+  unsigned int size;       // Buffer size
+  char *buffer;            // Buffer
+  unsigned int allocated;  // 1 if the buffer was allocated by the native layer.
+                           // The caller frees it. 0 for the caller's pool buffers
 } RS_Buffer;
 
 typedef RS_Buffer *pRS_Buffer;
@@ -79,33 +91,181 @@ typedef struct RonDB_Stats {
   volatile unsigned int ndb_objects_deleted;
   volatile unsigned int ndb_objects_count;
   volatile unsigned int ndb_objects_available;
+  unsigned long long ndb_op_retries;            // retries after temporary errors
+  unsigned long long ndb_op_retries_exhausted;  // operations failed after all retries
 } RonDB_Stats;
 
+// Retry policy for operations failing with temporary NDB errors
+typedef struct Retry_Policy {
+  unsigned int max_retries;         // max number of retries. 0 disables the retries
+  unsigned int initial_backoff_ms;  // backoff before the first retry. Doubled for each retry
+  unsigned int max_backoff_ms;      // max backoff between retries
+  unsigned int deadline_ms;         // no retries are started after the deadline
+} Retry_Policy;
+
+// Per connection stats
+typedef struct RonDB_Connection_Stats {
+  unsigned int node_id;                // API node id of the connection
+  unsigned int ndb_objects_count;      // Ndb objects created for the connection
+  unsigned int ndb_objects_available;  // free Ndb objects
+  unsigned int ndb_objects_in_use;     // Ndb objects used by running operations
+  unsigned long long requests;         // operations served by the connection
+} RonDB_Connection_Stats;
+
+// How the operations are distributed over the cluster connections
+typedef enum LoadBalancing {
+  ROUND_ROBIN = 1,
+  LEAST_LOAD  = 2  // connection with the fewest running operations
+} LoadBalancing;
+
+// RonDB cluster connection status
+typedef struct RonDB_Cluster_Status {
+  _Bool connected;                // connection to the cluster is initialized
+  unsigned int data_nodes;        // number of data nodes in the cluster
+  unsigned int alive_data_nodes;  // number of data nodes the API node is connected to
+} RonDB_Cluster_Status;
+
+// Table schema
+#define SCHEMA_NAME_LEN          65  // max identifier length + null
+#define SCHEMA_TYPE_LEN          64
+#define SCHEMA_MAX_INDEX_COLUMNS 16  // max number of key parts in MySQL
+
+typedef struct Column_Schema {
+  char name[SCHEMA_NAME_LEN];
+  char ndb_type[SCHEMA_TYPE_LEN];    // NdbDictionary::Column::Type, e.g., Varchar
+  char mysql_type[SCHEMA_TYPE_LEN];  // e.g., varchar(100) or int unsigned
+  int length;                        // max length of char/binary columns, in characters/bytes
+  int precision;                     // precision of decimal columns, fractional seconds of time
+  int scale;                         // scale of decimal columns
+  char charset[SCHEMA_NAME_LEN];     // charset of character columns, empty otherwise
+  _Bool nullable;
+  _Bool primary_key;
+} Column_Schema;
+
+typedef enum Index_Type {
+  ORDERED_INDEX = 1,
+  UNIQUE_INDEX  = 2
+} Index_Type;
+
+typedef struct Index_Schema {
+  char name[SCHEMA_NAME_LEN];
+  Index_Type type;
+  unsigned int no_columns;
+  char columns[SCHEMA_MAX_INDEX_COLUMNS][SCHEMA_NAME_LEN];
+} Index_Schema;
+
+// The columns and indexes are allocated by table_schema() and must be
+// freed by the caller
+typedef struct Table_Schema {
+  unsigned int no_columns;
+  Column_Schema *columns;
+  unsigned int no_indexes;
+  Index_Schema *indexes;
+} Table_Schema;
+
+// The names are allocated by list_databases() and list_tables() and must be
+// freed by the caller
+typedef struct Object_List {
+  unsigned int no_names;
+  char (*names)[SCHEMA_NAME_LEN];
+} Object_List;
+
+/**
+ * Initialize connections to the database
+ */
+RS_Status init(const char *connection_string, unsigned int connection_pool_size,
+               LoadBalancing load_balancing, _Bool find_available_node_id);
+
 /**
- * Initialize connection to the database
+ * Set the retry policy for operations failing with temporary errors
  */
-RS_Status init(const char *connection_string, _Bool find_available_node_id);
+RS_Status set_retry_policy(Retry_Policy policy);
 
 /**
  * Shutdown connection
  */
 RS_Status shutdown_connection();
 
+/**
+ * Drop the connection to the cluster after it is lost
+ */
+RS_Status disconnect_cluster();
+
+/**
+ * Connect to the cluster again after disconnect_cluster()
+ */
+RS_Status reconnect_cluster();
+
+/**
+ * The operations below fail with GATEWAY_TIMEOUT if they do not complete
+ * within timeout_ms milliseconds. 0 means no timeout
+ */
+
 /**
  * Primary key read operation
  */
-RS_Status pk_read(RS_Buffer *reqBuff, RS_Buffer *respBuff);
+RS_Status pk_read(RS_Buffer *reqBuff, RS_Buffer *respBuff, unsigned int timeout_ms);
+
+/**
+ * Primary key write operation, i.e., insert, update, upsert or delete
+ */
+RS_Status pk_write(RS_Buffer *reqBuff, RS_Buffer *respBuff, unsigned int timeout_ms);
 
 /**
  * Batched primary key read operation
  */
-RS_Status pk_batch_read(unsigned int no_req, RS_Buffer *req_buffs, RS_Buffer *resp_buffs);
+RS_Status pk_batch_read(unsigned int no_req, RS_Buffer *req_buffs, RS_Buffer *resp_buffs,
+                        unsigned int timeout_ms);
+
+/**
+ * Transactional batch of primary key read/write operations. All operations
+ * are committed together, or rolled back if any of the operations fails
+ */
+RS_Status pk_batch_tx(unsigned int no_req, RS_Buffer *req_buffs, RS_Buffer *resp_buffs,
+                      unsigned int timeout_ms);
+
+/**
+ * Ordered index scan operation. Also used for table scans
+ * which are ordered by the primary key
+ */
+RS_Status index_scan(RS_Buffer *reqBuff, RS_Buffer *respBuff, unsigned int timeout_ms);
+
+/**
+ * Get the columns and indexes of a table
+ */
+RS_Status table_schema(const char *database, const char *table, Table_Schema *schema);
+
+/**
+ * List the databases that have tables
+ */
+RS_Status list_databases(Object_List *list);
+
+/**
+ * List the tables of a database
+ */
+RS_Status list_tables(const char *database, Object_List *list);
 
 /**
  * Deallocate pointer array
  */
 RS_Status get_rondb_stats(RonDB_Stats *stats);
 
+/**
+ * Number of cluster connections
+ */
+unsigned int get_connection_count();
+
+/**
+ * Get the stats of each cluster connection. The stats array must
+ * have get_connection_count() elements
+ */
+RS_Status get_connection_stats(RonDB_Connection_Stats *stats);
+
+/**
+ * Get the status of the connection to the RonDB cluster
+ */
+RS_Status get_cluster_status(RonDB_Cluster_Status *status);
+
 
 /**
 * Call back function for log messages
@@ -28,22 +28,21 @@
 #include "src/ndb_object_pool.hpp"
 #include "src/db-operations/pk/common.hpp"
 
-extern Ndb_cluster_connection *ndb_connection;
 RS_Status closeNDBObject(Ndb *ndb_object);
 
 RS_Status select_table(Ndb *ndb_object, const char *database_str, const char *table_str,
                        const NdbDictionary::Table **table_dict) {
   if (ndb_object->setCatalogName(database_str) != 0) {
-    return RS_CLIENT_ERROR(ERROR_011 + std::string(" Database: ") + std::string(database_str) +
-                           std::string(". Table: ") + std::string(table_str));
+    return RS_CLIENT_ERROR(ERROR_011, std::string(" Database: ") + std::string(database_str) +
+                                      std::string(". Table: ") + std::string(table_str));
   }
 
   const NdbDictionary::Dictionary *dict = ndb_object->getDictionary();
   *table_dict                           = dict->getTable(table_str);
 
-  if (table_dict == nullptr) {
-    return RS_CLIENT_ERROR(ERROR_011 + std::string(" Database: ") + std::string(database_str) +
-                           std::string(". Table: ") + std::string(table_str));
+  if (*table_dict == nullptr) {
+    return RS_CLIENT_ERROR(ERROR_011, std::string(" Database: ") + std::string(database_str) +
+                                      std::string(". Table: ") + std::string(table_str));
   }
   return RS_OK;
 }
@@ -67,7 +66,7 @@ RS_Status get_index_scan_op(Ndb *ndb_object, NdbTransaction *tx,
   const NdbDictionary::Index *index     = dict->getIndex(index_name, table_dict->getName());
 
   if (index == nullptr) {
-    return RS_SERVER_ERROR(ERROR_032 + std::string(" Index: ") + std::string(index_name));
+    return RS_SERVER_ERROR(ERROR_032, std::string(" Index: ") + std::string(index_name));
   }
 
   *scanOp = tx->getNdbIndexScanOperation(index);
@@ -209,7 +208,7 @@ RS_Status find_api_key_int(Ndb *ndb_object, const char *prefix, HopsworksAPIKey
 RS_Status find_api_key(const char *prefix, HopsworksAPIKey *api_key) {
 
   Ndb *ndb_object  = nullptr;
-  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(ndb_connection, &ndb_object);
+  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(&ndb_object);
   if (status.http_code != SUCCESS) {
     return status;
   }
@@ -305,7 +304,7 @@ RS_Status find_user_int(Ndb *ndb_object, Uint32 uid, HopsworksUsers *users) {
 RS_Status find_user(Uint32 uid, HopsworksUsers *users) {
 
   Ndb *ndb_object  = nullptr;
-  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(ndb_connection, &ndb_object);
+  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(&ndb_object);
   if (status.http_code != SUCCESS) {
     return status;
   }
@@ -399,7 +398,7 @@ RS_Status find_project_team(HopsworksUsers *users,
                             std::vector<HopsworksProjectTeam> *project_team_vec) {
 
   Ndb *ndb_object  = nullptr;
-  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(ndb_connection, &ndb_object);
+  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(&ndb_object);
   if (status.http_code != SUCCESS) {
     return status;
   }
@@ -511,7 +510,7 @@ RS_Status find_projects_int(Ndb *ndb_object, std::vector<HopsworksProjectTeam> *
 RS_Status find_projects_vec(std::vector<HopsworksProjectTeam> *project_team_vec,
                             std::vector<HopsworksProject> *project_vec) {
   Ndb *ndb_object  = nullptr;
-  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(ndb_connection, &ndb_object);
+  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(&ndb_object);
   if (status.http_code != SUCCESS) {
     return status;
   }
@@ -560,6 +559,26 @@ RS_Status find_all_projects(int uid, char ***projects, int *count) {
   return RS_OK;
 }
 
+RS_Status check_hopsworks_tables() {
+  Ndb *ndb_object  = nullptr;
+  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(&ndb_object);
+  if (status.http_code != SUCCESS) {
+    return status;
+  }
+
+  const char *tables[] = {"api_key", "users", "project_team", "project"};
+  for (const char *table : tables) {
+    const NdbDictionary::Table *table_dict;
+    status = select_table(ndb_object, "hopsworks", table, &table_dict);
+    if (status.http_code != SUCCESS) {
+      break;
+    }
+  }
+  closeNDBObject(ndb_object);
+
+  return status;
+}
+
 /**
  * only for testing
  */
@@ -568,10 +587,10 @@ int main(int argc, char **argv) {
   std::cout << "size of is " << sizeof(HopsworksAPIKey) << std::endl;
 
   char connection_string[] = "localhost:1186";
-  init(connection_string, true);
+  init(connection_string, 1, ROUND_ROBIN, true);
 
   Ndb *ndb_object  = nullptr;
-  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(ndb_connection, &ndb_object);
+  RS_Status status = NdbObjectPool::GetInstance()->GetNdbObject(&ndb_object);
   if (status.http_code != SUCCESS) {
     INFO("Failed to get the NDB object");
     return 1;
@@ -60,6 +60,11 @@ RS_Status find_api_key(const char *prefix, HopsworksAPIKey* api_key);
  */
 RS_Status find_all_projects(int uid, char ***projects, int *count);
 
+/*
+ * Check that the Hopsworks tables used for API key validation exist
+ */
+RS_Status check_hopsworks_tables();
+
 #endif
 
 #ifdef __cplusplus
@@ -0,0 +1,94 @@
+/*
+ * Copyright (C) 2022 Hopsworks AB
+ *
+ * This program is free software; you can redistribute it and/or
+ * modify it under the terms of the GNU General Public License
+ * as published by the Free Software Foundation; either version 2
+ * of the License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301,
+ * USA.
+ */
+
+#include "src/retry.hpp"
+#include <NdbApi.hpp>
+#include <algorithm>
+#include <atomic>
+#include <chrono>
+#include <random>
+#include <string>
+#include <thread>
+#include "src/logger.hpp"
+#include "src/table-cache.hpp"
+
+static Retry_Policy retry_policy = {0, 0, 0, 0};
+
+static std::atomic<unsigned long long> retries_count(0);
+static std::atomic<unsigned long long> retries_exhausted_count(0);
+
+void SetRetryPolicy(const Retry_Policy &policy) {
+  retry_policy = policy;
+}
+
+bool IsRetriable(const RS_Status &status) {
+  return status.http_code != SUCCESS && status.status == NdbError::TemporaryError;
+}
+
+/**
+ * Full jitter, i.e., a random delay between zero and the backoff
+ */
+static unsigned int Jitter(unsigned int backoff_ms) {
+  thread_local std::minstd_rand generator(std::random_device{}());
+  std::uniform_int_distribution<unsigned int> distribution(0, backoff_ms);
+  return distribution(generator);
+}
+
+RS_Status RetryOperation(const std::function<RS_Status(const Deadline &)> &operation,
+                         unsigned int timeout_ms) {
+  auto deadline =
+      std::chrono::steady_clock::now() + std::chrono::milliseconds(retry_policy.deadline_ms);
+  Deadline request_deadline(timeout_ms);
+  unsigned int backoff_ms = retry_policy.initial_backoff_ms;
+
+  RS_Status status = operation(request_deadline);
+  // the failed attempt invalidated the altered tables, so the operation is
+  // retried once without delay
+  if (IsSchemaChange(status)) {
+    DEBUG(std::string("Retrying operation after schema change. Error: ") + status.message);
+    retries_count++;
+    status = operation(request_deadline);
+  }
+  for (unsigned int retry = 0; IsRetriable(status); retry++) {
+    if (retry >= retry_policy.max_retries) {
+      retries_exhausted_count++;
+      return status;
+    }
+
+    auto delay = std::chrono::milliseconds(Jitter(backoff_ms));
+    if (std::chrono::steady_clock::now() + delay >= deadline ||
+        (request_deadline.IsSet() && delay.count() >= request_deadline.RemainingMS())) {
+      retries_exhausted_count++;
+      return status;
+    }
+
+    DEBUG(std::string("Retrying operation after temporary error. Error: ") + status.message);
+    std::this_thread::sleep_for(delay);
+    backoff_ms = std::min(backoff_ms * 2, retry_policy.max_backoff_ms);
+
+    retries_count++;
+    status = operation(request_deadline);
+  }
+  return status;
+}
+
+void GetRetryStats(unsigned long long *retries, unsigned long long *retries_exhausted) {
+  *retries           = retries_count;
+  *retries_exhausted = retries_exhausted_count;
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (C) 2022 Hopsworks AB
+ *
+ * This program is free software; you can redistribute it and/or
+ * modify it under the terms of the GNU General Public License
+ * as published by the Free Software Foundation; either version 2
+ * of the License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301,
+ * USA.
+ */
+
+#ifndef DATA_ACCESS_RONDB_SRC_RETRY_HPP_
+#define DATA_ACCESS_RONDB_SRC_RETRY_HPP_
+
+#include <functional>
+#include "src/rdrs-dal.h"
+#include "src/deadline.hpp"
+
+/**
+ * Set the retry policy of the operations
+ */
+void SetRetryPolicy(const Retry_Policy &policy);
+
+/**
+ * Returns true if the operation failed with a temporary NDB error,
+ * e.g., during node failover or overload. Temporary errors abort the
+ * transaction, so the operation can be retried
+ */
+bool IsRetriable(const RS_Status &status);
+
+/**
+ * Runs the operation and retries it as long as it fails with a temporary
+ * error. The retries are delayed with exponential backoff and jitter, and
+ * stop when the retries or the deadline of the retry policy are used up,
+ * or when the request would time out while waiting. An operation that
+ * failed because a table was altered is retried once without delay
+ *
+ * @param operation runs one attempt of the operation before the request deadline
+ * @param timeout_ms request timeout. 0 means no timeout
+ * @return status of the last attempt
+ */
+RS_Status RetryOperation(const std::function<RS_Status(const Deadline &)> &operation,
+                         unsigned int timeout_ms);
+
+/**
+ * Get the retry stats
+ *
+ * @param[out] retries number of retries
+ * @param[out] retries_exhausted number of operations that failed with a
+ * temporary error after using up the retries or the deadline
+ */
+void GetRetryStats(unsigned long long *retries, unsigned long long *retries_exhausted);
+
+#endif  // DATA_ACCESS_RONDB_SRC_RETRY_HPP_
@@ -25,6 +25,7 @@
 #include <iostream>
 #include <NdbApi.hpp>
 #include "src/rdrs-dal.h"
+#include "src/error-strs.h"
 
 ///**
 // * create an object of RS_Status.
@@ -39,14 +40,37 @@
 //  return charArr;
 //}
 
+/**
+ * Returns the number of an error of the catalog, see error-strs.h, e.g., 11 for
+ * ERROR_011, or 0 if the error is not in the catalog
+ */
+inline int ErrorCode(const char *error) {
+  static const char *catalog[] = {
+      ERROR_001, ERROR_002, ERROR_003, ERROR_004, ERROR_005, ERROR_006, ERROR_007, ERROR_008,
+      ERROR_009, ERROR_010, ERROR_011, ERROR_012, ERROR_013, ERROR_014, ERROR_015, ERROR_016,
+      ERROR_017, ERROR_018, ERROR_019, ERROR_020, ERROR_021, ERROR_022, ERROR_023, ERROR_024,
+      ERROR_025, ERROR_026, ERROR_027, ERROR_028, ERROR_029, ERROR_030, ERROR_031, ERROR_032,
+      ERROR_033, ERROR_034, ERROR_035, ERROR_036, ERROR_037, ERROR_038, ERROR_039, ERROR_040,
+      ERROR_041, ERROR_042, ERROR_043, ERROR_044, ERROR_045, ERROR_046, ERROR_047, ERROR_048,
+      ERROR_049, ERROR_050, ERROR_051, ERROR_052, ERROR_053};
+  for (size_t i = 0; i < sizeof(catalog) / sizeof(catalog[0]); i++) {
+    if (strcmp(error, catalog[i]) == 0) {
+      return static_cast<int>(i + 1);
+    }
+  }
+  return 0;
+}
+
 inline RS_Status __RS_ERROR(const HTTP_CODE http_code, int status, int classification, int code,
-                            int mysql_code, std::string msg, int line_no, std::string file_name) {
+                            int mysql_code, int error_code, std::string msg, int line_no,
+                            std::string file_name) {
   RS_Status ret;
   ret.http_code      = http_code;
   ret.status         = status;
   ret.classification = classification;
   ret.code           = code;
   ret.mysql_code     = mysql_code;
+  ret.error_code     = error_code;
   ret.err_line_no    = line_no;
 
   strncpy(ret.message, msg.c_str(), RS_STATUS_MSG_LEN - 1);  // last byte for null terminator char
@@ -59,25 +83,69 @@ inline RS_Status __RS_ERROR(const HTTP_CODE http_code, int status, int classific
   return ret;
 }
 
-inline RS_Status __RS_ERROR_RONDB(const struct NdbError &error, std::string msg, int lineNo,
-                                  std::string file_name) {
-  std::string userMsg = "Error: " + msg + " Error: code:" + std::to_string(error.code) +
+/**
+ * Returns the status of an error. The message is the error, usually one of
+ * the catalog, followed by the details, e.g., the column name
+ */
+inline RS_Status __RS_ERROR_MSG(const HTTP_CODE http_code, int line_no, std::string file_name,
+                                const char *error, const std::string &details = "") {
+  return __RS_ERROR(http_code, -1, -1, -1, -1, ErrorCode(error), error + details, line_no,
+                    file_name);
+}
+
+/**
+ * Returns the http code for the classification of a RonDB error. Errors that
+ * the client can retry later are reported as such instead of the default code
+ */
+inline HTTP_CODE NdbErrorHttpCode(const HTTP_CODE default_code, const struct NdbError &error) {
+  switch (error.classification) {
+  case NdbError::ConstraintViolation:
+    return CONFLICT;
+  case NdbError::OverloadError:
+  case NdbError::TemporaryResourceError:
+    return TOO_MANY_REQUESTS;
+  case NdbError::TimeoutExpired:
+    return GATEWAY_TIMEOUT;
+  case NdbError::NodeRecoveryError:
+  case NdbError::NodeShutdown:
+    return SERVICE_UNAVAILABLE;
+  default:
+    break;
+  }
+  if (error.status == NdbError::TemporaryError) {
+    return SERVICE_UNAVAILABLE;
+  }
+  return default_code;
+}
+
+inline RS_Status __RS_ERROR_RONDB(const HTTP_CODE default_code, int lineNo, std::string file_name,
+                                  const struct NdbError &error, const char *msg,
+                                  const std::string &details = "") {
+  HTTP_CODE http_code = NdbErrorHttpCode(default_code, error);
+  std::string userMsg = std::string("Error: ") + msg + details +
+                        " Error: code:" + std::to_string(error.code) +
                         " MySQL Code: " + std::to_string(error.mysql_code) +
                         " Message: " + error.message;
-  return __RS_ERROR(SERVER_ERROR, error.status, error.classification, error.code, error.mysql_code,
-                    userMsg, lineNo, file_name);
+  return __RS_ERROR(http_code, error.status, error.classification, error.code, error.mysql_code,
+                    ErrorCode(msg), userMsg, lineNo, file_name);
 }
 
 #define __MYFILENAME__ __FILE__
 
-#define RS_OK __RS_ERROR(SUCCESS, -1, -1, -1, -1, "", 0, "");
-#define RS_CLIENT_ERROR(msg)                                                                       \
-  __RS_ERROR(CLIENT_ERROR, -1, -1, -1, -1, msg, __LINE__, __MYFILENAME__);
+// the error macros take an error, usually one of the catalog, and optionally
+// its details, e.g., RS_CLIENT_ERROR(ERROR_012, " Column: " + column)
+#define RS_OK __RS_ERROR(SUCCESS, -1, -1, -1, -1, 0, "", 0, "");
+#define RS_CLIENT_ERROR(...)                                                                       \
+  __RS_ERROR_MSG(CLIENT_ERROR, __LINE__, __MYFILENAME__, __VA_ARGS__);
 #define RS_CLIENT_404_ERROR()                                                                      \
-  __RS_ERROR(NOT_FOUND, -1, -1, -1, -1, "Not Found", __LINE__, __MYFILENAME__);
-#define RS_SERVER_ERROR(msg)                                                                       \
-  __RS_ERROR(SERVER_ERROR, -1, -1, -1, -1, msg, __LINE__, __MYFILENAME__);
-#define RS_RONDB_SERVER_ERROR(ndberror, msg)                                                       \
-  __RS_ERROR_RONDB(ndberror, msg, __LINE__, __MYFILENAME__);
+  __RS_ERROR(NOT_FOUND, -1, -1, -1, -1, 0, "Not Found", __LINE__, __MYFILENAME__);
+#define RS_TIMEOUT_ERROR()                                                                         \
+  __RS_ERROR_MSG(GATEWAY_TIMEOUT, __LINE__, __MYFILENAME__, ERROR_050);
+#define RS_SERVER_ERROR(...)                                                                       \
+  __RS_ERROR_MSG(SERVER_ERROR, __LINE__, __MYFILENAME__, __VA_ARGS__);
+#define RS_RONDB_SERVER_ERROR(ndberror, ...)                                                       \
+  __RS_ERROR_RONDB(SERVER_ERROR, __LINE__, __MYFILENAME__, ndberror, __VA_ARGS__);
+#define RS_RONDB_CLIENT_ERROR(ndberror, ...)                                                       \
+  __RS_ERROR_RONDB(CLIENT_ERROR, __LINE__, __MYFILENAME__, ndberror, __VA_ARGS__);
 
 #endif  // DATA_ACCESS_RONDB_SRC_STATUS_HPP_
@@ -0,0 +1,223 @@
+/*
+ * Copyright (C) 2022 Hopsworks AB
+ *
+ * This program is free software; you can redistribute it and/or
+ * modify it under the terms of the GNU General Public License
+ * as published by the Free Software Foundation; either version 2
+ * of the License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301,
+ * USA.
+ */
+
+#include "src/table-cache.hpp"
+#include <utility>
+#include <NdbDictionary.hpp>
+#include "src/error-strs.h"
+#include "src/logger.hpp"
+#include "src/status.hpp"
+
+static std::string TableKey(const char *database, const char *table) {
+  return std::string(database) + "/" + table;
+}
+
+// the non key columns are all the columns that are not in the key
+static void SetNonKeyColumns(const NdbDictionary::Table *table_dict, KeyMetadata *key) {
+  for (int i = 0; i < table_dict->getNoOfColumns(); i++) {
+    const NdbDictionary::Column *col = table_dict->getColumn(i);
+    std::string name(col->getName());
+    if (key->key_cols.find(name) == key->key_cols.end()) {
+      key->non_key_cols[name] = col;
+    }
+  }
+}
+
+TableCache::TableCache(Ndb_cluster_connection *connection) {
+  this->connection = connection;
+}
+
+TableCache::~TableCache() {
+  delete dict_ndb;
+}
+
+RS_Status TableCache::GetTable(const char *database, const char *table,
+                               std::shared_ptr<const TableMetadata> *metadata) {
+  std::string key                            = TableKey(database, table);
+  std::shared_ptr<const TableMetadata> found = Find(key);
+  if (found == nullptr) {
+    std::lock_guard<std::mutex> dict_guard(dict_mutex);
+    // another request may have read the table while this one was waiting
+    found = Find(key);
+    if (found == nullptr) {
+      RS_Status status = Load(database, table, &found);
+      if (status.http_code != SUCCESS) {
+        return status;
+      }
+      std::lock_guard<std::mutex> guard(mutex);
+      tables.emplace(key, found);
+    }
+  }
+  // assigned without holding the mutexes, as releasing the previous
+  // metadata, if any, locks dict_mutex
+  *metadata = std::move(found);
+  return RS_OK;
+}
+
+std::shared_ptr<const TableMetadata> TableCache::Find(const std::string &key) {
+  std::lock_guard<std::mutex> guard(mutex);
+  auto cached = tables.find(key);
+  if (cached == tables.end()) {
+    return nullptr;
+  }
+  return cached->second;
+}
+
+// reads the table and its indexes from the dictionary. Called while holding dict_mutex
+RS_Status TableCache::Load(const char *database, const char *table,
+                           std::shared_ptr<const TableMetadata> *metadata) {
+  if (dict_ndb == nullptr) {
+    Ndb *ndb    = new Ndb(connection);
+    int retCode = ndb->init();
+    if (retCode != 0) {
+      delete ndb;
+      return RS_SERVER_ERROR(ERROR_004, std::string(" RetCode: ") + std::to_string(retCode));
+    }
+    dict_ndb = ndb;
+  }
+
+  if (dict_ndb->setCatalogName(database) != 0) {
+    return RS_CLIENT_ERROR(ERROR_011, std::string(" Database: ") + std::string(database) +
+                                      " Table: " + table);
+  }
+
+  const NdbDictionary::Dictionary *dict  = dict_ndb->getDictionary();
+  const NdbDictionary::Table *table_dict = dict->getTableGlobal(table);
+  if (table_dict == nullptr) {
+    // e.g., during node failover. The operation can be retried
+    if (dict->getNdbError().status == NdbError::TemporaryError) {
+      return RS_RONDB_SERVER_ERROR(dict->getNdbError(), ERROR_011);
+    }
+    return RS_CLIENT_ERROR(ERROR_011, std::string(" Database: ") + std::string(database) +
+                                      " Table: " + table);
+  }
+
+  TableMetadata *md = new TableMetadata();
+  md->table         = table_dict;
+  for (int i = 0; i < table_dict->getNoOfPrimaryKeys(); i++) {
+    const char *name                            = table_dict->getPrimaryKey(i);
+    md->primary_key.key_cols[std::string(name)] = table_dict->getColumn(name);
+  }
+  SetNonKeyColumns(table_dict, &md->primary_key);
+
+  NdbDictionary::Dictionary::List list;
+  if (dict->listIndexes(list, table) != 0) {
+    RS_Status status = RS_RONDB_SERVER_ERROR(dict->getNdbError(), ERROR_052);
+    Release(md);
+    return status;
+  }
+
+  for (unsigned i = 0; i < list.count; i++) {
+    const NdbDictionary::Index *index = dict->getIndexGlobal(list.elements[i].name, *table_dict);
+    if (index == nullptr) {
+      RS_Status status = RS_RONDB_SERVER_ERROR(dict->getNdbError(), ERROR_052);
+      Release(md);
+      return status;
+    }
+    std::string name(list.elements[i].name);
+    md->indexes[name] = index;
+
+    if (index->getType() == NdbDictionary::Index::UniqueHashIndex) {
+      KeyMetadata key;
+      key.index = index;
+      for (unsigned c = 0; c < index->getNoOfColumns(); c++) {
+        const char *col_name                = index->getColumn(c)->getName();
+        key.key_cols[std::string(col_name)] = table_dict->getColumn(col_name);
+      }
+      SetNonKeyColumns(table_dict, &key);
+      md->unique_keys[name] = key;
+    }
+  }
+
+  // the tables are released by the last operation that uses them
+  md->loaded = std::chrono::steady_clock::now();
+  metadata->reset(md, [this](TableMetadata *md) {
+    std::lock_guard<std::mutex> guard(dict_mutex);
+    Release(md);
+  });
+  return RS_OK;
+}
+
+// releases the table and the indexes in the global dictionary cache. They
+// are invalidated, as they are only released after a schema change or when
+// the connection is closed. Called while holding dict_mutex
+void TableCache::Release(TableMetadata *metadata) {
+  const NdbDictionary::Dictionary *dict = dict_ndb->getDictionary();
+  for (auto &index : metadata->indexes) {
+    dict->removeIndexGlobal(*index.second, 1);
+  }
+  dict->removeTableGlobal(*metadata->table, 1);
+  delete metadata;
+}
+
+void TableCache::Invalidate(const char *database, const char *table,
+                            const TableMetadata *metadata) {
+  Remove(database, table, metadata, false);
+}
+
+bool TableCache::InvalidateStale(const char *database, const char *table,
+                                 const TableMetadata *metadata) {
+  return Remove(database, table, metadata, true);
+}
+
+bool TableCache::Remove(const char *database, const char *table, const TableMetadata *metadata,
+                        bool stale_only) {
+  std::shared_ptr<const TableMetadata> stale;
+  {
+    std::lock_guard<std::mutex> guard(mutex);
+    auto cached = tables.find(TableKey(database, table));
+    // the table may have been read again after another operation invalidated it
+    if (cached == tables.end() || cached->second.get() != metadata) {
+      return false;
+    }
+    if (stale_only && std::chrono::steady_clock::now() - metadata->loaded <
+                          std::chrono::milliseconds(TABLE_CACHE_REFRESH_INTERVAL_MS)) {
+      return false;
+    }
+    stale = std::move(cached->second);
+    tables.erase(cached);
+  }
+  DEBUG(std::string("Invalidated table ") + TableKey(database, table));
+  // stale is released after the mutex, if this was the last reference
+  return true;
+}
+
+void TableCache::Clear() {
+  std::unordered_map<std::string, std::shared_ptr<const TableMetadata>> cleared;
+  {
+    std::lock_guard<std::mutex> guard(mutex);
+    cleared.swap(tables);
+  }
+  cleared.clear();
+
+  std::lock_guard<std::mutex> guard(dict_mutex);
+  delete dict_ndb;
+  dict_ndb = nullptr;
+}
+
+bool IsSchemaChange(const RS_Status &status) {
+  return status.http_code != SUCCESS && status.classification == NdbError::SchemaError;
+}
+
+bool IsUnknownColumnOrIndex(const RS_Status &status) {
+  if (status.http_code != CLIENT_ERROR) {
+    return false;
+  }
+  return status.error_code == ErrorCode(ERROR_012) || status.error_code == ErrorCode(ERROR_040);
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright (C) 2022 Hopsworks AB
+ *
+ * This program is free software; you can redistribute it and/or
+ * modify it under the terms of the GNU General Public License
+ * as published by the Free Software Foundation; either version 2
+ * of the License, or (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program; if not, write to the Free Software
+ * Foundation, Inc., 51 Franklin Street, Fifth Floor, Boston, MA  02110-1301,
+ * USA.
+ */
+
+#ifndef DATA_ACCESS_RONDB_SRC_TABLE_CACHE_HPP_
+#define DATA_ACCESS_RONDB_SRC_TABLE_CACHE_HPP_
+
+#include <NdbApi.hpp>
+#include <chrono>
+#include <memory>
+#include <mutex>
+#include <string>
+#include <unordered_map>
+#include "src/rdrs-dal.h"
+
+// tables are read again at most once per interval for requests with
+// columns or indexes that are not in the cached table
+#define TABLE_CACHE_REFRESH_INTERVAL_MS 1000
+
+typedef std::unordered_map<std::string, const NdbDictionary::Column *> ColumnMap;
+
+// key columns of the primary key or of a unique index. The non key columns
+// are all the other columns of the table
+typedef struct KeyMetadata {
+  const NdbDictionary::Index *index = nullptr;  // nullptr for the primary key
+  ColumnMap key_cols;
+  ColumnMap non_key_cols;
+} KeyMetadata;
+
+// Metadata of a table. It is read once from the dictionary and is shared
+// by the operations of all the Ndb objects of a cluster connection
+typedef struct TableMetadata {
+  const NdbDictionary::Table *table = nullptr;
+  KeyMetadata primary_key;
+  // unique keys by the NDB name of the unique index, i.e., with the $unique suffix
+  std::unordered_map<std::string, KeyMetadata> unique_keys;
+  // ordered and unique indexes by their NDB name
+  std::unordered_map<std::string, const NdbDictionary::Index *> indexes;
+  std::chrono::steady_clock::time_point loaded;
+} TableMetadata;
+
+/**
+ * Cache of the table metadata of a cluster connection. The tables are read
+ * with a dedicated Ndb object, as the tables of the global dictionary cache
+ * can be used by all the Ndb objects of the connection.
+ *
+ * Cached tables are not updated by DDL. Operations that fail because a table
+ * was altered or dropped invalidate the table, and the next lookup reads it
+ * again from the data nodes. Requests for columns or indexes that are not in
+ * the cached table, e.g., after ALTER TABLE ADD COLUMN, invalidate it as well.
+ * The operations keep a reference to the metadata, so invalidated tables are
+ * released after the last operation using them is done
+ */
+class TableCache {
+ private:
+  Ndb_cluster_connection *connection;
+  Ndb *dict_ndb = nullptr;  // reads the tables. Used while holding dict_mutex
+  std::mutex dict_mutex;
+  std::mutex mutex;  // protects the tables map only, it is not held while reading tables
+  std::unordered_map<std::string, std::shared_ptr<const TableMetadata>> tables;
+
+  std::shared_ptr<const TableMetadata> Find(const std::string &key);
+  RS_Status Load(const char *database, const char *table,
+                 std::shared_ptr<const TableMetadata> *metadata);
+  void Release(TableMetadata *metadata);
+  bool Remove(const char *database, const char *table, const TableMetadata *metadata,
+              bool stale_only);
+
+ public:
+  explicit TableCache(Ndb_cluster_connection *connection);
+
+  /**
+   * Deletes the Ndb object of the cache. Must be called after Clear()
+   */
+  ~TableCache();
+
+  /**
+   * Get the metadata of a table. The table is read from the dictionary
+   * if it is not cached. The tables are read one at a time, while the
+   * requests for cached tables do not wait for them
+   *
+   * @param[in] database
+   * @param[in] table
+   * @param[out] metadata
+   * @return status. ERROR_011 if the table does not exist
+   */
+  RS_Status GetTable(const char *database, const char *table,
+                     std::shared_ptr<const TableMetadata> *metadata);
+
+  /**
+   * Invalidate a table after it was altered or dropped. Nothing is done if
+   * the cached metadata is not the metadata used by the failed operation,
+   * e.g., if another operation invalidated it already
+   *
+   * @param[in] database
+   * @param[in] table
+   * @param[in] metadata used by the failed operation
+   */
+  void Invalidate(const char *database, const char *table, const TableMetadata *metadata);
+
+  /**
+   * Invalidate a table if a request used a column or an index that is not in
+   * the cached metadata, as it may have been added after the table was read.
+   * Tables read less than TABLE_CACHE_REFRESH_INTERVAL_MS ago are kept, so that
+   * requests with wrong names do not read the table every time
+   *
+   * @param[in] database
+   * @param[in] table
+   * @param[in] metadata used by the failed operation
+   * @return true if the table was invalidated
+   */
+  bool InvalidateStale(const char *database, const char *table, const TableMetadata *metadata);
+
+  /**
+   * Remove all the tables, e.g., before the connection is deleted.
+   * No operations may be in progress
+   */
+  void Clear();
+};
+
+/**
+ * Returns true if the operation failed because the schema of a table
+ * changed, e.g., after ALTER TABLE. The operation can be retried after
+ * the table is invalidated
+ */
+bool IsSchemaChange(const RS_Status &status);
+
+/**
+ * Returns true if a request was rejected because a column or an index does
+ * not exist in the cached table
+ */
+bool IsUnknownColumnOrIndex(const RS_Status &status);
+
+#endif  // DATA_ACCESS_RONDB_SRC_TABLE_CACHE_HPP_
@@ -28,10 +28,12 @@ import (
 	"hopsworks.ai/rdrs/internal/config"
 	"hopsworks.ai/rdrs/internal/handlers"
 	"hopsworks.ai/rdrs/internal/handlers/batchops"
+	"hopsworks.ai/rdrs/internal/handlers/health"
 	"hopsworks.ai/rdrs/internal/handlers/pkread"
 	"hopsworks.ai/rdrs/internal/handlers/stat"
 	"hopsworks.ai/rdrs/internal/log"
 	"hopsworks.ai/rdrs/internal/server"
+	"hopsworks.ai/rdrs/internal/tracing"
 	"hopsworks.ai/rdrs/version"
 )
 
@@ -58,15 +60,27 @@ func main() {
 
 	runtime.GOMAXPROCS(config.Configuration().RestServer.GOMAXPROCS)
 
+	err := tracing.InitTracer(config.Configuration().Tracing)
+	if err != nil {
+		log.Panic(fmt.Sprintf("Unable to initialize tracing: Error: %v", err))
+	}
+
 	router := server.CreateRouterContext()
 
 	handlers := &handlers.AllHandlers{
-		PKReader: pkread.GetPKReader(),
-		Stater:   stat.GetStater(),
-		Batcher:  batchops.GetBatcher(),
+		PKReader:            pkread.GetPKReader(),
+		PKWriter:            pkread.GetPKWriter(),
+		IndexScanner:        pkread.GetIndexScanner(),
+		TableScanner:        pkread.GetTableScanner(),
+		SchemaReader:        pkread.GetSchemaReader(),
+		Lister:              pkread.GetLister(),
+		Stater:              stat.GetStater(),
+		HealthChecker:       health.GetHealthChecker(),
+		Batcher:             batchops.GetBatcher(),
+		FeatureVectorReader: batchops.GetFeatureVectorReader(),
 	}
 
-	err := router.SetupRouter(handlers)
+	err = router.SetupRouter(handlers)
 	if err != nil {
 		log.Panic(fmt.Sprintf("Unable to setup router: Error: %v", err))
 	}
@@ -87,4 +101,5 @@ func main() {
 	log.Info("Shutting down server...")
 
 	router.StopRouter()
+	tracing.Shutdown()
 }
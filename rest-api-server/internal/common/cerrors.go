@@ -17,51 +17,72 @@
 
 package common
 
-/*
-#include "./../../../data-access-rondb/src/error-strs.h"
-*/
-import "C"
+import "hopsworks.ai/rdrs/internal/dal/dalapi"
 
 func ERROR_008() string {
-	return C.ERROR_008
+	return dalapi.ERROR_008
 }
 
 func ERROR_011() string {
-	return C.ERROR_011
+	return dalapi.ERROR_011
 }
 
 func ERROR_012() string {
-	return C.ERROR_012
+	return dalapi.ERROR_012
 }
 
 func ERROR_013() string {
-	return C.ERROR_013
+	return dalapi.ERROR_013
 }
 
 func ERROR_014() string {
-	return C.ERROR_014
+	return dalapi.ERROR_014
 }
 
 func ERROR_001() string {
-	return C.ERROR_001
+	return dalapi.ERROR_001
 }
 
 func ERROR_015() string {
-	return C.ERROR_015
+	return dalapi.ERROR_015
 }
 
 func ERROR_017() string {
-	return C.ERROR_017
+	return dalapi.ERROR_017
 }
 
 func ERROR_024() string {
-	return C.ERROR_024
+	return dalapi.ERROR_024
+}
+
+func ERROR_025() string {
+	return dalapi.ERROR_025
 }
 
 func ERROR_026() string {
-	return C.ERROR_026
+	return dalapi.ERROR_026
 }
 
 func ERROR_027() string {
-	return C.ERROR_027
+	return dalapi.ERROR_027
+}
+
+func ERROR_036() string {
+	return dalapi.ERROR_036
+}
+
+func ERROR_040() string {
+	return dalapi.ERROR_040
+}
+
+func ERROR_042() string {
+	return dalapi.ERROR_042
+}
+
+func ERROR_046() string {
+	return dalapi.ERROR_046
+}
+
+func ERROR_048() string {
+	return dalapi.ERROR_048
 }
@@ -0,0 +1,230 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/dal/dalapi"
+)
+
+// reasons of the error responses. Clients can branch on the reason, and on
+// the code for the errors of the catalog
+const (
+	REASON_INVALID_REQUEST     = "INVALID_REQUEST"
+	REASON_INVALID_DATA        = "INVALID_DATA"
+	REASON_INVALID_PRIMARY_KEY = "INVALID_PRIMARY_KEY"
+	REASON_INVALID_UNIQUE_KEY  = "INVALID_UNIQUE_KEY"
+	REASON_INVALID_INDEX_BOUND = "INVALID_INDEX_BOUND"
+	REASON_INVALID_FILTER      = "INVALID_FILTER"
+	REASON_UNSUPPORTED         = "UNSUPPORTED"
+	REASON_UNAUTHORIZED        = "UNAUTHORIZED"
+	REASON_NOT_FOUND           = "NOT_FOUND"
+	REASON_TABLE_NOT_FOUND     = "TABLE_NOT_FOUND"
+	REASON_COLUMN_NOT_FOUND    = "COLUMN_NOT_FOUND"
+	REASON_INDEX_NOT_FOUND     = "INDEX_NOT_FOUND"
+	REASON_CONFLICT            = "CONFLICT"
+	REASON_FAILED_DEPENDENCY   = "FAILED_DEPENDENCY"
+	REASON_TOO_MANY_REQUESTS   = "TOO_MANY_REQUESTS"
+	REASON_INTERNAL            = "INTERNAL"
+	REASON_UNAVAILABLE         = "UNAVAILABLE"
+	REASON_TIMEOUT             = "TIMEOUT"
+	REASON_CANCELLED           = "CANCELLED"
+)
+
+// CatalogError is an error of the native layer, see error-strs.h. The
+// reason is empty if it depends on the status code, e.g., a transaction that
+// failed because RonDB is overloaded or timed out
+type CatalogError struct {
+	Code    string
+	Message string
+	Reason  string
+}
+
+var errorCatalog = []CatalogError{
+	{"ERROR_001", dalapi.ERROR_001, ""},
+	{"ERROR_002", dalapi.ERROR_002, REASON_UNAVAILABLE},
+	{"ERROR_003", dalapi.ERROR_003, REASON_UNAVAILABLE},
+	{"ERROR_004", dalapi.ERROR_004, ""},
+	{"ERROR_005", dalapi.ERROR_005, ""},
+	{"ERROR_006", dalapi.ERROR_006, ""},
+	{"ERROR_007", dalapi.ERROR_007, ""},
+	{"ERROR_008", dalapi.ERROR_008, REASON_INVALID_DATA},
+	{"ERROR_009", dalapi.ERROR_009, ""},
+	{"ERROR_010", dalapi.ERROR_010, ""},
+	{"ERROR_011", dalapi.ERROR_011, REASON_TABLE_NOT_FOUND},
+	{"ERROR_012", dalapi.ERROR_012, REASON_COLUMN_NOT_FOUND},
+	{"ERROR_013", dalapi.ERROR_013, REASON_INVALID_PRIMARY_KEY},
+	{"ERROR_014", dalapi.ERROR_014, REASON_INVALID_PRIMARY_KEY},
+	{"ERROR_015", dalapi.ERROR_015, REASON_INVALID_DATA},
+	{"ERROR_016", dalapi.ERROR_016, ""},
+	{"ERROR_017", dalapi.ERROR_017, REASON_UNSUPPORTED},
+	{"ERROR_018", dalapi.ERROR_018, REASON_UNSUPPORTED},
+	{"ERROR_019", dalapi.ERROR_019, ""},
+	{"ERROR_020", dalapi.ERROR_020, REASON_INVALID_DATA},
+	{"ERROR_021", dalapi.ERROR_021, ""},
+	{"ERROR_022", dalapi.ERROR_022, ""},
+	{"ERROR_023", dalapi.ERROR_023, ""},
+	{"ERROR_024", dalapi.ERROR_024, REASON_UNAVAILABLE},
+	{"ERROR_025", dalapi.ERROR_025, REASON_UNSUPPORTED},
+	{"ERROR_026", dalapi.ERROR_026, REASON_UNSUPPORTED},
+	{"ERROR_027", dalapi.ERROR_027, REASON_INVALID_DATA},
+	{"ERROR_028", dalapi.ERROR_028, ""},
+	{"ERROR_029", dalapi.ERROR_029, ""},
+	{"ERROR_030", dalapi.ERROR_030, ""},
+	{"ERROR_031", dalapi.ERROR_031, ""},
+	{"ERROR_032", dalapi.ERROR_032, ""},
+	{"ERROR_033", dalapi.ERROR_033, ""},
+	{"ERROR_034", dalapi.ERROR_034, REASON_INVALID_REQUEST},
+	{"ERROR_035", dalapi.ERROR_035, REASON_INVALID_DATA},
+	{"ERROR_036", dalapi.ERROR_036, REASON_CONFLICT},
+	{"ERROR_037", dalapi.ERROR_037, ""},
+	{"ERROR_038", dalapi.ERROR_038, REASON_UNSUPPORTED},
+	{"ERROR_039", dalapi.ERROR_039, ""},
+	{"ERROR_040", dalapi.ERROR_040, REASON_INDEX_NOT_FOUND},
+	{"ERROR_041", dalapi.ERROR_041, REASON_UNSUPPORTED},
+	{"ERROR_042", dalapi.ERROR_042, REASON_INVALID_INDEX_BOUND},
+	{"ERROR_043", dalapi.ERROR_043, ""},
+	{"ERROR_044", dalapi.ERROR_044, REASON_INVALID_UNIQUE_KEY},
+	{"ERROR_045", dalapi.ERROR_045, REASON_INVALID_UNIQUE_KEY},
+	{"ERROR_046", dalapi.ERROR_046, REASON_INVALID_UNIQUE_KEY},
+	{"ERROR_047", dalapi.ERROR_047, REASON_UNSUPPORTED},
+	{"ERROR_048", dalapi.ERROR_048, REASON_INVALID_FILTER},
+	{"ERROR_049", dalapi.ERROR_049, REASON_UNAVAILABLE},
+	{"ERROR_050", dalapi.ERROR_050, REASON_TIMEOUT},
+	{"ERROR_051", dalapi.ERROR_051, REASON_CANCELLED},
+	{"ERROR_052", dalapi.ERROR_052, ""},
+	{"ERROR_053", dalapi.ERROR_053, ""},
+}
+
+// LookupError returns the catalog error of an error code set by the native
+// layer, e.g., 11 for ERROR_011, or nil
+func LookupError(errorCode int) *CatalogError {
+	code := fmt.Sprintf("ERROR_%03d", errorCode)
+	for i := range errorCatalog {
+		if errorCatalog[i].Code == code {
+			return &errorCatalog[i]
+		}
+	}
+	return nil
+}
+
+// the native errors name the column in the details, e.g., "Column: col0"
+var columnRegex = regexp.MustCompile(`Column: ([^\s,]+)`)
+
+func columnOf(message string) string {
+	match := columnRegex.FindStringSubmatch(message)
+	if match == nil {
+		return ""
+	}
+	return strings.TrimSuffix(match[1], ".")
+}
+
+// RequestError adds the request of a failed operation to the error response
+type RequestError struct {
+	DB          string
+	Table       string
+	OperationID string
+	Err         error
+}
+
+func (e *RequestError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// WithRequest wraps the error of an operation on db and table. It returns nil
+// if err is nil
+func WithRequest(err error, db, table, operationID *string) error {
+	if err == nil {
+		return nil
+	}
+	return &RequestError{DB: deref(db), Table: deref(table), OperationID: deref(operationID), Err: err}
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// NewErrorResponse returns the error response of a failed request
+func NewErrorResponse(httpCode int, err error) *ErrorResponse {
+	resp := &ErrorResponse{Reason: httpReason(httpCode), Message: http.StatusText(httpCode)}
+	if err != nil && err.Error() != "" {
+		resp.Message = err.Error()
+	}
+
+	var dalErr *dal.DalError
+	if errors.As(err, &dalErr) {
+		if e := LookupError(dalErr.ErrorCode); e != nil {
+			resp.Code = e.Code
+			// the client can retry the temporary errors, whatever the failed step was
+			if e.Reason != "" && !dalErr.IsTemporary() {
+				resp.Reason = e.Reason
+			}
+		}
+		resp.Column = columnOf(dalErr.Message)
+		if dalErr.IsNdbError() {
+			resp.NdbCode = dalErr.NdbCode
+		}
+	}
+
+	var reqErr *RequestError
+	if errors.As(err, &reqErr) {
+		resp.DB = reqErr.DB
+		resp.Table = reqErr.Table
+		resp.OperationID = reqErr.OperationID
+	}
+	return resp
+}
+
+// httpReason returns the reason of an http status code
+func httpReason(httpCode int) string {
+	switch httpCode {
+	case http.StatusUnauthorized:
+		return REASON_UNAUTHORIZED
+	case http.StatusNotFound:
+		return REASON_NOT_FOUND
+	case http.StatusConflict:
+		return REASON_CONFLICT
+	case http.StatusFailedDependency:
+		return REASON_FAILED_DEPENDENCY
+	case http.StatusTooManyRequests:
+		return REASON_TOO_MANY_REQUESTS
+	case http.StatusServiceUnavailable:
+		return REASON_UNAVAILABLE
+	case http.StatusGatewayTimeout:
+		return REASON_TIMEOUT
+	case dal.StatusClientClosedRequest:
+		return REASON_CANCELLED
+	}
+	if httpCode >= http.StatusInternalServerError {
+		return REASON_INTERNAL
+	}
+	return REASON_INVALID_REQUEST
+}
@@ -0,0 +1,77 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"hopsworks.ai/rdrs/internal/dal"
+)
+
+func TestErrorCatalog(t *testing.T) {
+	db := "db"
+	table := "table"
+	opID := "op"
+	tests := []struct {
+		httpCode int
+		err      error
+		expected ErrorResponse
+	}{
+		{http.StatusBadRequest,
+			&dal.DalError{HttpCode: http.StatusBadRequest, ErrorCode: 12,
+				Message: ERROR_012() + " Column: col1"},
+			ErrorResponse{Code: "ERROR_012", Reason: REASON_COLUMN_NOT_FOUND, Column: "col1"}},
+		{http.StatusConflict, WithRequest(&dal.DalError{HttpCode: http.StatusConflict, ErrorCode: 36,
+			Message: "Error: " + ERROR_036() + " Error: code:630", NdbCode: 630}, &db, &table, &opID),
+			ErrorResponse{Code: "ERROR_036", Reason: REASON_CONFLICT, DB: db, Table: table,
+				OperationID: opID, NdbCode: 630}},
+		// the reason of temporary errors is set by the status code
+		{http.StatusGatewayTimeout, &dal.DalError{HttpCode: http.StatusGatewayTimeout, ErrorCode: 11,
+			Message: "Error: " + ERROR_011() + " Error: code:266", NdbCode: 266},
+			ErrorResponse{Code: "ERROR_011", Reason: REASON_TIMEOUT, NdbCode: 266}},
+		{http.StatusServiceUnavailable, &dal.DalError{HttpCode: http.StatusServiceUnavailable, ErrorCode: 49,
+			Message: "Not connected to RonDB. Reconnecting"},
+			ErrorResponse{Code: "ERROR_049", Reason: REASON_UNAVAILABLE}},
+		{http.StatusGatewayTimeout, &dal.DalError{HttpCode: http.StatusGatewayTimeout, ErrorCode: 50,
+			Message: "Request timed out."},
+			ErrorResponse{Code: "ERROR_050", Reason: REASON_TIMEOUT}},
+		{dal.StatusClientClosedRequest, dal.ContextError(context.Canceled),
+			ErrorResponse{Code: "ERROR_051", Reason: REASON_CANCELLED}},
+		// the catalog error is set by the native layer, not by the message
+		{http.StatusBadRequest, &dal.DalError{HttpCode: http.StatusBadRequest,
+			Message: "Wrong API Prefix. " + ERROR_012()},
+			ErrorResponse{Reason: REASON_INVALID_REQUEST}},
+		{http.StatusBadRequest, fmt.Errorf("%s", ERROR_012()),
+			ErrorResponse{Reason: REASON_INVALID_REQUEST}},
+		{http.StatusNotFound, nil, ErrorResponse{Reason: REASON_NOT_FOUND}},
+	}
+
+	for _, test := range tests {
+		resp := NewErrorResponse(test.httpCode, test.err)
+		if resp.Message == "" {
+			t.Fatalf("Error message is not set")
+		}
+		resp.Message = ""
+		if *resp != test.expected {
+			t.Fatalf("Wrong error response. Expected: %v, Got: %v", test.expected, *resp)
+		}
+	}
+}
@@ -18,21 +18,38 @@
 
 package common
 
-import "C"
 import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"hopsworks.ai/rdrs/internal/config"
 )
 
+const NDJSON_CONTENT_TYPE = "application/x-ndjson"
+
+// ErrorResponse is the body of the failed requests. The code is set for the
+// errors of the native layer catalog, and the NDB code for the errors
+// returned by RonDB
 type ErrorResponse struct {
-	Error string `json:"error"    form:"error"    binding:"required"`
+	Code        string `json:"code,omitempty"        form:"code"`
+	Reason      string `json:"reason"                form:"reason"    binding:"required"`
+	Message     string `json:"message"               form:"message"   binding:"required"`
+	DB          string `json:"db,omitempty"          form:"db"`
+	Table       string `json:"table,omitempty"       form:"table"`
+	Column      string `json:"column,omitempty"      form:"column"`
+	OperationID string `json:"operationId,omitempty" form:"operation-id"`
+	NdbCode     int    `json:"ndbCode,omitempty"     form:"ndb-code"`
 }
 
 func SetResponseBodyError(c *gin.Context, code int, err error) {
-	errstruct := ErrorResponse{Error: fmt.Sprintf("Error Code: %d, Error: %v", code, err)}
+	errstruct := NewErrorResponse(code, err)
+	if errstruct.DB == "" {
+		errstruct.DB = c.Param(config.DB_PP)
+		errstruct.Table = c.Param(config.TABLE_PP)
+	}
 	b, _ := json.Marshal(errstruct)
 	c.Writer.WriteHeader(code)
 	c.Writer.Write(b)
@@ -48,3 +65,9 @@ func SetResponseBody(c *gin.Context, code int, response interface{}) {
 		c.Writer.Write(responseBytes)
 	}
 }
+
+// AcceptsNDJSON returns true if the client asked for a stream of newline
+// delimited JSON instead of a single JSON document
+func AcceptsNDJSON(c *gin.Context) bool {
+	return strings.Contains(c.GetHeader("Accept"), NDJSON_CONTENT_TYPE)
+}
@@ -309,10 +309,13 @@ func init() {
 			"USE " + db,
 
 			// blobs in PK is not supported by RonDB
-			"CREATE TABLE blob_table(id0 int, col0 blob, col1 int,  PRIMARY KEY(id0))",
-			"INSERT INTO  blob_table VALUES(1,0xFFFF, 1)",
-			"CREATE TABLE text_table(id0 int, col0 text, col1 int, PRIMARY KEY(id0))",
-			"INSERT INTO  text_table VALUES(1,\"FFFF\", 1)",
+			// col2 values are larger than the default response buffer
+			"CREATE TABLE blob_table(id0 int, col0 blob, col1 int, col2 mediumblob, PRIMARY KEY(id0))",
+			"INSERT INTO  blob_table VALUES(1,0xFFFF, 1, NULL)",
+			"INSERT INTO  blob_table VALUES(2,NULL, 2, REPEAT(0xFF, 1048576))",
+			"CREATE TABLE text_table(id0 int, col0 text, col1 int, col2 mediumtext, PRIMARY KEY(id0))",
+			"INSERT INTO  text_table VALUES(1,\"FFFF\", 1, NULL)",
+			"INSERT INTO  text_table VALUES(2,'{\"key\": \"value\"}  ', 2, REPEAT('a', 1048576))",
 		},
 
 		{ // clean up commands
@@ -474,6 +477,86 @@ func init() {
 		},
 	}
 
+	db = "DB025"
+	databases[db] = [][]string{
+		{
+			// setup commands
+			"DROP DATABASE IF EXISTS " + db,
+			"CREATE DATABASE " + db,
+			"USE " + db,
+
+			// user_ts is an ordered index used by the index scan tests
+			"CREATE TABLE `events` ( `id` int NOT NULL, `user_id` int NOT NULL, `ts` bigint NOT NULL, `event` varchar(100) DEFAULT NULL, PRIMARY KEY (`id`), KEY `user_ts` (`user_id`, `ts`))",
+			"insert into events values(1, 1, 100, \"a\")",
+			"insert into events values(2, 1, 200, \"b\")",
+			"insert into events values(3, 1, 300, \"c\")",
+			"insert into events values(4, 2, 100, \"d\")",
+			"insert into events set id=5, user_id=2, ts=200",
+		},
+
+		{ // clean up commands
+			"DROP DATABASE " + db,
+		},
+	}
+
+	db = "DB026"
+	databases[db] = [][]string{
+		{
+			// setup commands
+			"DROP DATABASE IF EXISTS " + db,
+			"CREATE DATABASE " + db,
+			"USE " + db,
+
+			// unique indexes used by the unique key read tests
+			"CREATE TABLE `users` ( `id` int NOT NULL, `email` varchar(100) NOT NULL, `ext_id` int DEFAULT NULL, `name` varchar(100) DEFAULT NULL, PRIMARY KEY (`id`), UNIQUE KEY `email_idx` (`email`), UNIQUE KEY `ext_idx` (`ext_id`), KEY `name_idx` (`name`))",
+			"insert into users values(1, \"alice@example.com\", 10, \"alice\")",
+			"insert into users values(2, \"bob@example.com\", 20, \"bob\")",
+		},
+
+		{ // clean up commands
+			"DROP DATABASE " + db,
+		},
+	}
+
+	db = "DB027"
+	databases[db] = [][]string{
+		{
+			// setup commands
+			"DROP DATABASE IF EXISTS " + db,
+			"CREATE DATABASE " + db,
+			"USE " + db,
+
+			// altered by the schema change tests
+			"CREATE TABLE `altered` ( `id` int NOT NULL, `col0` int DEFAULT NULL, PRIMARY KEY (`id`))",
+			"insert into altered values(1, 10)",
+		},
+
+		{ // clean up commands
+			"DROP DATABASE " + db,
+		},
+	}
+
+	db = "DB028"
+	databases[db] = [][]string{
+		{
+			// setup commands
+			"DROP DATABASE IF EXISTS " + db,
+			"CREATE DATABASE " + db,
+			"USE " + db,
+
+			// feature groups <name>_<version> used by the feature vector tests
+			"CREATE TABLE `profile_1` ( `id` int NOT NULL, `age` int DEFAULT NULL, `country` varchar(100) DEFAULT NULL, PRIMARY KEY (`id`))",
+			"insert into profile_1 values(1, 30, \"SE\")",
+			"insert into profile_1 values(2, NULL, \"DE\")",
+			"CREATE TABLE `activity_2` ( `id` int NOT NULL, `logins` int DEFAULT NULL, `score` double DEFAULT NULL, PRIMARY KEY (`id`))",
+			"insert into activity_2 values(1, 12, 0.5)",
+		},
+
+		{ // clean up commands
+			"DROP DATABASE " + db,
+		},
+	}
+
 	GenerateHWSchema(db)
 }
 
@@ -684,17 +767,8 @@ func createDatabasesInt(t testing.TB, create bool, dbNames ...string) {
 		dbs = append(dbs, Database(dbName))
 	}
 
-	//user:password@tcp(IP:Port)/
-	connectionString := fmt.Sprintf("%s:%s@tcp(%s:%d)/",
-		config.Configuration().MySQLServer.User,
-		config.Configuration().MySQLServer.Password,
-		config.Configuration().MySQLServer.IP,
-		config.Configuration().MySQLServer.Port)
-	dbConnection, err := sql.Open("mysql", connectionString)
+	dbConnection := connectMySQL(t)
 	defer dbConnection.Close()
-	if err != nil {
-		t.Fatalf("failed to connect to db. %v", err)
-	}
 
 	for _, db := range dbs {
 		if len(db) != 2 {
@@ -710,6 +784,30 @@ func createDatabasesInt(t testing.TB, create bool, dbNames ...string) {
 	}
 }
 
+// RunQueries runs SQL commands on the MySQL server, e.g., to alter
+// the test tables
+func RunQueries(t testing.TB, queries ...string) {
+	t.Helper()
+	dbConnection := connectMySQL(t)
+	defer dbConnection.Close()
+	runSQLQueries(t, dbConnection, queries)
+}
+
+func connectMySQL(t testing.TB) *sql.DB {
+	t.Helper()
+	//user:password@tcp(IP:Port)/
+	connectionString := fmt.Sprintf("%s:%s@tcp(%s:%d)/",
+		config.Configuration().MySQLServer.User,
+		config.Configuration().MySQLServer.Password,
+		config.Configuration().MySQLServer.IP,
+		config.Configuration().MySQLServer.Port)
+	dbConnection, err := sql.Open("mysql", connectionString)
+	if err != nil {
+		t.Fatalf("failed to connect to db. %v", err)
+	}
+	return dbConnection
+}
+
 func runSQLQueries(t testing.TB, db *sql.DB, setup []string) {
 	t.Helper()
 	for _, command := range setup {
@@ -25,6 +25,7 @@ import (
 	"path/filepath"
 
 	"hopsworks.ai/rdrs/internal/log"
+	"hopsworks.ai/rdrs/internal/tracing"
 )
 
 const CONFIG_FILE_NAME = "config.json"
@@ -34,9 +35,11 @@ var _config RSConfiguration
 type RSConfiguration struct {
 	RestServer  RestServer
 	RonDBConfig RonDB
+	DataAccess  DataAccess
 	MySQLServer MySQLServer
 	Security    Security
 	Log         log.LogConfig
+	Tracing     tracing.TracingConfig
 }
 
 func (config RSConfiguration) String() string {
@@ -52,6 +55,20 @@ type RestServer struct {
 	BufferSize          int
 	PreAllocatedBuffers uint32
 	GOMAXPROCS          int
+	// default timeout of the requests. 0 means no timeout
+	RequestTimeoutMS uint32
+}
+
+const (
+	BACKEND_RONDB  = "rondb"
+	BACKEND_MEMORY = "memory"
+)
+
+type DataAccess struct {
+	// storage backend, rondb or memory
+	Backend string
+	// JSON file with the schema and the rows loaded by the memory backend
+	FixtureFile string
 }
 
 type MySQLServer struct {
@@ -61,9 +78,36 @@ type MySQLServer struct {
 	Password string
 }
 
+const (
+	LOAD_BALANCING_ROUND_ROBIN = "round-robin"
+	LOAD_BALANCING_LEAST_LOAD  = "least-load"
+)
+
 type RonDB struct {
 	IP   string
 	Port uint16
+
+	// number of connections to the cluster, each using its own API node id
+	ConnectionPoolSize uint32
+	// how the operations are distributed over the connections,
+	// round-robin or least-load
+	LoadBalancing string
+
+	// reconnection after the connection to the cluster is lost
+	ConnectionCheckIntervalMS uint32
+	ReconnectInitialBackoffMS uint32
+	ReconnectMaxBackoffMS     uint32
+
+	OperationRetry OperationRetry
+}
+
+// OperationRetry is the retry policy for operations that fail with
+// temporary NDB errors, e.g., during node failover or overload
+type OperationRetry struct {
+	MaxRetries       uint32 // 0 disables the retries
+	InitialBackoffMS uint32 // doubled for each retry
+	MaxBackoffMS     uint32
+	DeadlineMS       uint32 // no retries are started after the deadline
 }
 
 type Security struct {
@@ -85,11 +129,28 @@ func init() {
 		BufferSize:          320 * 1024,
 		GOMAXPROCS:          -1,
 		PreAllocatedBuffers: 1024,
+		RequestTimeoutMS:    0,
 	}
 
 	ronDBConfig := RonDB{
-		IP:   "localhost",
-		Port: 1186,
+		IP:                        "localhost",
+		Port:                      1186,
+		ConnectionPoolSize:        1,
+		LoadBalancing:             LOAD_BALANCING_ROUND_ROBIN,
+		ConnectionCheckIntervalMS: 1000,
+		ReconnectInitialBackoffMS: 500,
+		ReconnectMaxBackoffMS:     30000,
+		OperationRetry: OperationRetry{
+			MaxRetries:       3,
+			InitialBackoffMS: 10,
+			MaxBackoffMS:     500,
+			DeadlineMS:       5000,
+		},
+	}
+
+	dataAccess := DataAccess{
+		Backend:     BACKEND_RONDB,
+		FixtureFile: "",
 	}
 
 	mySQLServer := MySQLServer{
@@ -117,12 +178,20 @@ func init() {
 		HopsWorksAPIKeysCacheValiditySec: 3,
 	}
 
+	tracingConfig := tracing.TracingConfig{
+		Exporter:     tracing.EXPORTER_DISABLED,
+		OTLPEndpoint: "",
+		ServiceName:  "rdrs",
+	}
+
 	_config = RSConfiguration{
 		RestServer:  restServer,
 		MySQLServer: mySQLServer,
 		RonDBConfig: ronDBConfig,
+		DataAccess:  dataAccess,
 		Security:    security,
 		Log:         log,
+		Tracing:     tracingConfig,
 	}
 
 	dir, err := os.Getwd()
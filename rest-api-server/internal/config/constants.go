@@ -21,15 +21,38 @@ import "hopsworks.ai/rdrs/version"
 
 const API_KEY_NAME = "X-API-KEY"
 
+// request timeout in milliseconds, overrides the configured default
+const REQUEST_TIMEOUT_HEADER = "X-Request-Timeout"
+
 const DB_PP = "db"
 const TABLE_PP = "table"
 const DB_OPS_EP_GROUP = "/" + version.API_VERSION + "/:" + DB_PP + "/:" + TABLE_PP + "/"
 const DBS_OPS_EP_GROUP = "/" + version.API_VERSION + "/"
 
 const PK_DB_OPERATION = "pk-read"
+const PK_INSERT_DB_OPERATION = "pk-insert"
+const PK_UPDATE_DB_OPERATION = "pk-update"
+const PK_UPSERT_DB_OPERATION = "pk-upsert"
+const PK_DELETE_DB_OPERATION = "pk-delete"
+const UNIQUE_KEY_READ_DB_OPERATION = "unique-key-read"
+const INDEX_SCAN_DB_OPERATION = "index-scan"
+const TABLE_SCAN_DB_OPERATION = "table-scan"
 const BATCH_OPERATION = "batch"
+const BATCH_TX_OPERATION = "batch-tx"
 const STAT_OPERATION = "stat"
+const SCHEMA_OPERATION = "schema"
+const DATABASES_OPERATION = "databases"
+const TABLES_OPERATION = "tables"
+const FEATURE_VECTOR_OPERATION = "feature-vector"
+const METRICS_PATH = "/metrics"
+const HEALTH_LIVE_PATH = "/health/live"
+const HEALTH_READY_PATH = "/health/ready"
 
 const PK_HTTP_VERB = "POST"
 const BATCH_HTTP_VERB = "POST"
+const INDEX_SCAN_HTTP_VERB = "POST"
+const TABLE_SCAN_HTTP_VERB = "POST"
 const STAT_HTTP_VERB = "GET"
+const SCHEMA_HTTP_VERB = "GET"
+const LIST_HTTP_VERB = "GET"
+const FEATURE_VECTOR_HTTP_VERB = "POST"
@@ -1,4 +1,4 @@
-package log
+package dal
 
 /*
 #include "./../../../data-access-rondb/src/rdrs-dal.h"
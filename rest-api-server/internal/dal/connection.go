@@ -0,0 +1,180 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dal
+
+/*
+#include "./../../../data-access-rondb/src/rdrs-dal.h"
+*/
+import "C"
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/log"
+)
+
+// The operations hold the read lock of the connection, so that a lost
+// connection is only dropped after the running operations have returned.
+// While the connection is down the operations fail with 503 without
+// calling into the native layer
+var connMutex sync.RWMutex
+var connected int32
+
+var monitor *connectionMonitor
+
+// acquireConnection must be called before calling the native layer.
+// Call releaseConnection after the call returns
+func acquireConnection() *DalError {
+	connMutex.RLock()
+	if atomic.LoadInt32(&connected) == 0 {
+		connMutex.RUnlock()
+		return &DalError{HttpCode: http.StatusServiceUnavailable, ErrorCode: 49,
+			Message: "Not connected to RonDB. Reconnecting"}
+	}
+	return nil
+}
+
+// releaseConnection releases the connection. Server errors trigger an
+// immediate check of the connection
+func releaseConnection(dalErr *DalError) {
+	connMutex.RUnlock()
+	if dalErr != nil && dalErr.HttpCode >= http.StatusInternalServerError && monitor != nil {
+		monitor.checkNow()
+	}
+}
+
+// IsConnected returns true if the connection to RonDB is up
+func IsConnected() bool {
+	return atomic.LoadInt32(&connected) == 1
+}
+
+// connectionMonitor checks the connection to the cluster periodically. If
+// none of the data nodes are alive, e.g., after a cluster restart, the
+// connection is dropped and re-established with exponential backoff
+type connectionMonitor struct {
+	check chan struct{}
+	stop  chan struct{}
+	done  chan struct{}
+}
+
+// the connection is not checked if the interval is not set
+func startConnectionMonitor() {
+	if config.Configuration().RonDBConfig.ConnectionCheckIntervalMS == 0 {
+		return
+	}
+	monitor = &connectionMonitor{
+		check: make(chan struct{}, 1),
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+	go monitor.run()
+}
+
+func stopConnectionMonitor() {
+	if monitor == nil {
+		return
+	}
+	close(monitor.stop)
+	<-monitor.done
+	monitor = nil
+}
+
+func (m *connectionMonitor) checkNow() {
+	select {
+	case m.check <- struct{}{}:
+	default:
+	}
+}
+
+func (m *connectionMonitor) run() {
+	defer close(m.done)
+
+	interval := time.Duration(config.Configuration().RonDBConfig.ConnectionCheckIntervalMS) *
+		time.Millisecond
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+		case <-m.check:
+		}
+
+		if clusterAlive() {
+			continue
+		}
+
+		log.Warn("Lost connection to RonDB. Reconnecting")
+		dropConnection()
+		if !m.reconnect() {
+			return
+		}
+		log.Info("Reconnected to RonDB")
+	}
+}
+
+func clusterAlive() bool {
+	var cstatus C.RonDB_Cluster_Status
+	ret := C.get_cluster_status(&cstatus)
+	return ret.http_code == http.StatusOK && bool(cstatus.connected) && cstatus.alive_data_nodes > 0
+}
+
+// dropConnection fails new operations fast and drops the connection once
+// the running operations have returned. The Ndb objects of the lost
+// connection are deleted
+func dropConnection() {
+	atomic.StoreInt32(&connected, 0)
+	connMutex.Lock()
+	defer connMutex.Unlock()
+	C.disconnect_cluster()
+}
+
+// reconnect retries with exponential backoff until the connection is
+// re-established. Returns false if the monitor is stopped
+func (m *connectionMonitor) reconnect() bool {
+	backoff := time.Duration(config.Configuration().RonDBConfig.ReconnectInitialBackoffMS) *
+		time.Millisecond
+	maxBackoff := time.Duration(config.Configuration().RonDBConfig.ReconnectMaxBackoffMS) *
+		time.Millisecond
+
+	for {
+		select {
+		case <-m.stop:
+			return false
+		case <-time.After(backoff):
+		}
+
+		ret := C.reconnect_cluster()
+		if ret.http_code == http.StatusOK {
+			atomic.StoreInt32(&connected, 1)
+			return true
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+		log.Warnf("Failed to reconnect to RonDB. Retrying in %v. Error: %v", backoff,
+			cToGoRet(&ret))
+	}
+}
@@ -0,0 +1,69 @@
+//go:build cgo
+
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dal
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"hopsworks.ai/rdrs/internal/config"
+)
+
+func TestReconnect(t *testing.T) {
+
+	conString := fmt.Sprintf("%s:%d", config.Configuration().RonDBConfig.IP,
+		config.Configuration().RonDBConfig.Port)
+
+	dalErr := InitRonDBConnection(conString, true)
+	if dalErr != nil {
+		t.Fatalf("Failed to connect to RonDB. Error: %v", dalErr)
+	}
+	defer ShutdownConnection()
+
+	// same as losing the connection to the cluster
+	dropConnection()
+
+	_, dalErr = GetAPIKey("bkYjEz6OTZyevbqt")
+	if dalErr == nil || dalErr.HttpCode != http.StatusServiceUnavailable {
+		t.Fatalf("Expected the operation to fail with %d. Got: %v",
+			http.StatusServiceUnavailable, dalErr)
+	}
+
+	status, dalErr := GetClusterStatus()
+	if dalErr != nil || status.Connected {
+		t.Fatalf("Cluster status does not match. Got: %#v, Error: %v", status, dalErr)
+	}
+
+	// the monitor reconnects in the background
+	deadline := time.Now().Add(60 * time.Second)
+	for !IsConnected() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Failed to reconnect to RonDB")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	status, dalErr = GetClusterStatus()
+	if dalErr != nil || !status.Connected || status.AliveDataNodes == 0 {
+		t.Fatalf("Cluster status does not match. Got: %#v, Error: %v", status, dalErr)
+	}
+}
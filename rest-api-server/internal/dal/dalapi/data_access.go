@@ -0,0 +1,121 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package dalapi defines the DataAccess interface of the storage backends,
+// with the native buffers, errors and protocol constants they share. It does
+// not use cgo, so that backends other than RonDB, e.g., dal/memory, do not
+// depend on the native layer
+package dalapi
+
+import (
+	"context"
+	"unsafe"
+)
+
+// DataAccess is the storage backend of the handlers. The operations use the
+// native request and response buffers, see handlers/pkread/encoding.go.
+// RonDB is the default backend. The in-memory backend, see dal/memory, is
+// used for tests and local development
+type DataAccess interface {
+	PKRead(ctx context.Context, request *NativeBuffer, response *NativeBuffer) *DalError
+	PKWrite(ctx context.Context, request *NativeBuffer, response *NativeBuffer) *DalError
+	IndexScan(ctx context.Context, request *NativeBuffer, response *NativeBuffer) *DalError
+	BatchedPKRead(ctx context.Context, noOps uint32, requests []*NativeBuffer,
+		responses []*NativeBuffer) *DalError
+	BatchedPKTx(ctx context.Context, noOps uint32, requests []*NativeBuffer,
+		responses []*NativeBuffer) *DalError
+
+	GetRonDBStats() (*RonDBStats, *DalError)
+	GetConnectionStats() ([]ConnectionStats, *DalError)
+	GetClusterStatus() (*ClusterStatus, *DalError)
+	GetTableSchema(db, table string) (*TableSchema, *DalError)
+	ListDatabases() ([]string, *DalError)
+	ListTables(db string) ([]string, *DalError)
+
+	GetAPIKey(userKey string) (*HopsworksAPIKey, *DalError)
+	GetUserProjects(uid int) ([]string, *DalError)
+	CheckHopsworksTables() *DalError
+}
+
+// NativeBuffer is a request or response buffer of the native layer
+type NativeBuffer struct {
+	Size   uint32
+	Buffer unsafe.Pointer
+}
+
+type RonDBStats struct {
+	NdbObjectsCreationCount int64
+	NdbObjectsDeletionCount int64
+	NdbObjectsTotalCount    int64
+	NdbObjectsFreeCount     int64
+	NdbOpRetries            int64
+	NdbOpRetriesExhausted   int64
+}
+
+type ConnectionStats struct {
+	NodeID              int
+	NdbObjectsCount     int64
+	NdbObjectsFreeCount int64
+	NdbObjectsInUse     int64
+	Requests            int64
+}
+
+type ClusterStatus struct {
+	Connected      bool
+	DataNodes      int
+	AliveDataNodes int
+}
+
+const (
+	ORDERED_INDEX = "ordered"
+	UNIQUE_INDEX  = "unique"
+)
+
+type TableSchema struct {
+	Columns []ColumnSchema `json:"columns"`
+	Indexes []IndexSchema  `json:"indexes"`
+}
+
+// ColumnSchema length is the max length of char and binary columns, in
+// characters and bytes. Precision and scale are set for decimal columns, and
+// the precision is the fractional seconds of time columns
+type ColumnSchema struct {
+	Name       string `json:"name"`
+	NdbType    string `json:"ndbType"`
+	MySQLType  string `json:"mysqlType"`
+	Length     int    `json:"length"`
+	Precision  int    `json:"precision"`
+	Scale      int    `json:"scale"`
+	Charset    string `json:"charset,omitempty"`
+	Nullable   bool   `json:"nullable"`
+	PrimaryKey bool   `json:"primaryKey"`
+}
+
+// IndexSchema type is ordered or unique. Ordered indexes are used by the
+// index-scan endpoint and unique indexes by the unique-key-read endpoint
+type IndexSchema struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Columns []string `json:"columns"`
+}
+
+type HopsworksAPIKey struct {
+	Secret string
+	Salt   string
+	Name   string
+	UserID int
+}
@@ -0,0 +1,76 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dalapi
+
+// The errors of the catalog, see data-access-rondb/src/error-strs.h. The
+// error code of a DalError is the number of its catalog error
+const (
+	ERROR_001 = "ndb_init() failed."
+	ERROR_002 = "failed to connect to RonDB mgm server."
+	ERROR_003 = "Cluster was not ready within 30 secs."
+	ERROR_004 = "Failed to initialize ndb object."
+	ERROR_005 = "Failed to start transaction."
+	ERROR_006 = "An operation has already been created."
+	ERROR_007 = "Failed to start read operation."
+	ERROR_008 = "Invalid column data."
+	ERROR_009 = "Failed to execute transaction."
+	ERROR_010 = "Unable to copy data to the response buffer."
+	ERROR_011 = "Database/Table does not exist."
+	ERROR_012 = "Column does not exist."
+	ERROR_013 = "Wrong number of primary-key columns."
+	ERROR_014 = "Wrong primay-key column."
+	ERROR_015 = "Wrong data type."
+	ERROR_016 = "Response buffer overflow."
+	ERROR_017 = "Hash indexes on float and double; and indexes on Blob types are not supported."
+	ERROR_018 = "Undefined data type."
+	ERROR_019 = "Unable to read data."
+	ERROR_020 = "Column length too big."
+	ERROR_021 = "Programming error buffer is too small."
+	ERROR_022 = "Failed to set lock level."
+	ERROR_023 = "Failed to set NdbOperation::equal()."
+	ERROR_024 = "Failed to find free API node slot."
+	ERROR_025 = "Data return type is not supported."
+	ERROR_026 = "Reading BLOB/TEXT column is not supported yet."
+	ERROR_027 = "Invalid Date/Time."
+	ERROR_028 = "Programming error. Please report bug."
+	ERROR_029 = "Failed to start scan operation."
+	ERROR_030 = "Failed to set lock mode."
+	ERROR_031 = "Failed to set filter."
+	ERROR_032 = "Failed to load index."
+	ERROR_033 = "Failed to set NdbOperation::setValue()."
+	ERROR_034 = "Invalid operation type."
+	ERROR_035 = "Column is not nullable."
+	ERROR_036 = "Constraint violation."
+	ERROR_037 = "Failed to start write operation."
+	ERROR_038 = "Writing BLOB/TEXT column is not supported yet."
+	ERROR_039 = "Failed to read BLOB/TEXT column."
+	ERROR_040 = "Index does not exist."
+	ERROR_041 = "Only ordered indexes can be scanned."
+	ERROR_042 = "Wrong index bound column."
+	ERROR_043 = "Failed to set index bound."
+	ERROR_044 = "Only unique indexes can be used for unique key reads."
+	ERROR_045 = "Wrong number of unique-key columns."
+	ERROR_046 = "Wrong unique-key column."
+	ERROR_047 = "Table scans require a primary key."
+	ERROR_048 = "Invalid scan filter."
+	ERROR_049 = "Not connected to RonDB."
+	ERROR_050 = "Request timed out."
+	ERROR_051 = "Request cancelled."
+	ERROR_052 = "Failed to read the indexes of the table."
+	ERROR_053 = "Failed to list the tables."
+)
@@ -0,0 +1,69 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dalapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// StatusClientClosedRequest is the non standard status code of the requests
+// cancelled by the client before they completed
+const StatusClientClosedRequest = 499
+
+// DalError is a failed native call. The NDB fields are only set if the
+// error was returned by RonDB, see IsNdbError
+type DalError struct {
+	HttpCode       int
+	Message        string
+	ErrLineNo      int
+	ErrFileName    string
+	Status         int // NdbError status
+	Classification int // NdbError classification
+	NdbCode        int // NdbError code
+	MySQLCode      int // NdbError mysql code
+	ErrorCode      int // catalog error, see error-strs.h, e.g., 11 for ERROR_011. 0 if none
+}
+
+func (e *DalError) Error() string {
+	return e.Message
+}
+
+// IsNdbError returns true if the error was returned by RonDB
+func (e *DalError) IsNdbError() bool {
+	return e.NdbCode > 0
+}
+
+// IsTemporary returns true if the request may succeed if retried later
+func (e *DalError) IsTemporary() bool {
+	switch e.HttpCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// ContextError is the error of a request that is cancelled or past its
+// deadline. Only the requests past their deadline timed out
+func ContextError(err error) *DalError {
+	if errors.Is(err, context.Canceled) {
+		return &DalError{HttpCode: StatusClientClosedRequest, ErrorCode: 51, Message: ERROR_051}
+	}
+	return &DalError{HttpCode: http.StatusGatewayTimeout, ErrorCode: 50, Message: ERROR_050}
+}
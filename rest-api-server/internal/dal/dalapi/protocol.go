@@ -0,0 +1,145 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dalapi
+
+// The constants of the native request and response buffers, see
+// data-access-rondb/src/rdrs-const.h. They are checked against the native
+// constants by the dal tests
+
+// 4 bytes. Max addressable memrory is 4GB
+// which is max supported blob size
+const ADDRESS_SIZE = 4
+
+// Request Type Identifiers
+const (
+	RDRS_PK_REQ_ID     = 1
+	RDRS_PK_RESP_ID    = 2
+	RDRS_BATCH_REQ_ID  = 3
+	RDRS_BATCH_RESP_ID = 4
+)
+
+// Primary Key Write Request Type Identifiers
+// Responses to write requests use RDRS_PK_RESP_ID
+const (
+	RDRS_PK_INSERT_REQ_ID = 5
+	RDRS_PK_UPDATE_REQ_ID = 6
+	RDRS_PK_UPSERT_REQ_ID = 7
+	RDRS_PK_DELETE_REQ_ID = 8
+)
+
+// Index Scan Request Type Identifier
+// Responses to scan requests use RDRS_PK_RESP_ID. The
+// rows are stored in the rows section of the response
+const RDRS_INDEX_SCAN_REQ_ID = 9
+
+// Index Scan Flags
+const (
+	RDRS_SCAN_LOWER_BOUND_INCLUSIVE = 1
+	RDRS_SCAN_UPPER_BOUND_INCLUSIVE = 2
+	RDRS_SCAN_DESCENDING            = 4
+)
+
+// Table Scan Request Type Identifier
+// Table scans are ordered by the primary key so that a scan can be
+// continued from the last returned row. Responses use RDRS_PK_RESP_ID
+const (
+	RDRS_TABLE_SCAN_REQ_ID = 10
+	RDRS_PRIMARY_INDEX     = "PRIMARY"
+)
+
+// Scan filter node types
+// Logical nodes are followed by their operands
+const (
+	RDRS_FILTER_AND         = 1
+	RDRS_FILTER_OR          = 2
+	RDRS_FILTER_NOT         = 3
+	RDRS_FILTER_EQ          = 4
+	RDRS_FILTER_NE          = 5
+	RDRS_FILTER_LT          = 6
+	RDRS_FILTER_LE          = 7
+	RDRS_FILTER_GT          = 8
+	RDRS_FILTER_GE          = 9
+	RDRS_FILTER_LIKE        = 10
+	RDRS_FILTER_IS_NULL     = 11
+	RDRS_FILTER_IS_NOT_NULL = 12
+)
+
+// Unique key reads use the hash part of MySQL unique indexes.
+// MySQL stores it as a separate index with this suffix
+const RDRS_UNIQUE_INDEX_SUFFIX = "$unique"
+
+// Data types
+// Everyting is a string.
+// However for RDRS_STRING_DATATYPE the string
+// is enclosed in quotes. This is now JSON works
+const (
+	RDRS_UNKNOWN_DATATYPE          = 0
+	RDRS_STRING_DATATYPE           = 1
+	RDRS_INTEGER_DATATYPE          = 2
+	RDRS_FLOAT_DATATYPE            = 3
+	RDRS_BINARY_DATATYPE           = 4
+	RDRS_DATETIME_DATATYPE         = 5
+	RDRS_BIT_DATATYPE              = 6
+	RDRS_DECIMAL_DATATYPE          = 7
+	RDRS_UNSIGNED_INTEGER_DATATYPE = 8
+)
+
+// Data return types of the read columns, see DataReturnType in
+// data-access-rondb/src/rdrs-dal.h
+const (
+	DEFAULT_DRT = 1
+	BASE64_DRT  = 2
+	HEX_DRT     = 3
+	STRING_DRT  = 4
+)
+
+// Primary Key Read Request Header Indexes
+const (
+	PK_REQ_OP_TYPE_IDX       = 0
+	PK_REQ_CAPACITY_IDX      = 1
+	PK_REQ_LENGTH_IDX        = 2
+	PK_REQ_DB_IDX            = 3
+	PK_REQ_TABLE_IDX         = 4
+	PK_REQ_PK_COLS_IDX       = 5
+	PK_REQ_READ_COLS_IDX     = 6
+	PK_REQ_OP_ID_IDX         = 7
+	PK_REQ_VALUES_IDX        = 8
+	PK_REQ_INDEX_IDX         = 9
+	PK_REQ_LOWER_IDX         = 10
+	PK_REQ_UPPER_IDX         = 11
+	PK_REQ_FLAGS_IDX         = 12
+	PK_REQ_LIMIT_IDX         = 13
+	PK_REQ_FILTER_IDX        = 14
+	PK_REQ_FILTER_VALUES_IDX = 15
+	PK_REQ_HEADER_END        = 64
+)
+
+// Primary Key Read Response Header Indexes
+const (
+	PK_RESP_OP_TYPE_IDX   = 0
+	PK_RESP_OP_STATUS_IDX = 1
+	PK_RESP_CAPACITY_IDX  = 2
+	PK_RESP_LENGTH_IDX    = 3
+	PK_RESP_DB_IDX        = 4
+	PK_RESP_TABLE_IDX     = 5
+	PK_RESP_COLS_IDX      = 6
+	PK_RESP_OP_ID_IDX     = 7
+	PK_RESP_ROWS_IDX      = 8
+	PK_RESP_NEXT_IDX      = 9
+	PK_RESP_HEADER_END    = 40
+)
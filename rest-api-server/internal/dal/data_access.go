@@ -0,0 +1,127 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dal
+
+import (
+	"context"
+
+	"hopsworks.ai/rdrs/internal/dal/dalapi"
+)
+
+// The types of the backend interface are defined in dalapi, which does not
+// use cgo, so that the other backends do not depend on the native layer
+type (
+	DataAccess      = dalapi.DataAccess
+	NativeBuffer    = dalapi.NativeBuffer
+	DalError        = dalapi.DalError
+	RonDBStats      = dalapi.RonDBStats
+	ConnectionStats = dalapi.ConnectionStats
+	ClusterStatus   = dalapi.ClusterStatus
+	TableSchema     = dalapi.TableSchema
+	ColumnSchema    = dalapi.ColumnSchema
+	IndexSchema     = dalapi.IndexSchema
+	HopsworksAPIKey = dalapi.HopsworksAPIKey
+)
+
+const (
+	StatusClientClosedRequest = dalapi.StatusClientClosedRequest
+	ORDERED_INDEX             = dalapi.ORDERED_INDEX
+	UNIQUE_INDEX              = dalapi.UNIQUE_INDEX
+)
+
+// ContextError is the error of a request that is cancelled or past its
+// deadline
+func ContextError(err error) *DalError {
+	return dalapi.ContextError(err)
+}
+
+// RonDB is the DataAccess backed by the native layer
+type RonDB struct{}
+
+var _ DataAccess = RonDB{}
+
+var dataAccess DataAccess = RonDB{}
+
+// SetDataAccess replaces the backend used by the handlers. It is set
+// before the servers are started
+func SetDataAccess(da DataAccess) {
+	dataAccess = da
+}
+
+// GetDataAccess returns the backend used by the handlers
+func GetDataAccess() DataAccess {
+	return dataAccess
+}
+
+func (RonDB) PKRead(ctx context.Context, request *NativeBuffer, response *NativeBuffer) *DalError {
+	return RonDBPKRead(ctx, request, response)
+}
+
+func (RonDB) PKWrite(ctx context.Context, request *NativeBuffer, response *NativeBuffer) *DalError {
+	return RonDBPKWrite(ctx, request, response)
+}
+
+func (RonDB) IndexScan(ctx context.Context, request *NativeBuffer, response *NativeBuffer) *DalError {
+	return RonDBIndexScan(ctx, request, response)
+}
+
+func (RonDB) BatchedPKRead(ctx context.Context, noOps uint32, requests []*NativeBuffer,
+	responses []*NativeBuffer) *DalError {
+	return RonDBBatchedPKRead(ctx, noOps, requests, responses)
+}
+
+func (RonDB) BatchedPKTx(ctx context.Context, noOps uint32, requests []*NativeBuffer,
+	responses []*NativeBuffer) *DalError {
+	return RonDBBatchedPKTx(ctx, noOps, requests, responses)
+}
+
+func (RonDB) GetRonDBStats() (*RonDBStats, *DalError) {
+	return GetRonDBStats()
+}
+
+func (RonDB) GetConnectionStats() ([]ConnectionStats, *DalError) {
+	return GetConnectionStats()
+}
+
+func (RonDB) GetClusterStatus() (*ClusterStatus, *DalError) {
+	return GetClusterStatus()
+}
+
+func (RonDB) GetTableSchema(db, table string) (*TableSchema, *DalError) {
+	return GetTableSchema(db, table)
+}
+
+func (RonDB) ListDatabases() ([]string, *DalError) {
+	return ListDatabases()
+}
+
+func (RonDB) ListTables(db string) ([]string, *DalError) {
+	return ListTables(db)
+}
+
+func (RonDB) GetAPIKey(userKey string) (*HopsworksAPIKey, *DalError) {
+	return GetAPIKey(userKey)
+}
+
+func (RonDB) GetUserProjects(uid int) ([]string, *DalError) {
+	return GetUserProjects(uid)
+}
+
+func (RonDB) CheckHopsworksTables() *DalError {
+	return CheckHopsworksTables()
+}
@@ -0,0 +1,35 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dal
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// The buffers are allocated with malloc, as the native layer frees and
+// reallocates the response buffers that are too small
+
+func allocateNative(size int) unsafe.Pointer {
+	return C.malloc(C.size_t(size))
+}
+
+func freeNative(buffer unsafe.Pointer) {
+	C.free(buffer)
+}
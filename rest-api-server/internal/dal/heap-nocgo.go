@@ -0,0 +1,32 @@
+//go:build !cgo
+
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dal
+
+import "unsafe"
+
+// Without cgo the native layer is not linked, and the buffers of the other
+// backends, e.g., dal/memory, are allocated by Go
+
+func allocateNative(size int) unsafe.Pointer {
+	return unsafe.Pointer(&make([]byte, size)[0])
+}
+
+func freeNative(buffer unsafe.Pointer) {
+}
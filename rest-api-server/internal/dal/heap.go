@@ -18,25 +18,15 @@
 
 package dal
 
-/*
-#include "./../../../data-access-rondb/src/rdrs-const.h"
-#include "./../../../data-access-rondb/src/rdrs-dal.h"
-#include <stdlib.h>
-*/
-import "C"
 import (
 	"fmt"
 	"sync"
 	"unsafe"
 
 	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/dal/dalapi"
 )
 
-type NativeBuffer struct {
-	Size   uint32
-	Buffer unsafe.Pointer
-}
-
 type MemoryStats struct {
 	AllocationsCount   int64
 	DeallocationsCount int64
@@ -57,12 +47,12 @@ func InitializeBuffers() {
 		panic(fmt.Sprintf("Native buffers are already initialized"))
 	}
 
-	if C.ADDRESS_SIZE != 4 {
+	if dalapi.ADDRESS_SIZE != 4 {
 		panic(fmt.Sprintf("Only 4 byte address are supported"))
 	}
 
-	if config.Configuration().RestServer.BufferSize%C.ADDRESS_SIZE != 0 {
-		panic(fmt.Sprintf("Buffer size must be multiple of %d", C.ADDRESS_SIZE))
+	if config.Configuration().RestServer.BufferSize%dalapi.ADDRESS_SIZE != 0 {
+		panic(fmt.Sprintf("Buffer size must be multiple of %d", dalapi.ADDRESS_SIZE))
 	}
 
 	for i := uint32(0); i < config.Configuration().RestServer.PreAllocatedBuffers; i++ {
@@ -85,7 +75,7 @@ func ReleaseAllBuffers() {
 	}
 
 	for _, buffer := range buffers {
-		C.free(buffer.Buffer)
+		freeNative(buffer.Buffer)
 	}
 	buffers = make([]*NativeBuffer, 0)
 	buffersStats = MemoryStats{}
@@ -93,7 +83,7 @@ func ReleaseAllBuffers() {
 }
 
 func __allocateBuffer() *NativeBuffer {
-	buff := NativeBuffer{Buffer: C.malloc(C.size_t(config.Configuration().RestServer.BufferSize)),
+	buff := NativeBuffer{Buffer: allocateNative(config.Configuration().RestServer.BufferSize),
 		Size: uint32(config.Configuration().RestServer.BufferSize)}
 	dstBuf := unsafe.Slice((*byte)(buff.Buffer), config.Configuration().RestServer.BufferSize)
 	dstBuf[0] = 0x00 // reset buffer by putting null terminator in the begenning
@@ -130,6 +120,12 @@ func ReturnBuffer(buffer *NativeBuffer) {
 		return
 	}
 
+	// buffers that were enlarged by the native layer are not pooled
+	if buffer.Size != uint32(config.Configuration().RestServer.BufferSize) {
+		freeNative(buffer.Buffer)
+		return
+	}
+
 	mutex.Lock()
 	defer mutex.Unlock()
 
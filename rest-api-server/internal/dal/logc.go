@@ -0,0 +1,49 @@
+package dal
+
+/*
+#cgo CFLAGS: -g -Wall
+#include <stdlib.h>
+#include <stdbool.h>
+#include "./../../../data-access-rondb/src/rdrs-dal.h"
+extern void loggerCToGo(RS_LOG_MSG log);
+*/
+import "C"
+import (
+	"github.com/sirupsen/logrus"
+	"hopsworks.ai/rdrs/internal/log"
+)
+
+var cCallbacks C.Callbacks
+
+// registerLogCallBack sends the log messages of the native layer to the
+// server log
+func registerLogCallBack() {
+	cCallbacks = C.Callbacks{}
+	cCallbacks.logger = C.LogCallBackFn(C.loggerCToGo)
+	C.register_callbacks(cCallbacks)
+}
+
+//export goLog
+func goLog(logMsg C.RS_LOG_MSG) {
+	level := logrus.Level(logMsg.level)
+	msg := C.GoString(&logMsg.message[0])
+
+	switch level {
+	case logrus.PanicLevel:
+		log.Panic(msg)
+	case logrus.FatalLevel:
+		log.Fatal(msg)
+	case logrus.ErrorLevel:
+		log.Error(msg)
+	case logrus.WarnLevel:
+		log.Warn(msg)
+	case logrus.InfoLevel:
+		log.Info(msg)
+	case logrus.DebugLevel:
+		log.Debug(msg)
+	case logrus.TraceLevel:
+		log.Trace(msg)
+	default:
+		log.Error("Please fix log level for this message: " + msg)
+	}
+}
@@ -0,0 +1,329 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package memory
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"unsafe"
+
+	"hopsworks.ai/rdrs/internal/dal/dalapi"
+)
+
+// The memory backend uses the same request and response buffers as the
+// native layer, see handlers/pkread/encoding.go
+
+type keyValue struct {
+	column string
+	value  *string // nil is NULL
+}
+
+type request struct {
+	opType      uint32
+	db          string
+	table       string
+	index       *string
+	operationID *string
+	filters     []keyValue
+	readColumns []string
+	values      []keyValue
+}
+
+func parseRequest(buff *dalapi.NativeBuffer) *request {
+	r := newReader(buff)
+	req := request{
+		opType:      r.iBuf[dalapi.PK_REQ_OP_TYPE_IDX],
+		db:          r.cString(r.iBuf[dalapi.PK_REQ_DB_IDX]),
+		table:       r.cString(r.iBuf[dalapi.PK_REQ_TABLE_IDX]),
+		index:       r.optionalCString(r.iBuf[dalapi.PK_REQ_INDEX_IDX]),
+		operationID: r.optionalCString(r.iBuf[dalapi.PK_REQ_OP_ID_IDX]),
+		filters:     r.keyValues(r.iBuf[dalapi.PK_REQ_PK_COLS_IDX]),
+		values:      r.keyValues(r.iBuf[dalapi.PK_REQ_VALUES_IDX]),
+	}
+
+	// [count][col 1 offset]...[col n offset], each column is [return type][name]
+	if offset := r.iBuf[dalapi.PK_REQ_READ_COLS_IDX]; offset != 0 {
+		count := r.iBuf[offset/dalapi.ADDRESS_SIZE]
+		for i := uint32(0); i < count; i++ {
+			colOffset := r.iBuf[offset/dalapi.ADDRESS_SIZE+1+i]
+			req.readColumns = append(req.readColumns, r.cString(colOffset+dalapi.ADDRESS_SIZE))
+		}
+	}
+	return &req
+}
+
+type filterNode struct {
+	nodeType uint32
+	arg      uint32
+}
+
+// scanRequest is an index or table scan. The flags are the bound and order
+// flags, e.g., RDRS_SCAN_DESCENDING
+type scanRequest struct {
+	*request
+	lower        []keyValue
+	upper        []keyValue
+	flags        uint32
+	limit        uint32
+	filter       []filterNode
+	filterValues []keyValue
+}
+
+func parseScanRequest(buff *dalapi.NativeBuffer) *scanRequest {
+	r := newReader(buff)
+	req := scanRequest{
+		request:      parseRequest(buff),
+		lower:        r.keyValues(r.iBuf[dalapi.PK_REQ_LOWER_IDX]),
+		upper:        r.keyValues(r.iBuf[dalapi.PK_REQ_UPPER_IDX]),
+		flags:        r.iBuf[dalapi.PK_REQ_FLAGS_IDX],
+		limit:        r.iBuf[dalapi.PK_REQ_LIMIT_IDX],
+		filterValues: r.keyValues(r.iBuf[dalapi.PK_REQ_FILTER_VALUES_IDX]),
+	}
+
+	// [count][type][arg]...[type][arg]
+	if offset := r.iBuf[dalapi.PK_REQ_FILTER_IDX]; offset != 0 {
+		count := r.iBuf[offset/dalapi.ADDRESS_SIZE]
+		nodes := r.iBuf[offset/dalapi.ADDRESS_SIZE+1:]
+		for i := uint32(0); i < count; i++ {
+			req.filter = append(req.filter, filterNode{nodeType: nodes[2*i], arg: nodes[2*i+1]})
+		}
+	}
+	return &req
+}
+
+type reader struct {
+	iBuf []uint32
+	bBuf []byte
+}
+
+func newReader(buff *dalapi.NativeBuffer) *reader {
+	return &reader{
+		iBuf: unsafe.Slice((*uint32)(buff.Buffer), buff.Size/dalapi.ADDRESS_SIZE),
+		bBuf: unsafe.Slice((*byte)(buff.Buffer), buff.Size),
+	}
+}
+
+func (r *reader) cString(offset uint32) string {
+	end := bytes.IndexByte(r.bBuf[offset:], 0)
+	return string(r.bBuf[offset : offset+uint32(end)])
+}
+
+// offset 0 means not set
+func (r *reader) optionalCString(offset uint32) *string {
+	if offset == 0 {
+		return nil
+	}
+	s := r.cString(offset)
+	return &s
+}
+
+// ndbString reads a value. The first two bytes store the length
+func (r *reader) ndbString(offset uint32) string {
+	length := uint32(r.bBuf[offset]) + uint32(r.bBuf[offset+1])*256
+	return string(r.bBuf[offset+2 : offset+2+length])
+}
+
+// keyValues reads [count][kv 1 offset]...[kv n offset], each key/value
+// pair is [key offset][value offset]. A value offset of 0 is NULL
+func (r *reader) keyValues(offset uint32) []keyValue {
+	if offset == 0 {
+		return nil
+	}
+
+	count := r.iBuf[offset/dalapi.ADDRESS_SIZE]
+	kvs := make([]keyValue, count)
+	for i := uint32(0); i < count; i++ {
+		tupleIdx := r.iBuf[offset/dalapi.ADDRESS_SIZE+1+i] / dalapi.ADDRESS_SIZE
+		kvs[i].column = r.cString(r.iBuf[tupleIdx])
+		if valueOffset := r.iBuf[tupleIdx+1]; valueOffset != 0 {
+			value := r.ndbString(valueOffset)
+			kvs[i].value = &value
+		}
+	}
+	return kvs
+}
+
+type response struct {
+	iBuf []uint32
+	bBuf []byte
+	head uint32
+	rows []uint32 // offsets of the columns of the rows of scans
+}
+
+func newResponse(buff *dalapi.NativeBuffer) *response {
+	r := response{
+		iBuf: unsafe.Slice((*uint32)(buff.Buffer), buff.Size/dalapi.ADDRESS_SIZE),
+		bBuf: unsafe.Slice((*byte)(buff.Buffer), buff.Size),
+		head: dalapi.PK_RESP_HEADER_END,
+	}
+	for i := 0; i < dalapi.PK_RESP_HEADER_END/dalapi.ADDRESS_SIZE; i++ {
+		r.iBuf[i] = 0
+	}
+	r.iBuf[dalapi.PK_RESP_OP_TYPE_IDX] = dalapi.RDRS_PK_RESP_ID
+	r.iBuf[dalapi.PK_RESP_CAPACITY_IDX] = buff.Size
+	return &r
+}
+
+func (r *response) setStatus(status int) {
+	r.iBuf[dalapi.PK_RESP_OP_STATUS_IDX] = uint32(status)
+}
+
+// setRequest copies the db, table and operation ID of the request
+func (r *response) setRequest(req *request) *dalapi.DalError {
+	fields := []struct {
+		idx   uint32
+		value *string
+	}{{dalapi.PK_RESP_DB_IDX, &req.db}, {dalapi.PK_RESP_TABLE_IDX, &req.table},
+		{dalapi.PK_RESP_OP_ID_IDX, req.operationID}}
+
+	for _, field := range fields {
+		if field.value == nil {
+			continue
+		}
+		offset, dalErr := r.appendCString(*field.value)
+		if dalErr != nil {
+			return dalErr
+		}
+		r.iBuf[field.idx] = offset
+	}
+	return nil
+}
+
+// setColumns writes the columns of the row of a key read
+func (r *response) setColumns(columns []*column, values row) *dalapi.DalError {
+	offset, dalErr := r.appendColumns(columns, values)
+	if dalErr != nil {
+		return dalErr
+	}
+	r.iBuf[dalapi.PK_RESP_COLS_IDX] = offset
+	return nil
+}
+
+// addRow writes the columns of a row of a scan
+func (r *response) addRow(columns []*column, values row) *dalapi.DalError {
+	offset, dalErr := r.appendColumns(columns, values)
+	if dalErr != nil {
+		return dalErr
+	}
+	r.rows = append(r.rows, offset)
+	return nil
+}
+
+// setNextKey writes the primary key of the last row of a table scan. The
+// next scan starts after it
+func (r *response) setNextKey(columns []*column, values row) *dalapi.DalError {
+	offset, dalErr := r.appendColumns(columns, values)
+	if dalErr != nil {
+		return dalErr
+	}
+	r.iBuf[dalapi.PK_RESP_NEXT_IDX] = offset
+	return nil
+}
+
+// setRows writes [count][row 1 offset]...[row n offset]
+func (r *response) setRows() *dalapi.DalError {
+	r.head = alignWord(r.head)
+	rowsOffset := r.head
+	if dalErr := r.reserve(dalapi.ADDRESS_SIZE + uint32(len(r.rows))*dalapi.ADDRESS_SIZE); dalErr != nil {
+		return dalErr
+	}
+	r.iBuf[rowsOffset/dalapi.ADDRESS_SIZE] = uint32(len(r.rows))
+	copy(r.iBuf[rowsOffset/dalapi.ADDRESS_SIZE+1:], r.rows)
+	r.iBuf[dalapi.PK_RESP_ROWS_IDX] = rowsOffset
+	return nil
+}
+
+// appendColumns writes the columns of a row and returns their offset. Each
+// column has a header of [name offset][value offset][is NULL][data type]
+func (r *response) appendColumns(columns []*column, values row) (uint32, *dalapi.DalError) {
+	r.head = alignWord(r.head)
+	colsOffset := r.head
+	if dalErr := r.reserve(dalapi.ADDRESS_SIZE + uint32(len(columns))*4*dalapi.ADDRESS_SIZE); dalErr != nil {
+		return 0, dalErr
+	}
+	r.iBuf[colsOffset/dalapi.ADDRESS_SIZE] = uint32(len(columns))
+
+	for i, col := range columns {
+		nameOffset, dalErr := r.appendCString(col.name)
+		if dalErr != nil {
+			return 0, dalErr
+		}
+
+		header := r.iBuf[colsOffset/dalapi.ADDRESS_SIZE+1+uint32(i)*4:]
+		header[0] = nameOffset
+		value := values[col.name]
+		if value == nil {
+			header[1] = 0
+			header[2] = 1
+			header[3] = dalapi.RDRS_UNKNOWN_DATATYPE
+			continue
+		}
+
+		valueOffset, dalErr := r.appendCString(col.responseValue(*value))
+		if dalErr != nil {
+			return 0, dalErr
+		}
+		header[1] = valueOffset
+		header[2] = 0
+		header[3] = col.dataType
+	}
+	return colsOffset, nil
+}
+
+func (r *response) close() {
+	r.iBuf[dalapi.PK_RESP_LENGTH_IDX] = r.head
+}
+
+func (r *response) reserve(size uint32) *dalapi.DalError {
+	// the data must be shorter than the buffer
+	if r.head+size >= uint32(len(r.bBuf)) {
+		return &dalapi.DalError{HttpCode: http.StatusInternalServerError, ErrorCode: 16,
+			Message: dalapi.ERROR_016}
+	}
+	r.head += size
+	return nil
+}
+
+func (r *response) appendCString(s string) (uint32, *dalapi.DalError) {
+	offset := r.head
+	if dalErr := r.reserve(uint32(len(s)) + 1); dalErr != nil {
+		return 0, dalErr
+	}
+	copy(r.bBuf[offset:], s)
+	r.bBuf[offset+uint32(len(s))] = 0x00
+	return offset, nil
+}
+
+func alignWord(head uint32) uint32 {
+	if a := head % dalapi.ADDRESS_SIZE; a != 0 {
+		head += dalapi.ADDRESS_SIZE - a
+	}
+	return head
+}
+
+// escape escapes a string value for JSON. The quotes are added when the
+// response is converted
+func escape(s string) string {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(s)
+	quoted := bytes.TrimSpace(b.Bytes())
+	return string(quoted[1 : len(quoted)-1])
+}
@@ -0,0 +1,260 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package memory
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"hopsworks.ai/rdrs/internal/dal/dalapi"
+)
+
+// charset of the character columns of the fixtures
+const DEFAULT_CHARSET = "utf8mb4"
+
+// Fixture is the content of the memory backend, i.e., the schema and the
+// rows of the tables, and the API keys
+//
+//	{
+//	  "databases": [{
+//	    "name": "db001",
+//	    "tables": [{
+//	      "name": "users",
+//	      "columns": [
+//	        { "name": "id", "type": "int", "primaryKey": true },
+//	        { "name": "name", "type": "varchar(100)", "nullable": true }
+//	      ],
+//	      "indexes": [{ "name": "name_idx", "type": "unique", "columns": ["name"] }],
+//	      "rows": [{ "id": 1, "name": "alice" }]
+//	    }]
+//	  }],
+//	  "apiKeys": [{ "key": "<prefix>.<secret>", "userId": 1, "databases": ["db001"] }]
+//	}
+type Fixture struct {
+	Databases []Database `json:"databases"`
+	APIKeys   []APIKey   `json:"apiKeys"`
+}
+
+type Database struct {
+	Name   string  `json:"name"`
+	Tables []Table `json:"tables"`
+}
+
+type Table struct {
+	Name    string                       `json:"name"`
+	Columns []Column                     `json:"columns"`
+	Indexes []Index                      `json:"indexes"`
+	Rows    []map[string]json.RawMessage `json:"rows"`
+}
+
+// Column type is the MySQL type of the column, e.g., int, bigint unsigned,
+// varchar(100) or datetime
+type Column struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	PrimaryKey bool   `json:"primaryKey"`
+	Nullable   bool   `json:"nullable"`
+}
+
+// Index type is ordered or unique. As in RonDB, the primary key is also the
+// PRIMARY ordered index, and a unique key that can be scanned is declared
+// as both an ordered and a unique index with the same name
+type Index struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Columns []string `json:"columns"`
+}
+
+// APIKey is a Hopsworks API key, i.e., a 16 character prefix and a secret
+// separated by a dot, and the databases that the user can access
+type APIKey struct {
+	Key       string   `json:"key"`
+	UserID    int      `json:"userId"`
+	Databases []string `json:"databases"`
+}
+
+// LoadFixture reads a fixture from a JSON file
+func LoadFixture(path string) (*Fixture, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read fixture file. Error: %v", err)
+	}
+
+	fixture := Fixture{}
+	if err := json.Unmarshal(b, &fixture); err != nil {
+		return nil, fmt.Errorf("Unable to parse fixture file. Error: %v", err)
+	}
+	return &fixture, nil
+}
+
+// dataType returns the data type of the values of a MySQL column type as
+// returned by the native layer
+func dataType(columnType string) (uint32, error) {
+	t := strings.ToLower(strings.TrimSpace(columnType))
+	unsigned := strings.HasSuffix(t, " unsigned")
+	t = strings.TrimSuffix(t, " unsigned")
+	if i := strings.Index(t, "("); i >= 0 {
+		t = t[:i]
+	}
+
+	switch t {
+	case "tinyint", "smallint", "mediumint", "int", "integer", "bigint":
+		if unsigned {
+			return dalapi.RDRS_UNSIGNED_INTEGER_DATATYPE, nil
+		}
+		return dalapi.RDRS_INTEGER_DATATYPE, nil
+	case "float", "double", "real":
+		return dalapi.RDRS_FLOAT_DATATYPE, nil
+	case "decimal", "numeric":
+		return dalapi.RDRS_DECIMAL_DATATYPE, nil
+	case "char", "varchar", "text", "tinytext", "mediumtext", "longtext":
+		return dalapi.RDRS_STRING_DATATYPE, nil
+	case "binary", "varbinary", "blob", "tinyblob", "mediumblob", "longblob":
+		return dalapi.RDRS_BINARY_DATATYPE, nil
+	case "date", "datetime", "timestamp", "time", "year":
+		return dalapi.RDRS_DATETIME_DATATYPE, nil
+	case "bit":
+		return dalapi.RDRS_BIT_DATATYPE, nil
+	}
+	return dalapi.RDRS_UNKNOWN_DATATYPE, fmt.Errorf("%s Type: %s", dalapi.ERROR_018, columnType)
+}
+
+// ndbTypes are the NDB column types of the MySQL types, signed and unsigned
+var ndbTypes = map[string][2]string{
+	"tinyint":    {"Tinyint", "Tinyunsigned"},
+	"smallint":   {"Smallint", "Smallunsigned"},
+	"mediumint":  {"Mediumint", "Mediumunsigned"},
+	"int":        {"Int", "Unsigned"},
+	"integer":    {"Int", "Unsigned"},
+	"bigint":     {"Bigint", "Bigunsigned"},
+	"float":      {"Float", "Float"},
+	"double":     {"Double", "Double"},
+	"real":       {"Double", "Double"},
+	"decimal":    {"Decimal", "Decimalunsigned"},
+	"numeric":    {"Decimal", "Decimalunsigned"},
+	"char":       {"Char", "Char"},
+	"varchar":    {"Varchar", "Varchar"},
+	"binary":     {"Binary", "Binary"},
+	"varbinary":  {"Varbinary", "Varbinary"},
+	"text":       {"Text", "Text"},
+	"tinytext":   {"Text", "Text"},
+	"mediumtext": {"Text", "Text"},
+	"longtext":   {"Text", "Text"},
+	"blob":       {"Blob", "Blob"},
+	"tinyblob":   {"Blob", "Blob"},
+	"mediumblob": {"Blob", "Blob"},
+	"longblob":   {"Blob", "Blob"},
+	"date":       {"Date", "Date"},
+	"datetime":   {"Datetime2", "Datetime2"},
+	"timestamp":  {"Timestamp2", "Timestamp2"},
+	"time":       {"Time2", "Time2"},
+	"year":       {"Year", "Year"},
+	"bit":        {"Bit", "Bit"},
+}
+
+// columnSchema returns the schema of a fixture column. The type arguments,
+// e.g., varchar(100) or decimal(10,2), are the length, precision and scale
+func columnSchema(c *Column) dalapi.ColumnSchema {
+	mysqlType := strings.ToLower(strings.TrimSpace(c.Type))
+	t := strings.TrimSuffix(mysqlType, " unsigned")
+	unsigned := t != mysqlType
+
+	args := []int{}
+	if i := strings.Index(t, "("); i >= 0 {
+		for _, arg := range strings.Split(strings.TrimSuffix(t[i+1:], ")"), ",") {
+			n, _ := strconv.Atoi(strings.TrimSpace(arg))
+			args = append(args, n)
+		}
+		t = t[:i]
+	}
+
+	schema := dalapi.ColumnSchema{Name: c.Name, MySQLType: mysqlType,
+		Nullable: c.Nullable && !c.PrimaryKey, PrimaryKey: c.PrimaryKey}
+	if ndbType, ok := ndbTypes[t]; ok {
+		schema.NdbType = ndbType[0]
+		if unsigned {
+			schema.NdbType = ndbType[1]
+		}
+	}
+	switch t {
+	case "char", "varchar", "binary", "varbinary", "bit":
+		if len(args) > 0 {
+			schema.Length = args[0]
+		}
+	case "decimal", "numeric":
+		if len(args) > 0 {
+			schema.Precision = args[0]
+		}
+		if len(args) > 1 {
+			schema.Scale = args[1]
+		}
+	case "datetime", "timestamp", "time":
+		if len(args) > 0 {
+			schema.Precision = args[0]
+		}
+	}
+	switch t {
+	case "char", "varchar", "text", "tinytext", "mediumtext", "longtext":
+		schema.Charset = DEFAULT_CHARSET
+	}
+	return schema
+}
+
+// isNumber returns true if the values of the column are returned as JSON
+// numbers
+func isNumber(dataType uint32) bool {
+	return dataType == dalapi.RDRS_INTEGER_DATATYPE || dataType == dalapi.RDRS_UNSIGNED_INTEGER_DATATYPE ||
+		dataType == dalapi.RDRS_FLOAT_DATATYPE || dataType == dalapi.RDRS_DECIMAL_DATATYPE
+}
+
+// checkValue checks that a value, as stored in the request buffers, i.e.,
+// unquoted, matches the data type of the column
+func checkValue(dataType uint32, value string) bool {
+	var err error
+	switch dataType {
+	case dalapi.RDRS_INTEGER_DATATYPE:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case dalapi.RDRS_UNSIGNED_INTEGER_DATATYPE:
+		_, err = strconv.ParseUint(value, 10, 64)
+	case dalapi.RDRS_FLOAT_DATATYPE, dalapi.RDRS_DECIMAL_DATATYPE:
+		_, err = strconv.ParseFloat(value, 64)
+	}
+	return err == nil
+}
+
+// fixtureValue converts a JSON value of the fixture to the unquoted form
+// used by the request buffers. JSON null is returned as nil
+func fixtureValue(raw json.RawMessage) (*string, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	if raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		return &s, nil
+	}
+	s := string(raw)
+	return &s, nil
+}
@@ -0,0 +1,724 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package memory is a DataAccess backend that keeps the tables in memory.
+// It is loaded from a fixture and is used to run the REST and gRPC servers
+// without a RonDB cluster, e.g., in tests and for local development.
+// Primary key and unique key reads, writes, batches, and index and table
+// scans are supported. The rows are searched and sorted on each request as
+// the tables of the fixtures are small
+package memory
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"hopsworks.ai/rdrs/internal/dal/dalapi"
+)
+
+// NDB errors of duplicate primary keys and unique keys, returned for parity
+// with the native layer
+const (
+	NDB_TUPLE_EXISTS_CODE       = 630
+	NDB_TUPLE_EXISTS_MYSQL_CODE = 121
+	NDB_TUPLE_EXISTS_MESSAGE    = "Tuple already existed when attempting to insert"
+
+	NDB_UNIQUE_VIOLATION_CODE       = 893
+	NDB_UNIQUE_VIOLATION_MYSQL_CODE = 141
+	NDB_UNIQUE_VIOLATION_MESSAGE    = "Constraint violation e.g. duplicate value in unique index"
+)
+
+// name of the ordered index of the primary key
+const PRIMARY_INDEX = "PRIMARY"
+
+type column struct {
+	name       string
+	dataType   uint32
+	ndbType    string
+	primaryKey bool
+	nullable   bool
+}
+
+// responseValue converts a value to the format of the response buffers
+func (c *column) responseValue(value string) string {
+	if isNumber(c.dataType) {
+		return value
+	}
+	return escape(value)
+}
+
+// row values are stored unquoted, as in the request buffers. nil is NULL
+type row map[string]*string
+
+type tableKey struct {
+	db    string
+	table string
+}
+
+// index is an ordered or a unique index. Key reads return the non key
+// columns by default
+type index struct {
+	name    string
+	columns []*column
+	nonKey  []*column
+}
+
+func (i *index) column(name string) *column {
+	return findColumn(i.columns, name)
+}
+
+// matches returns true if the index columns of a row are equal to the
+// values. As in RonDB, NULL values are not equal
+func (i *index) matches(r row, values row) bool {
+	for _, col := range i.columns {
+		a, b := r[col.name], values[col.name]
+		if a == nil || b == nil || compareValues(col, a, b) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+type table struct {
+	columns map[string]*column
+	all     []*column // in the order of the fixture
+	pk      []*column
+	nonPK   []*column
+	ordered map[string]*index
+	unique  map[string]*index
+	rows    map[string]row
+	schema  dalapi.TableSchema
+}
+
+type Memory struct {
+	mutex   sync.RWMutex
+	tables  map[tableKey]*table
+	apiKeys map[string]*dalapi.HopsworksAPIKey // by prefix
+	userDBs map[int][]string
+}
+
+var _ dalapi.DataAccess = (*Memory)(nil)
+
+// Load creates a memory backend from a fixture file
+func Load(path string) (*Memory, error) {
+	fixture, err := LoadFixture(path)
+	if err != nil {
+		return nil, err
+	}
+	return New(fixture)
+}
+
+// New creates a memory backend with the tables and API keys of the fixture
+func New(fixture *Fixture) (*Memory, error) {
+	m := Memory{
+		tables:  make(map[tableKey]*table),
+		apiKeys: make(map[string]*dalapi.HopsworksAPIKey),
+		userDBs: make(map[int][]string),
+	}
+
+	for _, db := range fixture.Databases {
+		for _, t := range db.Tables {
+			tbl, err := newTable(&t)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid table %s.%s. Error: %v", db.Name, t.Name, err)
+			}
+			m.tables[tableKey{db: db.Name, table: t.Name}] = tbl
+		}
+	}
+
+	for _, key := range fixture.APIKeys {
+		splits := strings.Split(key.Key, ".")
+		if len(splits) != 2 || len(splits[0]) != 16 {
+			return nil, fmt.Errorf("Invalid API key '%s'", key.Key)
+		}
+		// sha256(client.secret + db.salt) = db.secret
+		m.apiKeys[splits[0]] = &dalapi.HopsworksAPIKey{
+			Secret: fmt.Sprintf("%x", sha256.Sum256([]byte(splits[1]))),
+			Salt:   "",
+			Name:   splits[0],
+			UserID: key.UserID,
+		}
+		m.userDBs[key.UserID] = append(m.userDBs[key.UserID], key.Databases...)
+	}
+	return &m, nil
+}
+
+func newTable(t *Table) (*table, error) {
+	tbl := table{columns: make(map[string]*column), ordered: make(map[string]*index),
+		unique: make(map[string]*index), rows: make(map[string]row)}
+	for _, c := range t.Columns {
+		dataType, err := dataType(c.Type)
+		if err != nil {
+			return nil, err
+		}
+		schema := columnSchema(&c)
+		col := column{name: c.Name, dataType: dataType, ndbType: schema.NdbType,
+			primaryKey: c.PrimaryKey, nullable: c.Nullable && !c.PrimaryKey}
+		tbl.columns[c.Name] = &col
+		tbl.all = append(tbl.all, &col)
+		tbl.schema.Columns = append(tbl.schema.Columns, schema)
+		if col.primaryKey {
+			tbl.pk = append(tbl.pk, &col)
+		} else {
+			tbl.nonPK = append(tbl.nonPK, &col)
+		}
+	}
+	if len(tbl.pk) == 0 {
+		return nil, fmt.Errorf("The table does not have a primary key")
+	}
+
+	// as in RonDB, the primary key is also an ordered index
+	primary := Index{Name: PRIMARY_INDEX, Type: dalapi.ORDERED_INDEX}
+	for _, col := range tbl.pk {
+		primary.Columns = append(primary.Columns, col.name)
+	}
+	for _, i := range append([]Index{primary}, t.Indexes...) {
+		if err := tbl.addIndex(&i); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, fixtureRow := range t.Rows {
+		r := row{}
+		for name, raw := range fixtureRow {
+			if _, ok := tbl.columns[name]; !ok {
+				return nil, fmt.Errorf("Row %d. %s Column: %s", i, dalapi.ERROR_012, name)
+			}
+			value, err := fixtureValue(raw)
+			if err != nil {
+				return nil, fmt.Errorf("Row %d. %s Column: %s", i, dalapi.ERROR_008, name)
+			}
+			r[name] = value
+		}
+		for _, col := range tbl.columns {
+			if dalErr := col.checkValue(r[col.name]); dalErr != nil {
+				return nil, fmt.Errorf("Row %d. %s", i, dalErr.Message)
+			}
+		}
+
+		key := tbl.rowKey(r)
+		if _, exists := tbl.rows[key]; exists {
+			return nil, fmt.Errorf("Row %d. Duplicate primary key", i)
+		}
+		if tbl.uniqueViolation(key, r) {
+			return nil, fmt.Errorf("Row %d. Duplicate unique key", i)
+		}
+		tbl.rows[key] = r
+	}
+	return &tbl, nil
+}
+
+func (t *table) addIndex(i *Index) error {
+	indexes := t.ordered
+	switch i.Type {
+	case dalapi.ORDERED_INDEX:
+	case dalapi.UNIQUE_INDEX:
+		indexes = t.unique
+	default:
+		return fmt.Errorf("Index %s. Unknown type: %s", i.Name, i.Type)
+	}
+	if _, exists := indexes[i.Name]; exists {
+		return fmt.Errorf("Duplicate index %s", i.Name)
+	}
+	if len(i.Columns) == 0 {
+		return fmt.Errorf("Index %s does not have columns", i.Name)
+	}
+
+	idx := index{name: i.Name}
+	for _, name := range i.Columns {
+		col, ok := t.columns[name]
+		if !ok {
+			return fmt.Errorf("Index %s. %s Column: %s", i.Name, dalapi.ERROR_012, name)
+		}
+		idx.columns = append(idx.columns, col)
+	}
+	for _, col := range t.all {
+		if idx.column(col.name) == nil {
+			idx.nonKey = append(idx.nonKey, col)
+		}
+	}
+	indexes[i.Name] = &idx
+	t.schema.Indexes = append(t.schema.Indexes, dalapi.IndexSchema{Name: i.Name, Type: i.Type,
+		Columns: i.Columns})
+	return nil
+}
+
+// uniqueViolation returns true if another row has the same values of a
+// unique index
+func (t *table) uniqueViolation(key string, r row) bool {
+	for _, idx := range t.unique {
+		for otherKey, other := range t.rows {
+			if otherKey != key && idx.matches(other, r) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *column) checkValue(value *string) *dalapi.DalError {
+	if value == nil {
+		if !c.nullable {
+			return clientError(35, dalapi.ERROR_035+" Column: "+c.name)
+		}
+		return nil
+	}
+	if !checkValue(c.dataType, *value) {
+		return clientError(15, dalapi.ERROR_015+" Column: "+c.name)
+	}
+	return nil
+}
+
+// rowKey encodes the primary key of a row
+func (t *table) rowKey(r row) string {
+	values := make([]*string, len(t.pk))
+	for i, col := range t.pk {
+		values[i] = r[col.name]
+	}
+	b, _ := json.Marshal(values)
+	return string(b)
+}
+
+// key checks the primary key filters of a request and returns the row key
+func (t *table) key(filters []keyValue) (string, *dalapi.DalError) {
+	if len(filters) != len(t.pk) {
+		return "", clientError(13, fmt.Sprintf("%s Expecting: %d Got: %d", dalapi.ERROR_013, len(t.pk),
+			len(filters)))
+	}
+
+	r := row{}
+	for _, filter := range filters {
+		col, ok := t.columns[filter.column]
+		if !ok || !col.primaryKey {
+			return "", clientError(14, dalapi.ERROR_014+" Column: "+filter.column)
+		}
+		if dalErr := col.checkValue(filter.value); dalErr != nil {
+			return "", dalErr
+		}
+		r[filter.column] = filter.value
+	}
+	return t.rowKey(r), nil
+}
+
+// uniqueRow checks the unique key filters of a request and returns the row
+// with the values of the unique index, if any
+func (t *table) uniqueRow(idx *index, filters []keyValue) (row, bool, *dalapi.DalError) {
+	if len(filters) != len(idx.columns) {
+		return nil, false, clientError(45, fmt.Sprintf("%s Expecting: %d Got: %d", dalapi.ERROR_045,
+			len(idx.columns), len(filters)))
+	}
+
+	values := row{}
+	for _, filter := range filters {
+		col := idx.column(filter.column)
+		if col == nil {
+			return nil, false, clientError(46, dalapi.ERROR_046+" Column: "+filter.column)
+		}
+		if dalErr := col.checkValue(filter.value); dalErr != nil {
+			return nil, false, dalErr
+		}
+		values[filter.column] = filter.value
+	}
+
+	for _, r := range t.rows {
+		if idx.matches(r, values) {
+			return r, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (m *Memory) table(req *request) (*table, *dalapi.DalError) {
+	t, ok := m.tables[tableKey{db: req.db, table: req.table}]
+	if !ok {
+		return nil, clientError(11, fmt.Sprintf("%s Database: %s Table: %s", dalapi.ERROR_011, req.db,
+			req.table))
+	}
+	return t, nil
+}
+
+func (m *Memory) PKRead(ctx context.Context, request *dalapi.NativeBuffer,
+	response *dalapi.NativeBuffer) *dalapi.DalError {
+	if err := ctx.Err(); err != nil {
+		return dalapi.ContextError(err)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.read(parseRequest(request), newResponse(response), false)
+}
+
+func (m *Memory) BatchedPKRead(ctx context.Context, noOps uint32, requests []*dalapi.NativeBuffer,
+	responses []*dalapi.NativeBuffer) *dalapi.DalError {
+	if err := ctx.Err(); err != nil {
+		return dalapi.ContextError(err)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	for i := uint32(0); i < noOps; i++ {
+		req := parseRequest(requests[i])
+		if req.opType != dalapi.RDRS_PK_REQ_ID {
+			return clientError(34, fmt.Sprintf("%s Type: %d", dalapi.ERROR_034, req.opType))
+		}
+		if dalErr := m.read(req, newResponse(responses[i]), true); dalErr != nil {
+			return dalErr
+		}
+	}
+	return nil
+}
+
+// read returns an error if the row is not found, except for batches where
+// only the status of the operation is set
+func (m *Memory) read(req *request, resp *response, batch bool) *dalapi.DalError {
+	t, dalErr := m.table(req)
+	if dalErr != nil {
+		return dalErr
+	}
+
+	// unique key reads use the unique index of the request
+	var r row
+	var found bool
+	nonKey := t.nonPK
+	if req.index == nil {
+		key, dalErr := t.key(req.filters)
+		if dalErr != nil {
+			return dalErr
+		}
+		r, found = t.rows[key]
+	} else {
+		idx, ok := t.unique[*req.index]
+		if !ok {
+			return clientError(40, dalapi.ERROR_040+" Index: "+*req.index)
+		}
+		r, found, dalErr = t.uniqueRow(idx, req.filters)
+		if dalErr != nil {
+			return dalErr
+		}
+		nonKey = idx.nonKey
+	}
+
+	// all the non key columns are read if the read columns are not set
+	columns := nonKey
+	if req.readColumns != nil {
+		columns = make([]*column, len(req.readColumns))
+		for i, name := range req.readColumns {
+			col := findColumn(nonKey, name)
+			if col == nil {
+				return clientError(12, dalapi.ERROR_012+" Column: "+name)
+			}
+			columns[i] = col
+		}
+	}
+
+	if dalErr := resp.setRequest(req); dalErr != nil {
+		return dalErr
+	}
+	if !found {
+		resp.setStatus(http.StatusNotFound)
+		resp.close()
+		if batch {
+			return nil
+		}
+		return notFound()
+	}
+
+	resp.setStatus(http.StatusOK)
+	if dalErr := resp.setColumns(columns, r); dalErr != nil {
+		return dalErr
+	}
+	resp.close()
+	return nil
+}
+
+func (m *Memory) PKWrite(ctx context.Context, request *dalapi.NativeBuffer,
+	response *dalapi.NativeBuffer) *dalapi.DalError {
+	if err := ctx.Err(); err != nil {
+		return dalapi.ContextError(err)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.write(parseRequest(request), newResponse(response), nil)
+}
+
+// BatchedPKTx runs the operations in order. If an operation is not found or
+// conflicts, the previous writes are undone, its status is set in its
+// response, and the other operations fail with http.StatusFailedDependency.
+// Like the native layer, this is not an error of the request. Any other error
+// fails the whole request
+func (m *Memory) BatchedPKTx(ctx context.Context, noOps uint32, requests []*dalapi.NativeBuffer,
+	responses []*dalapi.NativeBuffer) *dalapi.DalError {
+	if err := ctx.Err(); err != nil {
+		return dalapi.ContextError(err)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	undo := []func(){}
+	for i := uint32(0); i < noOps; i++ {
+		req := parseRequest(requests[i])
+		var dalErr *dalapi.DalError
+		if req.opType == dalapi.RDRS_PK_REQ_ID {
+			dalErr = m.read(req, newResponse(responses[i]), false)
+		} else {
+			dalErr = m.write(req, newResponse(responses[i]), &undo)
+		}
+		if dalErr == nil {
+			continue
+		}
+
+		for j := len(undo) - 1; j >= 0; j-- {
+			undo[j]()
+		}
+		if dalErr.HttpCode == http.StatusNotFound || dalErr.HttpCode == http.StatusConflict {
+			for j := uint32(0); j < noOps; j++ {
+				if j != i {
+					failDependency(parseRequest(requests[j]), newResponse(responses[j]))
+				}
+			}
+			return nil
+		}
+		return dalErr
+	}
+	return nil
+}
+
+func failDependency(req *request, resp *response) {
+	resp.setRequest(req)
+	resp.setStatus(http.StatusFailedDependency)
+	resp.close()
+}
+
+// write applies a write operation. The changes are undone by the functions
+// appended to undo, if set
+func (m *Memory) write(req *request, resp *response, undo *[]func()) *dalapi.DalError {
+	t, dalErr := m.table(req)
+	if dalErr != nil {
+		return dalErr
+	}
+	// unique keys can only be read
+	if req.index != nil {
+		return clientError(34, fmt.Sprintf("%s Type: %d", dalapi.ERROR_034, req.opType))
+	}
+	key, dalErr := t.key(req.filters)
+	if dalErr != nil {
+		return dalErr
+	}
+	for _, value := range req.values {
+		col, ok := t.columns[value.column]
+		if !ok || col.primaryKey {
+			return clientError(12, dalapi.ERROR_012+" Column: "+value.column)
+		}
+		if dalErr := col.checkValue(value.value); dalErr != nil {
+			return dalErr
+		}
+	}
+
+	old, found := t.rows[key]
+	var updated row
+	status := http.StatusOK
+	switch req.opType {
+	case dalapi.RDRS_PK_INSERT_REQ_ID:
+		if found {
+			status = http.StatusConflict
+			break
+		}
+		updated = newRow(req)
+	case dalapi.RDRS_PK_UPDATE_REQ_ID:
+		if !found {
+			status = http.StatusNotFound
+			break
+		}
+		updated = updateRow(old, req)
+	case dalapi.RDRS_PK_UPSERT_REQ_ID:
+		if found {
+			updated = updateRow(old, req)
+		} else {
+			updated = newRow(req)
+		}
+	case dalapi.RDRS_PK_DELETE_REQ_ID:
+		if !found {
+			status = http.StatusNotFound
+		}
+	default:
+		return clientError(34, fmt.Sprintf("%s Type: %d", dalapi.ERROR_034, req.opType))
+	}
+
+	// the columns that are not set by inserts are NULL
+	if status == http.StatusOK && updated != nil && !found {
+		for _, col := range t.nonPK {
+			if dalErr := col.checkValue(updated[col.name]); dalErr != nil {
+				return dalErr
+			}
+		}
+	}
+	uniqueViolation := status == http.StatusOK && updated != nil && t.uniqueViolation(key, updated)
+	if uniqueViolation {
+		status = http.StatusConflict
+	}
+
+	if dalErr := resp.setRequest(req); dalErr != nil {
+		return dalErr
+	}
+	resp.setStatus(status)
+	resp.close()
+	switch status {
+	case http.StatusConflict:
+		if uniqueViolation {
+			return conflict(NDB_UNIQUE_VIOLATION_CODE, NDB_UNIQUE_VIOLATION_MYSQL_CODE,
+				NDB_UNIQUE_VIOLATION_MESSAGE)
+		}
+		return conflict(NDB_TUPLE_EXISTS_CODE, NDB_TUPLE_EXISTS_MYSQL_CODE, NDB_TUPLE_EXISTS_MESSAGE)
+	case http.StatusNotFound:
+		return notFound()
+	}
+
+	if updated != nil {
+		t.rows[key] = updated
+	} else {
+		delete(t.rows, key)
+	}
+	if undo != nil {
+		*undo = append(*undo, func() {
+			if found {
+				t.rows[key] = old
+			} else {
+				delete(t.rows, key)
+			}
+		})
+	}
+	return nil
+}
+
+func newRow(req *request) row {
+	r := row{}
+	for _, filter := range req.filters {
+		r[filter.column] = filter.value
+	}
+	for _, value := range req.values {
+		r[value.column] = value.value
+	}
+	return r
+}
+
+func updateRow(old row, req *request) row {
+	r := row{}
+	for name, value := range old {
+		r[name] = value
+	}
+	for _, value := range req.values {
+		r[value.column] = value.value
+	}
+	return r
+}
+
+// GetRonDBStats returns empty stats as the memory backend does not use Ndb objects
+func (m *Memory) GetRonDBStats() (*dalapi.RonDBStats, *dalapi.DalError) {
+	return &dalapi.RonDBStats{}, nil
+}
+
+func (m *Memory) GetConnectionStats() ([]dalapi.ConnectionStats, *dalapi.DalError) {
+	return []dalapi.ConnectionStats{}, nil
+}
+
+// GetClusterStatus reports a connected cluster with a single data node
+func (m *Memory) GetClusterStatus() (*dalapi.ClusterStatus, *dalapi.DalError) {
+	return &dalapi.ClusterStatus{Connected: true, DataNodes: 1, AliveDataNodes: 1}, nil
+}
+
+func (m *Memory) GetTableSchema(db, tableName string) (*dalapi.TableSchema, *dalapi.DalError) {
+	t, dalErr := m.table(&request{db: db, table: tableName})
+	if dalErr != nil {
+		return nil, dalErr
+	}
+	return &t.schema, nil
+}
+
+func (m *Memory) ListDatabases() ([]string, *dalapi.DalError) {
+	dbs := map[string]bool{}
+	for key := range m.tables {
+		dbs[key.db] = true
+	}
+	names := make([]string, 0, len(dbs))
+	for db := range dbs {
+		names = append(names, db)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (m *Memory) ListTables(db string) ([]string, *dalapi.DalError) {
+	names := []string{}
+	for key := range m.tables {
+		if key.db == db {
+			names = append(names, key.table)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (m *Memory) GetAPIKey(userKey string) (*dalapi.HopsworksAPIKey, *dalapi.DalError) {
+	key, ok := m.apiKeys[userKey]
+	if !ok {
+		return nil, clientError(0, "Wrong API Prefix")
+	}
+	k := *key
+	return &k, nil
+}
+
+func (m *Memory) GetUserProjects(uid int) ([]string, *dalapi.DalError) {
+	return append([]string{}, m.userDBs[uid]...), nil
+}
+
+func (m *Memory) CheckHopsworksTables() *dalapi.DalError {
+	return nil
+}
+
+// clientError returns a client error. The error code is the number of the
+// catalog error the message starts with, as set by the native layer
+func clientError(errorCode int, message string) *dalapi.DalError {
+	return &dalapi.DalError{HttpCode: http.StatusBadRequest, ErrorCode: errorCode, Message: message}
+}
+
+func notFound() *dalapi.DalError {
+	return &dalapi.DalError{HttpCode: http.StatusNotFound, Message: "Not Found"}
+}
+
+func conflict(ndbCode, mysqlCode int, message string) *dalapi.DalError {
+	return &dalapi.DalError{HttpCode: http.StatusConflict, ErrorCode: 36,
+		Message: fmt.Sprintf("Error: %s Error: code:%d MySQL Code: %d Message: %s", dalapi.ERROR_036,
+			ndbCode, mysqlCode, message),
+		NdbCode: ndbCode, MySQLCode: mysqlCode}
+}
+
+func findColumn(columns []*column, name string) *column {
+	for _, col := range columns {
+		if col.name == name {
+			return col
+		}
+	}
+	return nil
+}
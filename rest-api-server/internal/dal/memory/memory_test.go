@@ -0,0 +1,471 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package memory_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/dal/memory"
+	"hopsworks.ai/rdrs/internal/handlers/batchops"
+	"hopsworks.ai/rdrs/internal/handlers/pkread"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+func withMemoryBackend(t *testing.T) {
+	t.Helper()
+	if !dal.BuffersInitialized() {
+		dal.InitializeBuffers()
+	}
+	m, err := memory.Load("testdata/fixture.json")
+	if err != nil {
+		t.Fatalf("Failed to load the fixture. Error: %v", err)
+	}
+	dal.SetDataAccess(m)
+	t.Cleanup(func() { dal.SetDataAccess(dal.RonDB{}) })
+}
+
+func filters(kvs ...string) *[]api.Filter {
+	filters := []api.Filter{}
+	for i := 0; i < len(kvs); i += 2 {
+		value := json.RawMessage(kvs[i+1])
+		filters = append(filters, api.Filter{Column: &kvs[i], Value: &value})
+	}
+	return &filters
+}
+
+func read(t *testing.T, db, table string, apiKey string, kvs ...string) (int, error,
+	map[string]string) {
+	t.Helper()
+	params := api.PKReadParams{DB: &db, Table: &table, Filters: filters(kvs...)}
+	response := api.PKReadResponseJSON{}
+	response.Init()
+	status, err := pkread.GetPKReader().PkReadHandler(context.Background(), &params, &apiKey,
+		&response)
+
+	data := map[string]string{}
+	if response.Data != nil {
+		for column, value := range *response.Data {
+			if value == nil {
+				data[column] = "null"
+			} else {
+				data[column] = string(*value)
+			}
+		}
+	}
+	return status, err, data
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func uint32Ptr(n uint32) *uint32 {
+	return &n
+}
+
+func write(t *testing.T, operation, db, table string, kvs []string, columns ...string) (int, error) {
+	t.Helper()
+	writeColumns := []api.WriteColumn{}
+	for i := 0; i < len(columns); i += 2 {
+		value := json.RawMessage(columns[i+1])
+		writeColumns = append(writeColumns, api.WriteColumn{Column: &columns[i], Value: &value})
+	}
+	params := api.PKWriteParams{DB: &db, Table: &table, Operation: &operation,
+		Filters: filters(kvs...), WriteColumns: &writeColumns}
+	response := api.PKWriteResponseJSON{}
+	response.Init()
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+	return pkread.GetPKWriter().PkWriteHandler(context.Background(), &params, &apiKey, &response)
+}
+
+func TestMemoryPKRead(t *testing.T) {
+	withMemoryBackend(t)
+
+	status, err, data := read(t, "memdb", "users", common.HOPSWORKS_TEST_API_KEY, "id", "1")
+	if status != http.StatusOK || err != nil {
+		t.Fatalf("Expected %d. Got: %d, Error: %v", http.StatusOK, status, err)
+	}
+	if data["name"] != `"alice"` || data["age"] != "30" {
+		t.Fatalf("Read data does not match. Got: %v", data)
+	}
+
+	status, err, data = read(t, "memdb", "users", common.HOPSWORKS_TEST_API_KEY, "id", "2")
+	if status != http.StatusOK || err != nil || data["age"] != "null" {
+		t.Fatalf("Expected a NULL age. Got: %d, %v, Error: %v", status, data, err)
+	}
+
+	status, _, _ = read(t, "memdb", "users", common.HOPSWORKS_TEST_API_KEY, "id", "100")
+	if status != http.StatusNotFound {
+		t.Fatalf("Expected %d. Got: %d", http.StatusNotFound, status)
+	}
+
+	status, err, _ = read(t, "memdb", "missing", common.HOPSWORKS_TEST_API_KEY, "id", "1")
+	if status != http.StatusBadRequest || err == nil || !strings.Contains(err.Error(), common.ERROR_011()) {
+		t.Fatalf("Expected %d with %s. Got: %d, Error: %v", http.StatusBadRequest,
+			common.ERROR_011(), status, err)
+	}
+
+	status, _, _ = read(t, "memdb", "users", common.HOPSWORKS_TEST_API_KEY, "name", `"alice"`)
+	if status != http.StatusBadRequest {
+		t.Fatalf("Expected %d for a non primary key filter. Got: %d", http.StatusBadRequest, status)
+	}
+
+	// the API key does not have access to otherdb
+	status, _, _ = read(t, "otherdb", "items", common.HOPSWORKS_TEST_API_KEY, "id", "1")
+	if status != http.StatusUnauthorized {
+		t.Fatalf("Expected %d. Got: %d", http.StatusUnauthorized, status)
+	}
+}
+
+func TestMemoryPKWrite(t *testing.T) {
+	withMemoryBackend(t)
+
+	status, err := write(t, api.PK_INSERT, "memdb", "users", []string{"id", "3"},
+		"name", `"carol"`, "age", "41")
+	if status != http.StatusOK || err != nil {
+		t.Fatalf("Insert failed. Got: %d, Error: %v", status, err)
+	}
+	status, err, data := read(t, "memdb", "users", common.HOPSWORKS_TEST_API_KEY, "id", "3")
+	if status != http.StatusOK || err != nil || data["name"] != `"carol"` || data["age"] != "41" {
+		t.Fatalf("Inserted row does not match. Got: %d, %v, Error: %v", status, data, err)
+	}
+
+	status, err = write(t, api.PK_INSERT, "memdb", "users", []string{"id", "3"}, "name", `"dave"`)
+	var dalErr *dal.DalError
+	if status != http.StatusConflict || !errors.As(err, &dalErr) ||
+		dalErr.NdbCode != memory.NDB_TUPLE_EXISTS_CODE {
+		t.Fatalf("Expected %d. Got: %d, Error: %v", http.StatusConflict, status, err)
+	}
+
+	status, err = write(t, api.PK_UPDATE, "memdb", "users", []string{"id", "3"}, "age", "null")
+	if status != http.StatusOK || err != nil {
+		t.Fatalf("Update failed. Got: %d, Error: %v", status, err)
+	}
+	_, _, data = read(t, "memdb", "users", common.HOPSWORKS_TEST_API_KEY, "id", "3")
+	if data["name"] != `"carol"` || data["age"] != "null" {
+		t.Fatalf("Updated row does not match. Got: %v", data)
+	}
+
+	status, _ = write(t, api.PK_UPDATE, "memdb", "users", []string{"id", "100"}, "age", "1")
+	if status != http.StatusNotFound {
+		t.Fatalf("Expected %d. Got: %d", http.StatusNotFound, status)
+	}
+
+	status, _ = write(t, api.PK_UPSERT, "memdb", "users", []string{"id", "3"}, "name", `"a long name"`,
+		"age", `"not a number"`)
+	if status != http.StatusBadRequest {
+		t.Fatalf("Expected %d for an invalid value. Got: %d", http.StatusBadRequest, status)
+	}
+
+	status, err = write(t, api.PK_DELETE, "memdb", "users", []string{"id", "3"})
+	if status != http.StatusOK || err != nil {
+		t.Fatalf("Delete failed. Got: %d, Error: %v", status, err)
+	}
+	status, _, _ = read(t, "memdb", "users", common.HOPSWORKS_TEST_API_KEY, "id", "3")
+	if status != http.StatusNotFound {
+		t.Fatalf("Expected %d after delete. Got: %d", http.StatusNotFound, status)
+	}
+}
+
+func TestMemoryBatchTx(t *testing.T) {
+	withMemoryBackend(t)
+
+	db, table := "memdb", "users"
+	insert, update := api.PK_INSERT, api.PK_UPDATE
+	name := "name"
+	value := json.RawMessage(`"erin"`)
+	ops := []*api.BatchTxSubOpParams{
+		{WriteParams: &api.PKWriteParams{DB: &db, Table: &table, Operation: &insert,
+			Filters: filters("id", "4"), WriteColumns: &[]api.WriteColumn{{Column: &name, Value: &value}}}},
+		// fails as the row does not exist, so the insert is rolled back
+		{WriteParams: &api.PKWriteParams{DB: &db, Table: &table, Operation: &update,
+			Filters: filters("id", "100"), WriteColumns: &[]api.WriteColumn{{Column: &name, Value: &value}}}},
+	}
+
+	response := api.BatchResponseJSON{}
+	response.Init()
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+	status, err := batchops.GetBatcher().BatchTxOpsHandler(context.Background(), &ops, &apiKey, &response)
+	if status != http.StatusNotFound {
+		t.Fatalf("Expected %d. Got: %d. Error: %v", http.StatusNotFound, status, err)
+	}
+
+	codes := []int32{}
+	for _, subResp := range *response.Result {
+		codes = append(codes, *subResp.Code)
+	}
+	if len(codes) != 2 || codes[0] != http.StatusFailedDependency || codes[1] != http.StatusNotFound {
+		t.Fatalf("Expected [%d %d]. Got: %v", http.StatusFailedDependency, http.StatusNotFound, codes)
+	}
+
+	status, _, _ = read(t, db, table, apiKey, "id", "4")
+	if status != http.StatusNotFound {
+		t.Fatalf("Expected the insert to be rolled back. Got: %d", status)
+	}
+}
+
+func TestMemoryUniqueKeyRead(t *testing.T) {
+	withMemoryBackend(t)
+	db, table, index := "memdb", "users", "name_idx"
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+
+	uniqueRead := func(name string) (int, error, *api.PKReadResponseJSON) {
+		t.Helper()
+		params := api.PKReadParams{DB: &db, Table: &table, Index: &index,
+			Filters: filters("name", name)}
+		response := api.PKReadResponseJSON{}
+		response.Init()
+		status, err := pkread.GetPKReader().PkReadHandler(context.Background(), &params, &apiKey,
+			&response)
+		return status, err, &response
+	}
+
+	// the non key columns, including the primary key, are read
+	status, err, response := uniqueRead(`"alice"`)
+	if status != http.StatusOK || err != nil || response.Data == nil ||
+		string(*(*response.Data)["id"]) != "1" || string(*(*response.Data)["age"]) != "30" {
+		t.Fatalf("Expected the row of alice. Got: %d, %v, Error: %v", status, response.Data, err)
+	}
+
+	status, _, _ = uniqueRead(`"zoe"`)
+	if status != http.StatusNotFound {
+		t.Fatalf("Expected %d. Got: %d", http.StatusNotFound, status)
+	}
+
+	index = "age_idx"
+	status, err, _ = uniqueRead(`"alice"`)
+	if status != http.StatusBadRequest || err == nil || !strings.Contains(err.Error(), common.ERROR_040()) {
+		t.Fatalf("Expected %d with %s. Got: %d, Error: %v", http.StatusBadRequest,
+			common.ERROR_040(), status, err)
+	}
+
+	status, err = write(t, api.PK_INSERT, db, table, []string{"id", "6"}, "name", `"alice"`)
+	var dalErr *dal.DalError
+	if status != http.StatusConflict || !errors.As(err, &dalErr) ||
+		dalErr.NdbCode != memory.NDB_UNIQUE_VIOLATION_CODE {
+		t.Fatalf("Expected %d for a duplicate unique key. Got: %d, Error: %v", http.StatusConflict,
+			status, err)
+	}
+}
+
+func scanIDs(t *testing.T, response *api.ScanResponseJSON) []string {
+	t.Helper()
+	ids := []string{}
+	for _, row := range *response.Rows {
+		ids = append(ids, string(*row["id"]))
+	}
+	return ids
+}
+
+func TestMemoryIndexScan(t *testing.T) {
+	withMemoryBackend(t)
+	db, table, index := "memdb", "users", "age_idx"
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+
+	tests := []struct {
+		name     string
+		params   api.IndexScanParams
+		expected string
+	}{
+		// NULL is the smallest value
+		{name: "all", expected: "[2 5 1]"},
+		{name: "descending", params: api.IndexScanParams{Descending: boolPtr(true)},
+			expected: "[1 5 2]"},
+		{name: "lower bound", params: api.IndexScanParams{
+			LowerBound: &api.IndexBound{Columns: filters("age", "25")}}, expected: "[5 1]"},
+		{name: "exclusive lower bound", params: api.IndexScanParams{
+			LowerBound: &api.IndexBound{Columns: filters("age", "25"), Inclusive: boolPtr(false)}},
+			expected: "[1]"},
+		{name: "upper bound", params: api.IndexScanParams{
+			UpperBound: &api.IndexBound{Columns: filters("age", "29")}}, expected: "[2 5]"},
+		{name: "limit", params: api.IndexScanParams{Limit: uint32Ptr(1)}, expected: "[2]"},
+	}
+	for _, test := range tests {
+		params := test.params
+		params.DB, params.Table, params.Index = &db, &table, &index
+		response := api.ScanResponseJSON{}
+		response.Init()
+		status, err := pkread.GetIndexScanner().IndexScanHandler(context.Background(), &params,
+			&apiKey, &response)
+		if status != http.StatusOK {
+			t.Fatalf("%s. Expected %d. Got: %d, Error: %v", test.name, http.StatusOK, status, err)
+		}
+		if ids := fmt.Sprint(scanIDs(t, &response)); ids != test.expected {
+			t.Fatalf("%s. Expected %s. Got: %s", test.name, test.expected, ids)
+		}
+	}
+
+	// unique indexes can not be scanned
+	index = "name_idx"
+	response := api.ScanResponseJSON{}
+	response.Init()
+	status, err := pkread.GetIndexScanner().IndexScanHandler(context.Background(),
+		&api.IndexScanParams{DB: &db, Table: &table, Index: &index}, &apiKey, &response)
+	if status != http.StatusBadRequest || err == nil || !strings.Contains(err.Error(), common.ERROR_040()) {
+		t.Fatalf("Expected %d with %s. Got: %d, Error: %v", http.StatusBadRequest,
+			common.ERROR_040(), status, err)
+	}
+}
+
+func TestMemoryTableScan(t *testing.T) {
+	withMemoryBackend(t)
+	db, table := "memdb", "users"
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+
+	// the scan is continued with the token of the last page
+	params := api.TableScanParams{DB: &db, Table: &table, Limit: uint32Ptr(2)}
+	response := api.ScanResponseJSON{}
+	response.Init()
+	status, err := pkread.GetTableScanner().TableScanHandler(context.Background(), &params, &apiKey,
+		&response)
+	if status != http.StatusOK || fmt.Sprint(scanIDs(t, &response)) != "[1 2]" ||
+		response.ContinuationToken == nil {
+		t.Fatalf("Expected [1 2] and a token. Got: %d %v, Error: %v", status, scanIDs(t, &response), err)
+	}
+
+	params.ContinuationToken = response.ContinuationToken
+	response = api.ScanResponseJSON{}
+	response.Init()
+	status, err = pkread.GetTableScanner().TableScanHandler(context.Background(), &params, &apiKey,
+		&response)
+	if status != http.StatusOK || fmt.Sprint(scanIDs(t, &response)) != "[5]" ||
+		response.ContinuationToken != nil {
+		t.Fatalf("Expected [5] and no token. Got: %d %v, Error: %v", status, scanIDs(t, &response), err)
+	}
+
+	// age > 26 or name like "b%"
+	gt, or, like := api.FILTER_GT, api.FILTER_OR, api.FILTER_LIKE
+	age, name := "age", "name"
+	ageValue, nameValue := json.RawMessage("26"), json.RawMessage(`"b%"`)
+	params = api.TableScanParams{DB: &db, Table: &table, Filter: &api.FilterExpr{Op: &or,
+		Operands: &[]api.FilterExpr{{Op: &gt, Column: &age, Value: &ageValue},
+			{Op: &like, Column: &name, Value: &nameValue}}}}
+	response = api.ScanResponseJSON{}
+	response.Init()
+	status, err = pkread.GetTableScanner().TableScanHandler(context.Background(), &params, &apiKey,
+		&response)
+	if status != http.StatusOK || fmt.Sprint(scanIDs(t, &response)) != "[1 2]" {
+		t.Fatalf("Expected [1 2]. Got: %d %v, Error: %v", status, scanIDs(t, &response), err)
+	}
+}
+
+type scanRecorder struct {
+	api.ScanResponseJSON
+	flushes int
+}
+
+func (r *scanRecorder) Flush() error {
+	r.flushes++
+	return nil
+}
+
+func TestMemoryTableScanStream(t *testing.T) {
+	withMemoryBackend(t)
+	db, table := "memdb", "users"
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+
+	params := api.TableScanParams{DB: &db, Table: &table}
+	response := scanRecorder{}
+	response.Init()
+	status, err := pkread.GetTableScanner().TableScanStreamHandler(context.Background(), &params,
+		&apiKey, &response)
+	if status != http.StatusOK || fmt.Sprint(scanIDs(t, &response.ScanResponseJSON)) != "[1 2 5]" ||
+		response.flushes != 1 {
+		t.Fatalf("Expected [1 2 5] in a single page. Got: %d %v %d flushes, Error: %v", status,
+			scanIDs(t, &response.ScanResponseJSON), response.flushes, err)
+	}
+}
+
+func TestMemoryTableSchema(t *testing.T) {
+	withMemoryBackend(t)
+	db, table := "memdb", "users"
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+
+	params := api.TableSchemaParams{DB: &db, Table: &table}
+	response := api.TableSchemaResponse{}
+	status, err := pkread.GetSchemaReader().TableSchemaHandler(context.Background(), &params,
+		&apiKey, &response)
+	if status != http.StatusOK {
+		t.Fatalf("Expected %d. Got: %d, Error: %v", http.StatusOK, status, err)
+	}
+
+	expected := []dal.ColumnSchema{
+		{Name: "id", NdbType: "Int", MySQLType: "int", PrimaryKey: true},
+		{Name: "name", NdbType: "Varchar", MySQLType: "varchar(100)", Length: 100,
+			Charset: memory.DEFAULT_CHARSET},
+		{Name: "age", NdbType: "Int", MySQLType: "int", Nullable: true},
+	}
+	if len(response.Columns) != len(expected) {
+		t.Fatalf("Wrong number of columns. Got: %#v", response.Columns)
+	}
+	for i := range expected {
+		if response.Columns[i] != expected[i] {
+			t.Fatalf("Expected %#v. Got: %#v", expected[i], response.Columns[i])
+		}
+	}
+	expectedIndexes := []dal.IndexSchema{
+		{Name: memory.PRIMARY_INDEX, Type: dal.ORDERED_INDEX, Columns: []string{"id"}},
+		{Name: "name_idx", Type: dal.UNIQUE_INDEX, Columns: []string{"name"}},
+		{Name: "age_idx", Type: dal.ORDERED_INDEX, Columns: []string{"age"}},
+	}
+	if fmt.Sprint(response.Indexes) != fmt.Sprint(expectedIndexes) {
+		t.Fatalf("Expected %v. Got: %v", expectedIndexes, response.Indexes)
+	}
+
+	// the API key has no access to otherdb
+	db, table = "otherdb", "items"
+	status, _ = pkread.GetSchemaReader().TableSchemaHandler(context.Background(), &params,
+		&apiKey, &response)
+	if status != http.StatusUnauthorized {
+		t.Fatalf("Expected %d. Got: %d", http.StatusUnauthorized, status)
+	}
+}
+
+func TestMemoryListing(t *testing.T) {
+	withMemoryBackend(t)
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+
+	// otherdb is not a database of the API key
+	dbs := api.DatabasesResponse{}
+	status, err := pkread.GetLister().DatabasesHandler(context.Background(), &apiKey, &dbs)
+	if status != http.StatusOK || len(dbs.Databases) != 1 || dbs.Databases[0] != "memdb" {
+		t.Fatalf("Expected [memdb]. Got: %d %v, Error: %v", status, dbs.Databases, err)
+	}
+
+	db := "memdb"
+	params := api.TablesParams{DB: &db}
+	tables := api.TablesResponse{}
+	status, err = pkread.GetLister().TablesHandler(context.Background(), &params, &apiKey, &tables)
+	if status != http.StatusOK || len(tables.Tables) != 1 || tables.Tables[0] != "users" {
+		t.Fatalf("Expected [users]. Got: %d %v, Error: %v", status, tables.Tables, err)
+	}
+
+	db = "otherdb"
+	status, _ = pkread.GetLister().TablesHandler(context.Background(), &params, &apiKey, &tables)
+	if status != http.StatusUnauthorized {
+		t.Fatalf("Expected %d. Got: %d", http.StatusUnauthorized, status)
+	}
+}
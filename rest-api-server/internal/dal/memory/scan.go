@@ -0,0 +1,358 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"hopsworks.ai/rdrs/internal/dal/dalapi"
+)
+
+// IndexScan scans an ordered index, or the primary key for table scans.
+// Rows with the same index values are returned in the order of the primary
+// key. As in RonDB, a limit of 0 returns all the rows
+func (m *Memory) IndexScan(ctx context.Context, request *dalapi.NativeBuffer,
+	response *dalapi.NativeBuffer) *dalapi.DalError {
+	if err := ctx.Err(); err != nil {
+		return dalapi.ContextError(err)
+	}
+
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.scan(parseScanRequest(request), newResponse(response))
+}
+
+func (m *Memory) scan(req *scanRequest, resp *response) *dalapi.DalError {
+	t, dalErr := m.table(req.request)
+	if dalErr != nil {
+		return dalErr
+	}
+
+	tableScan := req.opType == dalapi.RDRS_TABLE_SCAN_REQ_ID
+	indexName := PRIMARY_INDEX
+	if !tableScan {
+		if req.index == nil {
+			return clientError(40, dalapi.ERROR_040)
+		}
+		indexName = *req.index
+	}
+	idx, ok := t.ordered[indexName]
+	if !ok {
+		return clientError(40, dalapi.ERROR_040+" Index: "+indexName)
+	}
+
+	for _, bound := range [][]keyValue{req.lower, req.upper} {
+		if dalErr := idx.checkBound(bound); dalErr != nil {
+			return dalErr
+		}
+	}
+	if len(req.filter) > 0 {
+		node := 0
+		if dalErr := t.checkFilter(req, &node); dalErr != nil {
+			return dalErr
+		}
+		if node != len(req.filter) {
+			return clientError(48, dalapi.ERROR_048+" Unexpected filter nodes")
+		}
+	}
+
+	// all the columns are read if the read columns are not set
+	columns := t.all
+	if req.readColumns != nil {
+		columns = make([]*column, len(req.readColumns))
+		for i, name := range req.readColumns {
+			col, ok := t.columns[name]
+			if !ok {
+				return clientError(12, dalapi.ERROR_012+" Column: "+name)
+			}
+			columns[i] = col
+		}
+	}
+
+	rows := []row{}
+	for _, r := range t.sortedRows(idx, req.flags&dalapi.RDRS_SCAN_DESCENDING != 0) {
+		node := 0
+		if idx.inBounds(r, req) && (len(req.filter) == 0 || t.matches(req, &node, r)) {
+			rows = append(rows, r)
+		}
+	}
+
+	// table scans are continued only if there are more rows
+	var next row
+	if req.limit != 0 && uint32(len(rows)) > req.limit {
+		rows = rows[:req.limit]
+		if tableScan {
+			next = rows[len(rows)-1]
+		}
+	}
+
+	if dalErr := resp.setRequest(req.request); dalErr != nil {
+		return dalErr
+	}
+	resp.setStatus(http.StatusOK)
+	for _, r := range rows {
+		if dalErr := resp.addRow(columns, r); dalErr != nil {
+			return dalErr
+		}
+	}
+	if next != nil {
+		if dalErr := resp.setNextKey(t.pk, next); dalErr != nil {
+			return dalErr
+		}
+	}
+	if dalErr := resp.setRows(); dalErr != nil {
+		return dalErr
+	}
+	resp.close()
+	return nil
+}
+
+// checkBound checks that the bound columns are a prefix of the index columns
+func (i *index) checkBound(bound []keyValue) *dalapi.DalError {
+	if len(bound) > len(i.columns) {
+		return clientError(42, fmt.Sprintf("%s Index has %d columns", dalapi.ERROR_042,
+			len(i.columns)))
+	}
+	for j, kv := range bound {
+		col := i.columns[j]
+		if kv.column != col.name {
+			return clientError(42, fmt.Sprintf("%s Column: %s. Expecting: %s", dalapi.ERROR_042,
+				kv.column, col.name))
+		}
+		if dalErr := col.checkValue(kv.value); dalErr != nil {
+			return dalErr
+		}
+	}
+	return nil
+}
+
+// inBounds returns true if the row is between the bounds of the scan. Only
+// the columns of a bound are compared, so a bound can be a prefix of the index
+func (i *index) inBounds(r row, req *scanRequest) bool {
+	if len(req.lower) > 0 {
+		c := i.compareBound(r, req.lower)
+		if c < 0 || (c == 0 && req.flags&dalapi.RDRS_SCAN_LOWER_BOUND_INCLUSIVE == 0) {
+			return false
+		}
+	}
+	if len(req.upper) > 0 {
+		c := i.compareBound(r, req.upper)
+		if c > 0 || (c == 0 && req.flags&dalapi.RDRS_SCAN_UPPER_BOUND_INCLUSIVE == 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func (i *index) compareBound(r row, bound []keyValue) int {
+	for j, kv := range bound {
+		if c := compareValues(i.columns[j], r[kv.column], kv.value); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// sortedRows returns the rows in the order of the index. Rows with the same
+// index values are sorted by the primary key
+func (t *table) sortedRows(idx *index, descending bool) []row {
+	rows := make([]row, 0, len(t.rows))
+	for _, r := range t.rows {
+		rows = append(rows, r)
+	}
+	sort.Slice(rows, func(a, b int) bool {
+		c := compareRows(idx.columns, rows[a], rows[b])
+		if c == 0 {
+			c = compareRows(t.pk, rows[a], rows[b])
+		}
+		if descending {
+			return c > 0
+		}
+		return c < 0
+	})
+	return rows
+}
+
+func compareRows(columns []*column, a, b row) int {
+	for _, col := range columns {
+		if c := compareValues(col, a[col.name], b[col.name]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareValues compares two values of a column. As in RonDB, NULL is
+// equal to NULL and smaller than any other value. Numbers are compared by
+// value and the other values byte by byte, i.e., the collation of character
+// columns is not used
+func compareValues(col *column, a, b *string) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	if isNumber(col.dataType) {
+		x, okX := new(big.Rat).SetString(*a)
+		y, okY := new(big.Rat).SetString(*b)
+		if okX && okY {
+			return x.Cmp(y)
+		}
+	}
+	return strings.Compare(*a, *b)
+}
+
+// checkFilter checks the filter node at node and its operands. node is
+// advanced past the checked nodes
+func (t *table) checkFilter(req *scanRequest, node *int) *dalapi.DalError {
+	if *node >= len(req.filter) {
+		return clientError(48, dalapi.ERROR_048+" Missing filter operands")
+	}
+	n := req.filter[*node]
+	(*node)++
+
+	switch n.nodeType {
+	case dalapi.RDRS_FILTER_AND, dalapi.RDRS_FILTER_OR, dalapi.RDRS_FILTER_NOT:
+		if n.arg == 0 || (n.nodeType == dalapi.RDRS_FILTER_NOT && n.arg != 1) {
+			return clientError(48, dalapi.ERROR_048+" Wrong number of operands")
+		}
+		for i := uint32(0); i < n.arg; i++ {
+			if dalErr := t.checkFilter(req, node); dalErr != nil {
+				return dalErr
+			}
+		}
+		return nil
+	case dalapi.RDRS_FILTER_EQ, dalapi.RDRS_FILTER_NE, dalapi.RDRS_FILTER_LT,
+		dalapi.RDRS_FILTER_LE, dalapi.RDRS_FILTER_GT, dalapi.RDRS_FILTER_GE,
+		dalapi.RDRS_FILTER_LIKE, dalapi.RDRS_FILTER_IS_NULL, dalapi.RDRS_FILTER_IS_NOT_NULL:
+		if n.arg >= uint32(len(req.filterValues)) {
+			return clientError(48, dalapi.ERROR_048+" Missing filter value")
+		}
+		value := req.filterValues[n.arg]
+		col, ok := t.columns[value.column]
+		if !ok {
+			return clientError(12, dalapi.ERROR_012+" Column: "+value.column)
+		}
+		if col.ndbType == "Blob" || col.ndbType == "Text" {
+			return clientError(48, dalapi.ERROR_048+" BLOB/TEXT columns can not be filtered. Column: "+
+				col.name)
+		}
+
+		switch n.nodeType {
+		case dalapi.RDRS_FILTER_IS_NULL, dalapi.RDRS_FILTER_IS_NOT_NULL:
+		case dalapi.RDRS_FILTER_LIKE:
+			if col.ndbType != "Char" && col.ndbType != "Varchar" {
+				return clientError(48, dalapi.ERROR_048+
+					" LIKE is only supported for string columns. Column: "+col.name)
+			}
+		default:
+			if value.value != nil && !checkValue(col.dataType, *value.value) {
+				return clientError(15, dalapi.ERROR_015+" Column: "+col.name)
+			}
+		}
+		return nil
+	}
+	return clientError(48, fmt.Sprintf("%s Type: %d", dalapi.ERROR_048, n.nodeType))
+}
+
+// matches evaluates the checked filter node at node and its operands on a
+// row. node is advanced past the evaluated nodes
+func (t *table) matches(req *scanRequest, node *int, r row) bool {
+	n := req.filter[*node]
+	(*node)++
+
+	switch n.nodeType {
+	case dalapi.RDRS_FILTER_AND, dalapi.RDRS_FILTER_OR, dalapi.RDRS_FILTER_NOT:
+		// all the operands are evaluated to advance node
+		result := n.nodeType == dalapi.RDRS_FILTER_AND
+		for i := uint32(0); i < n.arg; i++ {
+			operand := t.matches(req, node, r)
+			switch n.nodeType {
+			case dalapi.RDRS_FILTER_AND:
+				result = result && operand
+			case dalapi.RDRS_FILTER_OR:
+				result = result || operand
+			default:
+				result = !operand
+			}
+		}
+		return result
+	}
+
+	value := req.filterValues[n.arg]
+	col := t.columns[value.column]
+	switch n.nodeType {
+	case dalapi.RDRS_FILTER_IS_NULL:
+		return r[col.name] == nil
+	case dalapi.RDRS_FILTER_IS_NOT_NULL:
+		return r[col.name] != nil
+	case dalapi.RDRS_FILTER_LIKE:
+		return r[col.name] != nil && value.value != nil && like(*r[col.name], *value.value)
+	}
+
+	c := compareValues(col, r[col.name], value.value)
+	switch n.nodeType {
+	case dalapi.RDRS_FILTER_EQ:
+		return c == 0
+	case dalapi.RDRS_FILTER_NE:
+		return c != 0
+	case dalapi.RDRS_FILTER_LT:
+		return c < 0
+	case dalapi.RDRS_FILTER_LE:
+		return c <= 0
+	case dalapi.RDRS_FILTER_GT:
+		return c > 0
+	default: // RDRS_FILTER_GE
+		return c >= 0
+	}
+}
+
+// like matches a value with a SQL pattern, where % matches any characters,
+// _ a single character, and \ escapes the next character
+func like(value, pattern string) bool {
+	var b strings.Builder
+	b.WriteString("(?s)^")
+	escaped := false
+	for _, ch := range pattern {
+		switch {
+		case escaped:
+			b.WriteString(regexp.QuoteMeta(string(ch)))
+			escaped = false
+		case ch == '\\':
+			escaped = true
+		case ch == '%':
+			b.WriteString(".*")
+		case ch == '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+	b.WriteString("$")
+	matched, _ := regexp.MatchString(b.String(), value)
+	return matched
+}
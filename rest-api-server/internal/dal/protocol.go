@@ -0,0 +1,181 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dal
+
+/*
+#include "./../../../data-access-rondb/src/rdrs-const.h"
+#include "./../../../data-access-rondb/src/error-strs.h"
+#include "./../../../data-access-rondb/src/rdrs-dal.h"
+*/
+import "C"
+import (
+	"fmt"
+
+	"hopsworks.ai/rdrs/internal/dal/dalapi"
+)
+
+// checkProtocol returns an error if a constant of dalapi is not the
+// constant of the native layer
+func checkProtocol() error {
+	numbers := []struct {
+		name     string
+		native   int
+		constant int
+	}{
+		{"ADDRESS_SIZE", C.ADDRESS_SIZE, dalapi.ADDRESS_SIZE},
+		{"RDRS_PK_REQ_ID", C.RDRS_PK_REQ_ID, dalapi.RDRS_PK_REQ_ID},
+		{"RDRS_PK_RESP_ID", C.RDRS_PK_RESP_ID, dalapi.RDRS_PK_RESP_ID},
+		{"RDRS_BATCH_REQ_ID", C.RDRS_BATCH_REQ_ID, dalapi.RDRS_BATCH_REQ_ID},
+		{"RDRS_BATCH_RESP_ID", C.RDRS_BATCH_RESP_ID, dalapi.RDRS_BATCH_RESP_ID},
+		{"RDRS_PK_INSERT_REQ_ID", C.RDRS_PK_INSERT_REQ_ID, dalapi.RDRS_PK_INSERT_REQ_ID},
+		{"RDRS_PK_UPDATE_REQ_ID", C.RDRS_PK_UPDATE_REQ_ID, dalapi.RDRS_PK_UPDATE_REQ_ID},
+		{"RDRS_PK_UPSERT_REQ_ID", C.RDRS_PK_UPSERT_REQ_ID, dalapi.RDRS_PK_UPSERT_REQ_ID},
+		{"RDRS_PK_DELETE_REQ_ID", C.RDRS_PK_DELETE_REQ_ID, dalapi.RDRS_PK_DELETE_REQ_ID},
+		{"RDRS_INDEX_SCAN_REQ_ID", C.RDRS_INDEX_SCAN_REQ_ID, dalapi.RDRS_INDEX_SCAN_REQ_ID},
+		{"RDRS_SCAN_LOWER_BOUND_INCLUSIVE", C.RDRS_SCAN_LOWER_BOUND_INCLUSIVE, dalapi.RDRS_SCAN_LOWER_BOUND_INCLUSIVE},
+		{"RDRS_SCAN_UPPER_BOUND_INCLUSIVE", C.RDRS_SCAN_UPPER_BOUND_INCLUSIVE, dalapi.RDRS_SCAN_UPPER_BOUND_INCLUSIVE},
+		{"RDRS_SCAN_DESCENDING", C.RDRS_SCAN_DESCENDING, dalapi.RDRS_SCAN_DESCENDING},
+		{"RDRS_TABLE_SCAN_REQ_ID", C.RDRS_TABLE_SCAN_REQ_ID, dalapi.RDRS_TABLE_SCAN_REQ_ID},
+		{"RDRS_FILTER_AND", C.RDRS_FILTER_AND, dalapi.RDRS_FILTER_AND},
+		{"RDRS_FILTER_OR", C.RDRS_FILTER_OR, dalapi.RDRS_FILTER_OR},
+		{"RDRS_FILTER_NOT", C.RDRS_FILTER_NOT, dalapi.RDRS_FILTER_NOT},
+		{"RDRS_FILTER_EQ", C.RDRS_FILTER_EQ, dalapi.RDRS_FILTER_EQ},
+		{"RDRS_FILTER_NE", C.RDRS_FILTER_NE, dalapi.RDRS_FILTER_NE},
+		{"RDRS_FILTER_LT", C.RDRS_FILTER_LT, dalapi.RDRS_FILTER_LT},
+		{"RDRS_FILTER_LE", C.RDRS_FILTER_LE, dalapi.RDRS_FILTER_LE},
+		{"RDRS_FILTER_GT", C.RDRS_FILTER_GT, dalapi.RDRS_FILTER_GT},
+		{"RDRS_FILTER_GE", C.RDRS_FILTER_GE, dalapi.RDRS_FILTER_GE},
+		{"RDRS_FILTER_LIKE", C.RDRS_FILTER_LIKE, dalapi.RDRS_FILTER_LIKE},
+		{"RDRS_FILTER_IS_NULL", C.RDRS_FILTER_IS_NULL, dalapi.RDRS_FILTER_IS_NULL},
+		{"RDRS_FILTER_IS_NOT_NULL", C.RDRS_FILTER_IS_NOT_NULL, dalapi.RDRS_FILTER_IS_NOT_NULL},
+		{"RDRS_UNKNOWN_DATATYPE", C.RDRS_UNKNOWN_DATATYPE, dalapi.RDRS_UNKNOWN_DATATYPE},
+		{"RDRS_STRING_DATATYPE", C.RDRS_STRING_DATATYPE, dalapi.RDRS_STRING_DATATYPE},
+		{"RDRS_INTEGER_DATATYPE", C.RDRS_INTEGER_DATATYPE, dalapi.RDRS_INTEGER_DATATYPE},
+		{"RDRS_FLOAT_DATATYPE", C.RDRS_FLOAT_DATATYPE, dalapi.RDRS_FLOAT_DATATYPE},
+		{"RDRS_BINARY_DATATYPE", C.RDRS_BINARY_DATATYPE, dalapi.RDRS_BINARY_DATATYPE},
+		{"RDRS_DATETIME_DATATYPE", C.RDRS_DATETIME_DATATYPE, dalapi.RDRS_DATETIME_DATATYPE},
+		{"RDRS_BIT_DATATYPE", C.RDRS_BIT_DATATYPE, dalapi.RDRS_BIT_DATATYPE},
+		{"RDRS_DECIMAL_DATATYPE", C.RDRS_DECIMAL_DATATYPE, dalapi.RDRS_DECIMAL_DATATYPE},
+		{"RDRS_UNSIGNED_INTEGER_DATATYPE", C.RDRS_UNSIGNED_INTEGER_DATATYPE, dalapi.RDRS_UNSIGNED_INTEGER_DATATYPE},
+		{"DEFAULT_DRT", C.DEFAULT_DRT, dalapi.DEFAULT_DRT},
+		{"BASE64_DRT", C.BASE64_DRT, dalapi.BASE64_DRT},
+		{"HEX_DRT", C.HEX_DRT, dalapi.HEX_DRT},
+		{"STRING_DRT", C.STRING_DRT, dalapi.STRING_DRT},
+		{"PK_REQ_OP_TYPE_IDX", C.PK_REQ_OP_TYPE_IDX, dalapi.PK_REQ_OP_TYPE_IDX},
+		{"PK_REQ_CAPACITY_IDX", C.PK_REQ_CAPACITY_IDX, dalapi.PK_REQ_CAPACITY_IDX},
+		{"PK_REQ_LENGTH_IDX", C.PK_REQ_LENGTH_IDX, dalapi.PK_REQ_LENGTH_IDX},
+		{"PK_REQ_DB_IDX", C.PK_REQ_DB_IDX, dalapi.PK_REQ_DB_IDX},
+		{"PK_REQ_TABLE_IDX", C.PK_REQ_TABLE_IDX, dalapi.PK_REQ_TABLE_IDX},
+		{"PK_REQ_PK_COLS_IDX", C.PK_REQ_PK_COLS_IDX, dalapi.PK_REQ_PK_COLS_IDX},
+		{"PK_REQ_READ_COLS_IDX", C.PK_REQ_READ_COLS_IDX, dalapi.PK_REQ_READ_COLS_IDX},
+		{"PK_REQ_OP_ID_IDX", C.PK_REQ_OP_ID_IDX, dalapi.PK_REQ_OP_ID_IDX},
+		{"PK_REQ_VALUES_IDX", C.PK_REQ_VALUES_IDX, dalapi.PK_REQ_VALUES_IDX},
+		{"PK_REQ_INDEX_IDX", C.PK_REQ_INDEX_IDX, dalapi.PK_REQ_INDEX_IDX},
+		{"PK_REQ_LOWER_IDX", C.PK_REQ_LOWER_IDX, dalapi.PK_REQ_LOWER_IDX},
+		{"PK_REQ_UPPER_IDX", C.PK_REQ_UPPER_IDX, dalapi.PK_REQ_UPPER_IDX},
+		{"PK_REQ_FLAGS_IDX", C.PK_REQ_FLAGS_IDX, dalapi.PK_REQ_FLAGS_IDX},
+		{"PK_REQ_LIMIT_IDX", C.PK_REQ_LIMIT_IDX, dalapi.PK_REQ_LIMIT_IDX},
+		{"PK_REQ_FILTER_IDX", C.PK_REQ_FILTER_IDX, dalapi.PK_REQ_FILTER_IDX},
+		{"PK_REQ_FILTER_VALUES_IDX", C.PK_REQ_FILTER_VALUES_IDX, dalapi.PK_REQ_FILTER_VALUES_IDX},
+		{"PK_REQ_HEADER_END", C.PK_REQ_HEADER_END, dalapi.PK_REQ_HEADER_END},
+		{"PK_RESP_OP_TYPE_IDX", C.PK_RESP_OP_TYPE_IDX, dalapi.PK_RESP_OP_TYPE_IDX},
+		{"PK_RESP_OP_STATUS_IDX", C.PK_RESP_OP_STATUS_IDX, dalapi.PK_RESP_OP_STATUS_IDX},
+		{"PK_RESP_CAPACITY_IDX", C.PK_RESP_CAPACITY_IDX, dalapi.PK_RESP_CAPACITY_IDX},
+		{"PK_RESP_LENGTH_IDX", C.PK_RESP_LENGTH_IDX, dalapi.PK_RESP_LENGTH_IDX},
+		{"PK_RESP_DB_IDX", C.PK_RESP_DB_IDX, dalapi.PK_RESP_DB_IDX},
+		{"PK_RESP_TABLE_IDX", C.PK_RESP_TABLE_IDX, dalapi.PK_RESP_TABLE_IDX},
+		{"PK_RESP_COLS_IDX", C.PK_RESP_COLS_IDX, dalapi.PK_RESP_COLS_IDX},
+		{"PK_RESP_OP_ID_IDX", C.PK_RESP_OP_ID_IDX, dalapi.PK_RESP_OP_ID_IDX},
+		{"PK_RESP_ROWS_IDX", C.PK_RESP_ROWS_IDX, dalapi.PK_RESP_ROWS_IDX},
+		{"PK_RESP_NEXT_IDX", C.PK_RESP_NEXT_IDX, dalapi.PK_RESP_NEXT_IDX},
+		{"PK_RESP_HEADER_END", C.PK_RESP_HEADER_END, dalapi.PK_RESP_HEADER_END},
+	}
+	for _, c := range numbers {
+		if c.native != c.constant {
+			return fmt.Errorf("%s is %d in the native layer and %d in dalapi", c.name, c.native, c.constant)
+		}
+	}
+
+	strs := []struct {
+		name     string
+		native   string
+		constant string
+	}{
+		{"RDRS_PRIMARY_INDEX", C.RDRS_PRIMARY_INDEX, dalapi.RDRS_PRIMARY_INDEX},
+		{"RDRS_UNIQUE_INDEX_SUFFIX", C.RDRS_UNIQUE_INDEX_SUFFIX, dalapi.RDRS_UNIQUE_INDEX_SUFFIX},
+		{"ERROR_001", C.ERROR_001, dalapi.ERROR_001},
+		{"ERROR_002", C.ERROR_002, dalapi.ERROR_002},
+		{"ERROR_003", C.ERROR_003, dalapi.ERROR_003},
+		{"ERROR_004", C.ERROR_004, dalapi.ERROR_004},
+		{"ERROR_005", C.ERROR_005, dalapi.ERROR_005},
+		{"ERROR_006", C.ERROR_006, dalapi.ERROR_006},
+		{"ERROR_007", C.ERROR_007, dalapi.ERROR_007},
+		{"ERROR_008", C.ERROR_008, dalapi.ERROR_008},
+		{"ERROR_009", C.ERROR_009, dalapi.ERROR_009},
+		{"ERROR_010", C.ERROR_010, dalapi.ERROR_010},
+		{"ERROR_011", C.ERROR_011, dalapi.ERROR_011},
+		{"ERROR_012", C.ERROR_012, dalapi.ERROR_012},
+		{"ERROR_013", C.ERROR_013, dalapi.ERROR_013},
+		{"ERROR_014", C.ERROR_014, dalapi.ERROR_014},
+		{"ERROR_015", C.ERROR_015, dalapi.ERROR_015},
+		{"ERROR_016", C.ERROR_016, dalapi.ERROR_016},
+		{"ERROR_017", C.ERROR_017, dalapi.ERROR_017},
+		{"ERROR_018", C.ERROR_018, dalapi.ERROR_018},
+		{"ERROR_019", C.ERROR_019, dalapi.ERROR_019},
+		{"ERROR_020", C.ERROR_020, dalapi.ERROR_020},
+		{"ERROR_021", C.ERROR_021, dalapi.ERROR_021},
+		{"ERROR_022", C.ERROR_022, dalapi.ERROR_022},
+		{"ERROR_023", C.ERROR_023, dalapi.ERROR_023},
+		{"ERROR_024", C.ERROR_024, dalapi.ERROR_024},
+		{"ERROR_025", C.ERROR_025, dalapi.ERROR_025},
+		{"ERROR_026", C.ERROR_026, dalapi.ERROR_026},
+		{"ERROR_027", C.ERROR_027, dalapi.ERROR_027},
+		{"ERROR_028", C.ERROR_028, dalapi.ERROR_028},
+		{"ERROR_029", C.ERROR_029, dalapi.ERROR_029},
+		{"ERROR_030", C.ERROR_030, dalapi.ERROR_030},
+		{"ERROR_031", C.ERROR_031, dalapi.ERROR_031},
+		{"ERROR_032", C.ERROR_032, dalapi.ERROR_032},
+		{"ERROR_033", C.ERROR_033, dalapi.ERROR_033},
+		{"ERROR_034", C.ERROR_034, dalapi.ERROR_034},
+		{"ERROR_035", C.ERROR_035, dalapi.ERROR_035},
+		{"ERROR_036", C.ERROR_036, dalapi.ERROR_036},
+		{"ERROR_037", C.ERROR_037, dalapi.ERROR_037},
+		{"ERROR_038", C.ERROR_038, dalapi.ERROR_038},
+		{"ERROR_039", C.ERROR_039, dalapi.ERROR_039},
+		{"ERROR_040", C.ERROR_040, dalapi.ERROR_040},
+		{"ERROR_041", C.ERROR_041, dalapi.ERROR_041},
+		{"ERROR_042", C.ERROR_042, dalapi.ERROR_042},
+		{"ERROR_043", C.ERROR_043, dalapi.ERROR_043},
+		{"ERROR_044", C.ERROR_044, dalapi.ERROR_044},
+		{"ERROR_045", C.ERROR_045, dalapi.ERROR_045},
+		{"ERROR_046", C.ERROR_046, dalapi.ERROR_046},
+		{"ERROR_047", C.ERROR_047, dalapi.ERROR_047},
+		{"ERROR_048", C.ERROR_048, dalapi.ERROR_048},
+		{"ERROR_049", C.ERROR_049, dalapi.ERROR_049},
+		{"ERROR_050", C.ERROR_050, dalapi.ERROR_050},
+		{"ERROR_051", C.ERROR_051, dalapi.ERROR_051},
+		{"ERROR_052", C.ERROR_052, dalapi.ERROR_052},
+		{"ERROR_053", C.ERROR_053, dalapi.ERROR_053},
+	}
+	for _, c := range strs {
+		if c.native != c.constant {
+			return fmt.Errorf("%s is %q in the native layer and %q in dalapi", c.name, c.native, c.constant)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+//go:build !cgo
+
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dal
+
+import (
+	"context"
+	"net/http"
+
+	"hopsworks.ai/rdrs/internal/dal/dalapi"
+)
+
+// Without cgo the native layer is not linked. The RonDB backend fails with
+// 503, and the handlers are used with the other backends, e.g., dal/memory
+
+func errNoNativeLayer() *DalError {
+	return &DalError{HttpCode: http.StatusServiceUnavailable, ErrorCode: 49,
+		Message: dalapi.ERROR_049 + " The server was built without cgo"}
+}
+
+func InitRonDBConnection(connStr string, find_available_node_id bool) *DalError {
+	return errNoNativeLayer()
+}
+
+func ShutdownConnection() *DalError {
+	return nil
+}
+
+func RonDBPKRead(ctx context.Context, request *NativeBuffer, response *NativeBuffer) *DalError {
+	return errNoNativeLayer()
+}
+
+func RonDBPKWrite(ctx context.Context, request *NativeBuffer, response *NativeBuffer) *DalError {
+	return errNoNativeLayer()
+}
+
+func RonDBIndexScan(ctx context.Context, request *NativeBuffer, response *NativeBuffer) *DalError {
+	return errNoNativeLayer()
+}
+
+func RonDBBatchedPKRead(ctx context.Context, noOps uint32, requests []*NativeBuffer,
+	responses []*NativeBuffer) *DalError {
+	return errNoNativeLayer()
+}
+
+func RonDBBatchedPKTx(ctx context.Context, noOps uint32, requests []*NativeBuffer,
+	responses []*NativeBuffer) *DalError {
+	return errNoNativeLayer()
+}
+
+func GetRonDBStats() (*RonDBStats, *DalError) {
+	return nil, errNoNativeLayer()
+}
+
+func GetClusterStatus() (*ClusterStatus, *DalError) {
+	return &ClusterStatus{}, nil
+}
+
+func GetConnectionStats() ([]ConnectionStats, *DalError) {
+	return []ConnectionStats{}, nil
+}
+
+func GetTableSchema(db, table string) (*TableSchema, *DalError) {
+	return nil, errNoNativeLayer()
+}
+
+func ListDatabases() ([]string, *DalError) {
+	return nil, errNoNativeLayer()
+}
+
+func ListTables(db string) ([]string, *DalError) {
+	return nil, errNoNativeLayer()
+}
+
+func GetAPIKey(userKey string) (*HopsworksAPIKey, *DalError) {
+	return nil, errNoNativeLayer()
+}
+
+func GetUserProjects(uid int) ([]string, *DalError) {
+	return nil, errNoNativeLayer()
+}
+
+func CheckHopsworksTables() *DalError {
+	return errNoNativeLayer()
+}
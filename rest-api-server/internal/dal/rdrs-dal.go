@@ -29,42 +29,62 @@ package dal
 */
 import "C"
 import (
+	"context"
+	"fmt"
+	"math"
 	"net/http"
+	"sync/atomic"
+	"time"
 	"unsafe"
-)
 
-type DalError struct {
-	HttpCode    int
-	Message     string
-	ErrLineNo   int
-	ErrFileName string
-}
+	"hopsworks.ai/rdrs/internal/config"
+)
 
-func (e *DalError) Error() string {
-	return e.Message
-}
+// InitRonDBConnection creates the configured number of connections to the
+// cluster. The operations are distributed over the connections
+func InitRonDBConnection(connStr string, find_available_node_id bool) *DalError {
+	registerLogCallBack()
 
-type RonDBStats struct {
-	NdbObjectsCreationCount int64
-	NdbObjectsDeletionCount int64
-	NdbObjectsTotalCount    int64
-	NdbObjectsFreeCount     int64
-}
+	var loadBalancing C.LoadBalancing
+	switch config.Configuration().RonDBConfig.LoadBalancing {
+	case "", config.LOAD_BALANCING_ROUND_ROBIN:
+		loadBalancing = C.ROUND_ROBIN
+	case config.LOAD_BALANCING_LEAST_LOAD:
+		loadBalancing = C.LEAST_LOAD
+	default:
+		return &DalError{HttpCode: http.StatusInternalServerError,
+			Message: fmt.Sprintf("Unknown load balancing '%s'",
+				config.Configuration().RonDBConfig.LoadBalancing)}
+	}
 
-func InitRonDBConnection(connStr string, find_available_node_id bool) *DalError {
+	retry := config.Configuration().RonDBConfig.OperationRetry
+	C.set_retry_policy(C.Retry_Policy{
+		max_retries:        C.uint(retry.MaxRetries),
+		initial_backoff_ms: C.uint(retry.InitialBackoffMS),
+		max_backoff_ms:     C.uint(retry.MaxBackoffMS),
+		deadline_ms:        C.uint(retry.DeadlineMS),
+	})
 
 	cs := C.CString(connStr)
 	defer C.free(unsafe.Pointer(cs))
-	ret := C.init(cs, C.bool(find_available_node_id))
+	ret := C.init(cs, C.uint(config.Configuration().RonDBConfig.ConnectionPoolSize), loadBalancing,
+		C.bool(find_available_node_id))
 
 	if ret.http_code != http.StatusOK {
 		return cToGoRet(&ret)
 	}
 
+	atomic.StoreInt32(&connected, 1)
+	startConnectionMonitor()
 	return nil
 }
 
 func ShutdownConnection() *DalError {
+	stopConnectionMonitor()
+
+	atomic.StoreInt32(&connected, 0)
+	connMutex.Lock()
+	defer connMutex.Unlock()
 	ret := C.shutdown_connection()
 
 	if ret.http_code != http.StatusOK {
@@ -73,27 +93,109 @@ func ShutdownConnection() *DalError {
 	return nil
 }
 
-func RonDBPKRead(request *NativeBuffer, response *NativeBuffer) *DalError {
+func RonDBPKRead(ctx context.Context, request *NativeBuffer, response *NativeBuffer) *DalError {
 	// unsafe.Pointer
 	// create C structs for  buffers
 	var crequest C.RS_Buffer
 	var cresponse C.RS_Buffer
 	crequest.buffer = (*C.char)(request.Buffer)
 	crequest.size = C.uint(request.Size)
+	crequest.allocated = 0
 
 	cresponse.buffer = (*C.char)(response.Buffer)
 	cresponse.size = C.uint(response.Size)
+	cresponse.allocated = 0
 
-	ret := C.pk_read(&crequest, &cresponse)
+	timeoutMS, dalErr := requestTimeoutMS(ctx)
+	if dalErr != nil {
+		return dalErr
+	}
+	if dalErr := acquireConnection(); dalErr != nil {
+		return dalErr
+	}
+	ret := C.pk_read(&crequest, &cresponse, timeoutMS)
+	adoptResponseBuffer(&cresponse, response)
 
 	if ret.http_code != http.StatusOK {
-		return cToGoRet(&ret)
+		dalErr = cToGoRet(&ret)
 	}
+	releaseConnection(dalErr)
 
-	return nil
+	return dalErr
+}
+
+func RonDBPKWrite(ctx context.Context, request *NativeBuffer, response *NativeBuffer) *DalError {
+	var crequest C.RS_Buffer
+	var cresponse C.RS_Buffer
+	crequest.buffer = (*C.char)(request.Buffer)
+	crequest.size = C.uint(request.Size)
+	crequest.allocated = 0
+
+	cresponse.buffer = (*C.char)(response.Buffer)
+	cresponse.size = C.uint(response.Size)
+	cresponse.allocated = 0
+
+	timeoutMS, dalErr := requestTimeoutMS(ctx)
+	if dalErr != nil {
+		return dalErr
+	}
+	if dalErr := acquireConnection(); dalErr != nil {
+		return dalErr
+	}
+	ret := C.pk_write(&crequest, &cresponse, timeoutMS)
+	adoptResponseBuffer(&cresponse, response)
+
+	if ret.http_code != http.StatusOK {
+		dalErr = cToGoRet(&ret)
+	}
+	releaseConnection(dalErr)
+
+	return dalErr
+}
+
+func RonDBIndexScan(ctx context.Context, request *NativeBuffer, response *NativeBuffer) *DalError {
+	var crequest C.RS_Buffer
+	var cresponse C.RS_Buffer
+	crequest.buffer = (*C.char)(request.Buffer)
+	crequest.size = C.uint(request.Size)
+	crequest.allocated = 0
+
+	cresponse.buffer = (*C.char)(response.Buffer)
+	cresponse.size = C.uint(response.Size)
+	cresponse.allocated = 0
+
+	timeoutMS, dalErr := requestTimeoutMS(ctx)
+	if dalErr != nil {
+		return dalErr
+	}
+	if dalErr := acquireConnection(); dalErr != nil {
+		return dalErr
+	}
+	ret := C.index_scan(&crequest, &cresponse, timeoutMS)
+	adoptResponseBuffer(&cresponse, response)
+
+	if ret.http_code != http.StatusOK {
+		dalErr = cToGoRet(&ret)
+	}
+	releaseConnection(dalErr)
+
+	return dalErr
+}
+
+func RonDBBatchedPKRead(ctx context.Context, noOps uint32, requests []*NativeBuffer,
+	responses []*NativeBuffer) *DalError {
+	return batchedPKOperation(ctx, noOps, requests, responses, false)
 }
 
-func RonDBBatchedPKRead(noOps uint32, requests []*NativeBuffer, responses []*NativeBuffer) *DalError {
+// RonDBBatchedPKTx executes read and write operations in a single transaction.
+// The transaction is rolled back if any of the operations fails
+func RonDBBatchedPKTx(ctx context.Context, noOps uint32, requests []*NativeBuffer,
+	responses []*NativeBuffer) *DalError {
+	return batchedPKOperation(ctx, noOps, requests, responses, true)
+}
+
+func batchedPKOperation(ctx context.Context, noOps uint32, requests []*NativeBuffer, responses []*NativeBuffer,
+	transactional bool) *DalError {
 	reqMem := C.malloc(C.size_t(noOps) * C.size_t(C.sizeof_RS_Buffer))
 	defer C.free(reqMem)
 	cReqs := unsafe.Slice((*C.RS_Buffer)(reqMem), noOps)
@@ -105,23 +207,81 @@ func RonDBBatchedPKRead(noOps uint32, requests []*NativeBuffer, responses []*Nat
 	for i := 0; i < int(noOps); i++ {
 		cReqs[i].buffer = (*C.char)(requests[i].Buffer)
 		cReqs[i].size = C.uint(requests[i].Size)
+		cReqs[i].allocated = 0
 
 		cResps[i].buffer = (*C.char)(responses[i].Buffer)
 		cResps[i].size = C.uint(responses[i].Size)
+		cResps[i].allocated = 0
 	}
 
-	ret := C.pk_batch_read(C.uint(noOps), (*C.RS_Buffer)(reqMem), (*C.RS_Buffer)(respMem))
+	timeoutMS, dalErr := requestTimeoutMS(ctx)
+	if dalErr != nil {
+		return dalErr
+	}
+	if dalErr := acquireConnection(); dalErr != nil {
+		return dalErr
+	}
+	var ret C.RS_Status
+	if transactional {
+		ret = C.pk_batch_tx(C.uint(noOps), (*C.RS_Buffer)(reqMem), (*C.RS_Buffer)(respMem), timeoutMS)
+	} else {
+		ret = C.pk_batch_read(C.uint(noOps), (*C.RS_Buffer)(reqMem), (*C.RS_Buffer)(respMem),
+			timeoutMS)
+	}
+
+	for i := 0; i < int(noOps); i++ {
+		adoptResponseBuffer(&cResps[i], responses[i])
+	}
 
 	if ret.http_code != http.StatusOK {
-		return cToGoRet(&ret)
+		dalErr = cToGoRet(&ret)
 	}
+	releaseConnection(dalErr)
 
-	return nil
+	return dalErr
+}
+
+// requestTimeoutMS returns the time left until the deadline of the request,
+// which bounds the native operation. 0 means no deadline. Requests that are
+// already cancelled or past their deadline are not sent to RonDB
+func requestTimeoutMS(ctx context.Context) (C.uint, *DalError) {
+	if err := ctx.Err(); err != nil {
+		return 0, ContextError(err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0, nil
+	}
+	remaining := time.Until(deadline).Milliseconds()
+	if remaining <= 0 {
+		return 0, ContextError(context.DeadlineExceeded)
+	}
+	if remaining > math.MaxUint32 {
+		remaining = math.MaxUint32
+	}
+	return C.uint(remaining), nil
+}
+
+// The native layer replaces the response buffer with a larger buffer
+// if the response does not fit in it, e.g., when reading BLOB/TEXT columns.
+// The original buffer is returned to the pool and the larger buffer is
+// freed when it is returned
+func adoptResponseBuffer(cResponse *C.RS_Buffer, response *NativeBuffer) {
+	if cResponse.allocated == 0 {
+		return
+	}
+
+	ReturnBuffer(&NativeBuffer{Buffer: response.Buffer, Size: response.Size})
+	response.Buffer = unsafe.Pointer(cResponse.buffer)
+	response.Size = uint32(cResponse.size)
 }
 
 func cToGoRet(ret *C.RS_Status) *DalError {
 	return &DalError{HttpCode: int(ret.http_code), Message: C.GoString(&ret.message[0]),
-		ErrLineNo: int(ret.err_line_no), ErrFileName: C.GoString(&ret.err_file_name[0])}
+		ErrLineNo: int(ret.err_line_no), ErrFileName: C.GoString(&ret.err_file_name[0]),
+		Status: int(ret.status), Classification: int(ret.classification), NdbCode: int(ret.code),
+		MySQLCode: int(ret.mysql_code), ErrorCode: int(ret.error_code)}
 }
 
 func GetRonDBStats() (*RonDBStats, *DalError) {
@@ -139,6 +299,60 @@ func GetRonDBStats() (*RonDBStats, *DalError) {
 	rstats.NdbObjectsDeletionCount = int64(p.ndb_objects_deleted)
 	rstats.NdbObjectsTotalCount = int64(p.ndb_objects_count)
 	rstats.NdbObjectsFreeCount = int64(p.ndb_objects_available)
+	rstats.NdbOpRetries = int64(p.ndb_op_retries)
+	rstats.NdbOpRetriesExhausted = int64(p.ndb_op_retries_exhausted)
 
 	return &rstats, nil
 }
+
+func GetClusterStatus() (*ClusterStatus, *DalError) {
+	if dalErr := acquireConnection(); dalErr != nil {
+		return &ClusterStatus{}, nil
+	}
+	defer releaseConnection(nil)
+
+	var cstatus C.RonDB_Cluster_Status
+	ret := C.get_cluster_status(&cstatus)
+
+	if ret.http_code != http.StatusOK {
+		return nil, cToGoRet(&ret)
+	}
+
+	return &ClusterStatus{
+		Connected:      bool(cstatus.connected),
+		DataNodes:      int(cstatus.data_nodes),
+		AliveDataNodes: int(cstatus.alive_data_nodes),
+	}, nil
+}
+
+// GetConnectionStats returns the usage of each connection to the cluster.
+// No connections are returned while reconnecting
+func GetConnectionStats() ([]ConnectionStats, *DalError) {
+	if dalErr := acquireConnection(); dalErr != nil {
+		return []ConnectionStats{}, nil
+	}
+	defer releaseConnection(nil)
+
+	count := C.get_connection_count()
+	p := (*C.RonDB_Connection_Stats)(C.malloc(C.size_t(count) *
+		C.size_t(C.sizeof_RonDB_Connection_Stats)))
+	defer C.free(unsafe.Pointer(p))
+
+	ret := C.get_connection_stats(p)
+	if ret.http_code != http.StatusOK {
+		return nil, cToGoRet(&ret)
+	}
+
+	cstats := unsafe.Slice(p, count)
+	stats := make([]ConnectionStats, count)
+	for i, cstat := range cstats {
+		stats[i] = ConnectionStats{
+			NodeID:              int(cstat.node_id),
+			NdbObjectsCount:     int64(cstat.ndb_objects_count),
+			NdbObjectsFreeCount: int64(cstat.ndb_objects_available),
+			NdbObjectsInUse:     int64(cstat.ndb_objects_in_use),
+			Requests:            int64(cstat.requests),
+		}
+	}
+	return stats, nil
+}
@@ -29,13 +29,6 @@ import (
 	"unsafe"
 )
 
-type HopsworksAPIKey struct {
-	Secret string
-	Salt   string
-	Name   string
-	UserID int
-}
-
 func GetAPIKey(userKey string) (*HopsworksAPIKey, *DalError) {
 
 	cUserKey := C.CString(userKey)
@@ -44,7 +37,11 @@ func GetAPIKey(userKey string) (*HopsworksAPIKey, *DalError) {
 	apiKey := (*C.HopsworksAPIKey)(C.malloc(C.size_t(C.sizeof_HopsworksAPIKey)))
 	defer C.free(unsafe.Pointer(apiKey))
 
+	if dalErr := acquireConnection(); dalErr != nil {
+		return nil, dalErr
+	}
 	ret := C.find_api_key(cUserKey, apiKey)
+	releaseConnection(nil)
 
 	if ret.http_code != http.StatusOK {
 		return nil, cToGoRet(&ret)
@@ -69,7 +66,11 @@ func GetUserProjects(uid int) ([]string, *DalError) {
 	var projects **C.char
 	projectsPtr := (***C.char)(unsafe.Pointer(&projects))
 
+	if dalErr := acquireConnection(); dalErr != nil {
+		return nil, dalErr
+	}
 	ret := C.find_all_projects(C.int(uid), projectsPtr, countptr)
+	releaseConnection(nil)
 
 	dstBuf := unsafe.Slice((**C.char)(unsafe.Pointer(projects)), count)
 
@@ -86,3 +87,19 @@ func GetUserProjects(uid int) ([]string, *DalError) {
 
 	return dbs, nil
 }
+
+// CheckHopsworksTables checks that the tables used to validate
+// the API keys can be opened
+func CheckHopsworksTables() *DalError {
+	if dalErr := acquireConnection(); dalErr != nil {
+		return dalErr
+	}
+	ret := C.check_hopsworks_tables()
+	releaseConnection(nil)
+
+	if ret.http_code != http.StatusOK {
+		return cToGoRet(&ret)
+	}
+
+	return nil
+}
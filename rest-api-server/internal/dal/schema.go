@@ -0,0 +1,118 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dal
+
+/*
+#include <stdlib.h>
+#include "./../../../data-access-rondb/src/rdrs-dal.h"
+*/
+import "C"
+import (
+	"net/http"
+	"unsafe"
+)
+
+// GetTableSchema returns the columns and indexes of a table
+func GetTableSchema(db, table string) (*TableSchema, *DalError) {
+	cDB := C.CString(db)
+	defer C.free(unsafe.Pointer(cDB))
+	cTable := C.CString(table)
+	defer C.free(unsafe.Pointer(cTable))
+
+	if dalErr := acquireConnection(); dalErr != nil {
+		return nil, dalErr
+	}
+	var cschema C.Table_Schema
+	ret := C.table_schema(cDB, cTable, &cschema)
+	if ret.http_code != http.StatusOK {
+		dalErr := cToGoRet(&ret)
+		releaseConnection(dalErr)
+		return nil, dalErr
+	}
+	releaseConnection(nil)
+	defer C.free(unsafe.Pointer(cschema.columns))
+	defer C.free(unsafe.Pointer(cschema.indexes))
+
+	schema := TableSchema{
+		Columns: make([]ColumnSchema, cschema.no_columns),
+		Indexes: make([]IndexSchema, cschema.no_indexes),
+	}
+	for i, col := range unsafe.Slice(cschema.columns, cschema.no_columns) {
+		schema.Columns[i] = ColumnSchema{
+			Name:       C.GoString(&col.name[0]),
+			NdbType:    C.GoString(&col.ndb_type[0]),
+			MySQLType:  C.GoString(&col.mysql_type[0]),
+			Length:     int(col.length),
+			Precision:  int(col.precision),
+			Scale:      int(col.scale),
+			Charset:    C.GoString(&col.charset[0]),
+			Nullable:   bool(col.nullable),
+			PrimaryKey: bool(col.primary_key),
+		}
+	}
+	for i, index := range unsafe.Slice(cschema.indexes, cschema.no_indexes) {
+		indexType := ORDERED_INDEX
+		if index._type == C.UNIQUE_INDEX {
+			indexType = UNIQUE_INDEX
+		}
+		columns := make([]string, index.no_columns)
+		for c := range columns {
+			columns[c] = C.GoString(&index.columns[c][0])
+		}
+		schema.Indexes[i] = IndexSchema{Name: C.GoString(&index.name[0]), Type: indexType,
+			Columns: columns}
+	}
+	return &schema, nil
+}
+
+// ListDatabases returns the sorted names of the databases that have tables
+func ListDatabases() ([]string, *DalError) {
+	return listObjects(func(list *C.Object_List) C.RS_Status {
+		return C.list_databases(list)
+	})
+}
+
+// ListTables returns the sorted names of the tables of a database
+func ListTables(db string) ([]string, *DalError) {
+	cDB := C.CString(db)
+	defer C.free(unsafe.Pointer(cDB))
+	return listObjects(func(list *C.Object_List) C.RS_Status {
+		return C.list_tables(cDB, list)
+	})
+}
+
+func listObjects(list func(list *C.Object_List) C.RS_Status) ([]string, *DalError) {
+	if dalErr := acquireConnection(); dalErr != nil {
+		return nil, dalErr
+	}
+	var clist C.Object_List
+	ret := list(&clist)
+	if ret.http_code != http.StatusOK {
+		dalErr := cToGoRet(&ret)
+		releaseConnection(dalErr)
+		return nil, dalErr
+	}
+	releaseConnection(nil)
+	defer C.free(unsafe.Pointer(clist.names))
+
+	names := make([]string, clist.no_names)
+	for i, name := range unsafe.Slice(clist.names, clist.no_names) {
+		names[i] = C.GoString(&name[0])
+	}
+	return names, nil
+}
@@ -17,20 +17,26 @@
 package batchops
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"hopsworks.ai/rdrs/internal/common"
 	"hopsworks.ai/rdrs/internal/config"
 	"hopsworks.ai/rdrs/internal/dal"
 	"hopsworks.ai/rdrs/internal/handlers"
 	"hopsworks.ai/rdrs/internal/handlers/pkread"
 	"hopsworks.ai/rdrs/internal/log"
+	"hopsworks.ai/rdrs/internal/metrics"
 	"hopsworks.ai/rdrs/internal/security/apikey"
+	"hopsworks.ai/rdrs/internal/tracing"
 	"hopsworks.ai/rdrs/pkg/api"
 )
 
@@ -76,7 +82,7 @@ func (b *Batch) BatchOpsHttpHandler(c *gin.Context) {
 	var response api.BatchOpResponse = (api.BatchOpResponse)(&api.BatchResponseJSON{})
 	response.Init()
 
-	status, err := batch.BatchOpsHandler(&pkOperations, getAPIKey(c), response)
+	status, err := batch.BatchOpsHandler(c.Request.Context(), &pkOperations, getAPIKey(c), response)
 	if err != nil {
 		common.SetResponseBodyError(c, status, err)
 	}
@@ -84,38 +90,47 @@ func (b *Batch) BatchOpsHttpHandler(c *gin.Context) {
 	common.SetResponseBody(c, status, &response)
 }
 
-func (b *Batch) BatchOpsHandler(pkOperations *[]*api.PKReadParams, apiKey *string, response api.BatchOpResponse) (int, error) {
+func (b *Batch) BatchOpsHandler(ctx context.Context, pkOperations *[]*api.PKReadParams, apiKey *string, response api.BatchOpResponse) (int, error) {
+	start := time.Now()
 
-	err := checkAPIKey(pkOperations, apiKey)
+	err := checkAPIKey(ctx, pkOperations, apiKey)
 	if err != nil {
-		return http.StatusUnauthorized, err
+		return pkread.APIKeyErrorStatus(err), err
 	}
 
 	noOps := uint32(len(*pkOperations))
 	reqPtrs := make([]*dal.NativeBuffer, noOps)
 	respPtrs := make([]*dal.NativeBuffer, noOps)
 
+	_, span := tracing.Tracer().Start(ctx, "CreateNativeRequest")
 	for i, pkOp := range *pkOperations {
 		reqPtrs[i], respPtrs[i], err = pkread.CreateNativeRequest(pkOp)
 		defer dal.ReturnBuffer(reqPtrs[i])
 		defer dal.ReturnBuffer(respPtrs[i])
 		if err != nil {
+			tracing.SetError(span, err)
+			span.End()
 			return http.StatusInternalServerError, err
 		}
 	}
+	span.End()
 
-	dalErr := dal.RonDBBatchedPKRead(noOps, reqPtrs, respPtrs)
-	var message string
+	_, span = tracing.Tracer().Start(ctx, "pk_batch_read", trace.WithSpanKind(trace.SpanKindClient))
+	span.SetAttributes(attribute.String("db.system", "rondb"), attribute.Int("rdrs.batch.size", int(noOps)))
+	dalErr := dal.GetDataAccess().BatchedPKRead(ctx, noOps, reqPtrs, respPtrs)
+	pkread.EndNativeSpan(span, dalErr)
 	if dalErr != nil {
+		observeFailedReads(*pkOperations, dalErr.HttpCode, start)
 		if dalErr.HttpCode >= http.StatusInternalServerError {
-			message = fmt.Sprintf("%v File: %v, Line: %v ", dalErr.Message, dalErr.ErrFileName, dalErr.ErrLineNo)
-		} else {
-			message = fmt.Sprintf("%v", dalErr.Message)
+			return dalErr.HttpCode, fmt.Errorf("%w File: %v, Line: %v ", dalErr, dalErr.ErrFileName, dalErr.ErrLineNo)
 		}
-		return dalErr.HttpCode, fmt.Errorf("%s", message)
+		return dalErr.HttpCode, dalErr
 	}
 
-	status, err := processResponses(&respPtrs, response)
+	_, span = tracing.Tracer().Start(ctx, "ProcessPKReadResponse")
+	status, err := processResponses(&respPtrs, *pkOperations, start, response)
+	tracing.SetError(span, err)
+	span.End()
 	if err != nil {
 		return status, err
 	}
@@ -123,8 +138,14 @@ func (b *Batch) BatchOpsHandler(pkOperations *[]*api.PKReadParams, apiKey *strin
 	return http.StatusOK, nil
 }
 
-func processResponses(respBuffs *[]*dal.NativeBuffer, response api.BatchOpResponse) (int, error) {
-	for _, respBuff := range *respBuffs {
+// processResponses returns the status of the first sub operation that
+// failed, or http.StatusOK if all sub operations were successful. The table
+// stats of the read operations, which are nil for writes, are updated with
+// the latency of the whole batch
+func processResponses(respBuffs *[]*dal.NativeBuffer, readOps []*api.PKReadParams, start time.Time,
+	response api.BatchOpResponse) (int, error) {
+	status := http.StatusOK
+	for i, respBuff := range *respBuffs {
 
 		pkReadResponseWithCode := response.CreateNewSubResponse()
 		pkReadResponse := pkReadResponseWithCode.GetPKReadResponse()
@@ -134,13 +155,38 @@ func processResponses(respBuffs *[]*dal.NativeBuffer, response api.BatchOpRespon
 			return int(subRespCode), err
 		}
 
+		if readOps[i] != nil {
+			var bytes uint32 = 0
+			if subRespCode == http.StatusOK {
+				bytes = pkread.ResponseDataLength(respBuff)
+			}
+			metrics.ObserveTableRead(*readOps[i].DB, *readOps[i].Table, int(subRespCode), bytes,
+				time.Since(start))
+		}
+
 		pkReadResponseWithCode.SetCode(&subRespCode)
 		err = response.AppendSubResponse(pkReadResponseWithCode)
 		if err != nil {
 			return http.StatusInternalServerError, err
 		}
+
+		// operations that were rolled back due to the failure
+		// of an other operation in the transaction are ignored
+		if status == http.StatusOK && subRespCode != http.StatusOK &&
+			subRespCode != http.StatusFailedDependency {
+			status = int(subRespCode)
+		}
+	}
+	return status, nil
+}
+
+// observeFailedReads counts the read operations of a failed batch as errors
+func observeFailedReads(readOps []*api.PKReadParams, status int, start time.Time) {
+	for _, readOp := range readOps {
+		if readOp != nil {
+			metrics.ObserveTableRead(*readOp.DB, *readOp.Table, status, 0, time.Since(start))
+		}
 	}
-	return http.StatusOK, nil
 }
 
 func parseOperation(operation *api.BatchSubOp, pkReadarams *api.PKReadParams) error {
@@ -189,7 +235,15 @@ func getAPIKey(c *gin.Context) *string {
 	return &apiKey
 }
 
-func checkAPIKey(pkOperations *[]*api.PKReadParams, apiKey *string) error {
+func checkAPIKey(ctx context.Context, pkOperations *[]*api.PKReadParams, apiKey *string) error {
+	dbs := make([]*string, len(*pkOperations))
+	for i, op := range *pkOperations {
+		dbs[i] = op.DB
+	}
+	return checkAPIKeyForDBs(ctx, dbs, apiKey)
+}
+
+func checkAPIKeyForDBs(ctx context.Context, dbs []*string, apiKey *string) error {
 	// check for Hopsworks api keys
 	if config.Configuration().Security.UseHopsWorksAPIKeys {
 		if apiKey == nil || *apiKey == "" { // not set
@@ -199,15 +253,18 @@ func checkAPIKey(pkOperations *[]*api.PKReadParams, apiKey *string) error {
 		dbMap := make(map[string]bool)
 		dbArr := []*string{}
 
-		for _, op := range *pkOperations {
-			dbMap[*op.DB] = true
-		}
-
-		for dbKey := range dbMap {
-			dbArr = append(dbArr, &dbKey)
+		for _, db := range dbs {
+			if _, ok := dbMap[*db]; !ok {
+				dbMap[*db] = true
+				dbArr = append(dbArr, db)
+			}
 		}
 
-		return apikey.ValidateAPIKey(apiKey, dbArr...)
+		_, span := tracing.Tracer().Start(ctx, "ValidateAPIKey")
+		defer span.End()
+		err := apikey.ValidateAPIKey(apiKey, dbArr...)
+		tracing.SetError(span, err)
+		return err
 	}
 	return nil
 }
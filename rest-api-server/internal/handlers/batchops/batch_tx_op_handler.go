@@ -0,0 +1,222 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+package batchops
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/handlers/pkread"
+	"hopsworks.ai/rdrs/internal/log"
+	"hopsworks.ai/rdrs/internal/tracing"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+var txRelativeURLRegex = regexp.MustCompile("^[a-zA-Z0-9$_]+/[a-zA-Z0-9$_]+/(" +
+	config.PK_DB_OPERATION + "|" + config.PK_INSERT_DB_OPERATION + "|" +
+	config.PK_UPDATE_DB_OPERATION + "|" + config.PK_UPSERT_DB_OPERATION + "|" +
+	config.PK_DELETE_DB_OPERATION + ")$")
+
+func (b *Batch) BatchTxOpsHttpHandler(c *gin.Context) {
+	operations := api.BatchTxOpRequest{}
+	err := c.ShouldBindJSON(&operations)
+	if err != nil {
+		if log.IsDebug() {
+			body, _ := ioutil.ReadAll(c.Request.Body)
+			log.Debugf("Unable to parse request. Error: %v. Body: %s\n", err, body)
+		}
+		common.SetResponseBodyError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if operations.Operations == nil {
+		common.SetResponseBodyError(c, http.StatusBadRequest, fmt.Errorf("No valid operations found"))
+		return
+	}
+
+	txOperations := make([]*api.BatchTxSubOpParams, len(*operations.Operations))
+	for i, operation := range *operations.Operations {
+		txOperations[i], err = parseTxOperation(&operation)
+		if err != nil {
+			if log.IsDebug() {
+				log.Debugf("Error: %v", err)
+			}
+			common.SetResponseBodyError(c, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	var response api.BatchOpResponse = (api.BatchOpResponse)(&api.BatchResponseJSON{})
+	response.Init()
+
+	status, err := batch.BatchTxOpsHandler(c.Request.Context(), &txOperations, getAPIKey(c), response)
+	if err != nil {
+		common.SetResponseBodyError(c, status, err)
+		return
+	}
+
+	common.SetResponseBody(c, status, &response)
+}
+
+// BatchTxOpsHandler executes all the operations in a single transaction.
+// If any of the operations fails then the transaction is rolled back and the
+// status of the failed operation is returned. The other operations in the
+// response have status http.StatusFailedDependency
+func (b *Batch) BatchTxOpsHandler(ctx context.Context, txOperations *[]*api.BatchTxSubOpParams,
+	apiKey *string, response api.BatchOpResponse) (int, error) {
+	start := time.Now()
+
+	dbs := make([]*string, len(*txOperations))
+	readOps := make([]*api.PKReadParams, len(*txOperations))
+	for i, txOp := range *txOperations {
+		err := validateTxOperation(txOp)
+		if err != nil {
+			return http.StatusBadRequest, err
+		}
+
+		if txOp.ReadParams != nil {
+			dbs[i] = txOp.ReadParams.DB
+			readOps[i] = txOp.ReadParams
+		} else {
+			dbs[i] = txOp.WriteParams.DB
+		}
+	}
+
+	err := checkAPIKeyForDBs(ctx, dbs, apiKey)
+	if err != nil {
+		return pkread.APIKeyErrorStatus(err), err
+	}
+
+	noOps := uint32(len(*txOperations))
+	reqPtrs := make([]*dal.NativeBuffer, noOps)
+	respPtrs := make([]*dal.NativeBuffer, noOps)
+
+	for i, txOp := range *txOperations {
+		if txOp.ReadParams != nil {
+			reqPtrs[i], respPtrs[i], err = pkread.CreateNativeRequest(txOp.ReadParams)
+		} else {
+			reqPtrs[i], respPtrs[i], err = pkread.CreateNativeWriteRequest(txOp.WriteParams)
+		}
+		defer dal.ReturnBuffer(reqPtrs[i])
+		defer dal.ReturnBuffer(respPtrs[i])
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
+
+	_, span := tracing.Tracer().Start(ctx, "pk_batch_tx", trace.WithSpanKind(trace.SpanKindClient))
+	span.SetAttributes(attribute.String("db.system", "rondb"), attribute.Int("rdrs.batch.size", int(noOps)))
+	dalErr := dal.GetDataAccess().BatchedPKTx(ctx, noOps, reqPtrs, respPtrs)
+	pkread.EndNativeSpan(span, dalErr)
+	if dalErr != nil {
+		observeFailedReads(readOps, dalErr.HttpCode, start)
+		if dalErr.HttpCode >= http.StatusInternalServerError {
+			return dalErr.HttpCode, fmt.Errorf("%w File: %v, Line: %v ", dalErr, dalErr.ErrFileName, dalErr.ErrLineNo)
+		}
+		return dalErr.HttpCode, dalErr
+	}
+
+	return processResponses(&respPtrs, readOps, start, response)
+}
+
+func parseTxOperation(operation *api.BatchTxSubOp) (*api.BatchTxSubOpParams, error) {
+
+	//remove leading / character
+	if strings.HasPrefix(*operation.RelativeURL, "/") {
+		trimmed := strings.Trim(*operation.RelativeURL, "/")
+		operation.RelativeURL = &trimmed
+	}
+
+	if !txRelativeURLRegex.MatchString(*operation.RelativeURL) {
+		return nil, fmt.Errorf("Invalid Relative URL: %s", *operation.RelativeURL)
+	}
+
+	//split the relative url to extract path parameters
+	splits := strings.Split(*operation.RelativeURL, "/")
+	if len(splits) != 3 {
+		return nil, fmt.Errorf("Failed to extract database and table information from relative url")
+	}
+
+	body := operation.Body
+	txOp := api.BatchTxSubOpParams{}
+	if splits[2] == config.PK_DB_OPERATION {
+		if body.WriteColumns != nil {
+			return nil, fmt.Errorf("Invalid operation. Read operation does not accept write columns")
+		}
+
+		txOp.ReadParams = &api.PKReadParams{
+			DB:          &splits[0],
+			Table:       &splits[1],
+			Filters:     body.Filters,
+			ReadColumns: body.ReadColumns,
+			OperationID: body.OperationID,
+		}
+	} else {
+		if body.ReadColumns != nil {
+			return nil, fmt.Errorf("Invalid operation. Write operation does not accept read columns")
+		}
+
+		var operation string
+		switch splits[2] {
+		case config.PK_INSERT_DB_OPERATION:
+			operation = api.PK_INSERT
+		case config.PK_UPDATE_DB_OPERATION:
+			operation = api.PK_UPDATE
+		case config.PK_UPSERT_DB_OPERATION:
+			operation = api.PK_UPSERT
+		case config.PK_DELETE_DB_OPERATION:
+			operation = api.PK_DELETE
+		}
+
+		txOp.WriteParams = &api.PKWriteParams{
+			DB:           &splits[0],
+			Table:        &splits[1],
+			Operation:    &operation,
+			Filters:      body.Filters,
+			WriteColumns: body.WriteColumns,
+			OperationID:  body.OperationID,
+		}
+	}
+
+	return &txOp, nil
+}
+
+func validateTxOperation(txOp *api.BatchTxSubOpParams) error {
+	if (txOp.ReadParams == nil) == (txOp.WriteParams == nil) {
+		return fmt.Errorf("Invalid operation. Exactly one of read or write operation must be set")
+	}
+
+	if txOp.ReadParams != nil {
+		if txOp.ReadParams.DB == nil || txOp.ReadParams.Table == nil || txOp.ReadParams.Filters == nil {
+			return fmt.Errorf("Invalid operation. Database, table and filters are required")
+		}
+		return pkread.ValidatePKReadRequest(txOp.ReadParams)
+	}
+
+	return pkread.ValidatePKWriteRequest(txOp.WriteParams)
+}
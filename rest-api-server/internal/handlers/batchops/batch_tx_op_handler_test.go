@@ -0,0 +1,206 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package batchops
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+	tu "hopsworks.ai/rdrs/internal/handlers/utils"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+func TestBatchTxREST(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB004"},
+		getBatchHandler(), func(tc common.TestContext) {
+
+			// Test. mixed operations on multiple tables are committed together
+			ops := []api.BatchTxSubOp{
+				txSubOp("DB004/int_table/"+config.PK_INSERT_DB_OPERATION,
+					tu.NewFiltersKVs("id0", 5, "id1", 5), nil, tu.NewWriteColumnsKVs("col0", 1, "col1", 2)),
+				txSubOp("DB004/int_table1/"+config.PK_UPSERT_DB_OPERATION,
+					tu.NewFiltersKVs("id0", 5, "id1", 5), nil, nil),
+				txSubOp("DB004/int_table/"+config.PK_UPDATE_DB_OPERATION,
+					tu.NewFiltersKVs("id0", 0, "id1", 0), nil, tu.NewWriteColumnsKVs("col0", 7)),
+				txSubOp("DB004/int_table/"+config.PK_DELETE_DB_OPERATION,
+					tu.NewFiltersKVs("id0", 1, "id1", 1), nil, nil),
+				txSubOp("DB004/int_table/"+config.PK_DB_OPERATION,
+					tu.NewFiltersKVs("id0", 2147483647, "id1", 4294967295), tu.NewReadColumn("col0"), nil),
+			}
+			res := sendBatchTxHttpRequest(t, tc, ops, http.StatusOK)
+			checkTxCodes(t, res, http.StatusOK, http.StatusOK, http.StatusOK, http.StatusOK, http.StatusOK)
+			checkTxData(t, res, 4, "col0", "2147483647")
+
+			// read back
+			ops = []api.BatchTxSubOp{
+				txSubOp("DB004/int_table/"+config.PK_DB_OPERATION,
+					tu.NewFiltersKVs("id0", 5, "id1", 5), tu.NewReadColumn("col1"), nil),
+				txSubOp("DB004/int_table/"+config.PK_DB_OPERATION,
+					tu.NewFiltersKVs("id0", 0, "id1", 0), tu.NewReadColumn("col0"), nil),
+				txSubOp("DB004/int_table1/"+config.PK_DB_OPERATION,
+					tu.NewFiltersKVs("id0", 5, "id1", 5), nil, nil),
+			}
+			res = sendBatchTxHttpRequest(t, tc, ops, http.StatusOK)
+			checkTxCodes(t, res, http.StatusOK, http.StatusOK, http.StatusOK)
+			checkTxData(t, res, 0, "col1", "2")
+			checkTxData(t, res, 1, "col0", "7")
+
+			// Test. a failed operation rolls back the whole transaction.
+			// The row deleted above does not exist anymore
+			ops = []api.BatchTxSubOp{
+				txSubOp("DB004/int_table/"+config.PK_INSERT_DB_OPERATION,
+					tu.NewFiltersKVs("id0", 6, "id1", 6), nil, tu.NewWriteColumnsKVs("col0", 1)),
+				txSubOp("DB004/int_table/"+config.PK_UPDATE_DB_OPERATION,
+					tu.NewFiltersKVs("id0", 1, "id1", 1), nil, tu.NewWriteColumnsKVs("col0", 1)),
+			}
+			res = sendBatchTxHttpRequest(t, tc, ops, http.StatusNotFound)
+			checkTxCodes(t, res, http.StatusFailedDependency, http.StatusNotFound)
+
+			ops = []api.BatchTxSubOp{
+				txSubOp("DB004/int_table/"+config.PK_DB_OPERATION,
+					tu.NewFiltersKVs("id0", 6, "id1", 6), nil, nil),
+			}
+			res = sendBatchTxHttpRequest(t, tc, ops, http.StatusNotFound)
+			checkTxCodes(t, res, http.StatusNotFound)
+		})
+}
+
+func TestBatchTxGRPC(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB004"},
+		getBatchHandler(), func(tc common.TestContext) {
+			db := "DB004"
+			table := "int_table"
+			insert := api.PK_INSERT
+
+			newRow := api.BatchTxSubOpParams{WriteParams: &api.PKWriteParams{
+				DB: &db, Table: &table, Operation: &insert,
+				Filters:      tu.NewFiltersKVs("id0", 7, "id1", 7),
+				WriteColumns: tu.NewWriteColumnsKVs("col0", 1),
+			}}
+			existingRow := api.BatchTxSubOpParams{WriteParams: &api.PKWriteParams{
+				DB: &db, Table: &table, Operation: &insert,
+				Filters: tu.NewFiltersKVs("id0", 0, "id1", 0),
+			}}
+			readNewRow := api.BatchTxSubOpParams{ReadParams: &api.PKReadParams{
+				DB: &db, Table: &table,
+				Filters: tu.NewFiltersKVs("id0", 7, "id1", 7),
+			}}
+
+			// Test. duplicate key rolls back the transaction. The codes of the
+			// sub operations are in the error details
+			_, res := tu.SendGRPCBatchTxRequest(t, []*api.BatchTxSubOpParams{&newRow, &existingRow},
+				http.StatusConflict, "")
+			if res == nil || len(*res.Result) != 2 ||
+				*(*res.Result)[0].Code != http.StatusFailedDependency ||
+				*(*res.Result)[1].Code != http.StatusConflict {
+				t.Fatalf("Wrong sub operation codes of the rolled back transaction")
+			}
+			tu.SendGRPCBatchTxRequest(t, []*api.BatchTxSubOpParams{&readNewRow},
+				http.StatusNotFound, "")
+
+			// Test. successful transaction
+			_, res = tu.SendGRPCBatchTxRequest(t, []*api.BatchTxSubOpParams{&newRow, &readNewRow},
+				http.StatusOK, "")
+			if len(*res.Result) != 2 || *(*res.Result)[1].Code != http.StatusOK {
+				t.Fatalf("Wrong sub operation responses")
+			}
+			col0 := (*(*res.Result)[1].Body.Data)["col0"]
+			if col0 == nil || *col0 != "1" {
+				t.Fatalf("Wrong data read in transaction")
+			}
+		})
+}
+
+func TestBatchTxValidation(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB004"},
+		getBatchHandler(), func(tc common.TestContext) {
+
+			// Test. write operations do not accept read columns
+			ops := []api.BatchTxSubOp{
+				txSubOp("DB004/int_table/"+config.PK_INSERT_DB_OPERATION,
+					tu.NewFiltersKVs("id0", 5, "id1", 5), tu.NewReadColumn("col0"), nil),
+			}
+			body, _ := json.MarshalIndent(api.BatchTxOpRequest{Operations: &ops}, "", "\t")
+			tu.SendHttpRequest(t, tc, config.BATCH_HTTP_VERB, tu.NewBatchTxURL(), string(body),
+				http.StatusBadRequest, "Write operation does not accept read columns")
+
+			// Test. unknown operations
+			ops = []api.BatchTxSubOp{
+				txSubOp("DB004/int_table/pk-xxx", tu.NewFiltersKVs("id0", 5, "id1", 5), nil, nil),
+			}
+			body, _ = json.MarshalIndent(api.BatchTxOpRequest{Operations: &ops}, "", "\t")
+			tu.SendHttpRequest(t, tc, config.BATCH_HTTP_VERB, tu.NewBatchTxURL(), string(body),
+				http.StatusBadRequest, "Invalid Relative URL")
+		})
+}
+
+func txSubOp(url string, filters *[]api.Filter, readColumns *[]api.ReadColumn,
+	writeColumns *[]api.WriteColumn) api.BatchTxSubOp {
+	return api.BatchTxSubOp{
+		Method:      &[]string{config.PK_HTTP_VERB}[0],
+		RelativeURL: &url,
+		Body: &api.BatchTxSubOpBody{
+			Filters:      filters,
+			ReadColumns:  readColumns,
+			WriteColumns: writeColumns,
+			OperationID:  tu.NewOperationID(64),
+		},
+	}
+}
+
+func sendBatchTxHttpRequest(t testing.TB, tc common.TestContext, ops []api.BatchTxSubOp,
+	expectedStatus int) *api.BatchResponseJSON {
+	t.Helper()
+	body, _ := json.MarshalIndent(api.BatchTxOpRequest{Operations: &ops}, "", "\t")
+	_, resp := tu.SendHttpRequest(t, tc, config.BATCH_HTTP_VERB, tu.NewBatchTxURL(), string(body),
+		expectedStatus, "")
+
+	var res api.BatchResponseJSON
+	err := json.Unmarshal([]byte(resp), &res)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal batch response. Error %v", err)
+	}
+	return &res
+}
+
+func checkTxCodes(t testing.TB, res *api.BatchResponseJSON, codes ...int) {
+	t.Helper()
+	if len(*res.Result) != len(codes) {
+		t.Fatalf("Wrong number of operation responses received")
+	}
+
+	for i, subResp := range *res.Result {
+		if int(*subResp.Code) != codes[i] {
+			t.Fatalf("Return code does not match. Expecting: %d, Got: %d", codes[i], *subResp.Code)
+		}
+	}
+}
+
+func checkTxData(t testing.TB, res *api.BatchResponseJSON, op int, col string, expected string) {
+	t.Helper()
+	value, found := (*(*res.Result)[op].Body.Data)[col]
+	if !found || value == nil || string(*value) != expected {
+		t.Fatalf("Column %s data mismatch. Expected: %s", col, expected)
+	}
+}
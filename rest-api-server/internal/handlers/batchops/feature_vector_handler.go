@@ -0,0 +1,113 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package batchops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/handlers"
+	"hopsworks.ai/rdrs/internal/handlers/pkread"
+	"hopsworks.ai/rdrs/internal/log"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+type FeatureVector struct{}
+
+var _ handlers.FeatureVectorReader = (*FeatureVector)(nil)
+var featureVector FeatureVector
+
+func GetFeatureVectorReader() handlers.FeatureVectorReader {
+	return &featureVector
+}
+
+func (f *FeatureVector) FeatureVectorHttpHandler(c *gin.Context) {
+	params := api.FeatureVectorParams{}
+	err := c.ShouldBindJSON(&params)
+	if err != nil {
+		if log.IsDebug() {
+			log.Debugf("Unable to parse request. Error: %v", err)
+		}
+		common.SetResponseBodyError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var response api.FeatureVectorResponse = (api.FeatureVectorResponse)(&api.FeatureVectorResponseJSON{})
+	response.Init()
+
+	status, err := featureVector.FeatureVectorHandler(c.Request.Context(), &params, getAPIKey(c), response)
+	if err != nil {
+		common.SetResponseBodyError(c, status, err)
+		return
+	}
+
+	common.SetResponseBody(c, status, &response)
+}
+
+// FeatureVectorHandler reads the entry from all the feature groups in one
+// batch. Feature groups that do not have the entry return null features
+func (f *FeatureVector) FeatureVectorHandler(ctx context.Context, params *api.FeatureVectorParams, apiKey *string, response api.FeatureVectorResponse) (int, error) {
+	readOps, err := featureGroupReads(params)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	status, err := batch.BatchOpsHandler(ctx, &readOps, apiKey, response.GetBatchResponse())
+	if err != nil {
+		return status, err
+	}
+
+	return response.SetFeatures(*params.FeatureGroups)
+}
+
+// featureGroupReads returns the pk reads of the feature groups. The
+// entry is the primary key of all the feature groups
+func featureGroupReads(params *api.FeatureVectorParams) ([]*api.PKReadParams, error) {
+	if params.Project == nil || params.Entry == nil ||
+		params.FeatureGroups == nil || len(*params.FeatureGroups) == 0 {
+		return nil, fmt.Errorf("No valid feature groups found")
+	}
+
+	readOps := make([]*api.PKReadParams, len(*params.FeatureGroups))
+	for i, featureGroup := range *params.FeatureGroups {
+		if featureGroup.Name == nil || featureGroup.Version == nil ||
+			featureGroup.Features == nil || len(*featureGroup.Features) == 0 {
+			return nil, fmt.Errorf("Invalid feature group. Feature group %d has no features", i)
+		}
+
+		readColumns := make([]api.ReadColumn, len(*featureGroup.Features))
+		for j := range *featureGroup.Features {
+			readColumns[j].Column = &(*featureGroup.Features)[j]
+		}
+
+		table := featureGroup.Table()
+		readOps[i] = &api.PKReadParams{
+			DB:          params.Project,
+			Table:       &table,
+			Filters:     params.Entry,
+			ReadColumns: &readColumns,
+		}
+		if err := pkread.ValidatePKReadRequest(readOps[i]); err != nil {
+			return nil, err
+		}
+	}
+	return readOps, nil
+}
@@ -0,0 +1,154 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package batchops
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/handlers"
+	tu "hopsworks.ai/rdrs/internal/handlers/utils"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+func TestFeatureVector(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB028"},
+		getFeatureVectorHandler(), func(tc common.TestContext) {
+			url := tu.NewFeatureVectorURL()
+
+			// Test. the entry is in all the feature groups
+			params := newFeatureVectorParams("DB028", 1)
+			features, nulls := sendFeatureVectorRequest(t, tc, url, params, http.StatusOK, "")
+			checkFeatureVector(t, features, nulls, []interface{}{30.0, "SE", 0.5, 12.0})
+
+			// Test. NULL values and entries that are not in a feature group are null
+			params = newFeatureVectorParams("DB028", 2)
+			features, nulls = sendFeatureVectorRequest(t, tc, url, params, http.StatusOK, "")
+			checkFeatureVector(t, features, nulls, []interface{}{nil, "DE", nil, nil})
+
+			// Test. the feature does not exist
+			(*(*params.FeatureGroups)[1].Features)[0] = "rank"
+			sendFeatureVectorRequest(t, tc, url, params, http.StatusBadRequest, common.ERROR_012())
+
+			// Test. the feature group version does not exist
+			params = newFeatureVectorParams("DB028", 1)
+			*(*params.FeatureGroups)[1].Version = 3
+			sendFeatureVectorRequest(t, tc, url, params, http.StatusBadRequest, common.ERROR_011())
+
+			// Test. the entry can not be a feature
+			params = newFeatureVectorParams("DB028", 1)
+			(*(*params.FeatureGroups)[0].Features)[0] = "id"
+			sendFeatureVectorRequest(t, tc, url, params, http.StatusBadRequest, "already included in filter")
+
+			// Test. feature groups without features should result in 400 error
+			params = newFeatureVectorParams("DB028", 1)
+			*(*params.FeatureGroups)[0].Features = []string{}
+			sendFeatureVectorRequest(t, tc, url, params, http.StatusBadRequest,
+				"Error:Field validation for 'Features'")
+
+			// Test. the API key has no access to the project
+			params = newFeatureVectorParams("DB028_XXX", 1)
+			sendFeatureVectorRequest(t, tc, url, params, http.StatusUnauthorized, "")
+		})
+}
+
+func TestFeatureVectorGRPC(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB028"},
+		getFeatureVectorHandler(), func(tc common.TestContext) {
+			params := newFeatureVectorParams("DB028", 2)
+			_, resp := tu.SendGRPCFeatureVectorRequest(t, params, http.StatusOK, "")
+			expectedNulls := []bool{true, false, true, true}
+			if len(*resp.Features) != len(expectedNulls) || !reflect.DeepEqual(*resp.Nulls, expectedNulls) {
+				t.Fatalf("Null markers do not match. Expected: %v, Got: %v", expectedNulls, *resp.Nulls)
+			}
+			for i, feature := range *resp.Features {
+				if feature.GetNullValue() != expectedNulls[i] {
+					t.Fatalf("Feature %d is not null", i)
+				}
+			}
+			if (*resp.Features)[1].GetStringValue() != "DE" {
+				t.Fatalf("Feature value does not match. Expected: DE, Got: %v", (*resp.Features)[1])
+			}
+
+			// Test. the feature does not exist
+			(*(*params.FeatureGroups)[0].Features)[0] = "rank"
+			tu.SendGRPCFeatureVectorRequest(t, params, http.StatusBadRequest, common.ERROR_012())
+		})
+}
+
+// newFeatureVectorParams reads age and country from profile_1,
+// and score and logins from activity_2
+func newFeatureVectorParams(project string, id int) *api.FeatureVectorParams {
+	profile := "profile"
+	activity := "activity"
+	profileVersion := uint32(1)
+	activityVersion := uint32(2)
+	return &api.FeatureVectorParams{
+		Project: &project,
+		FeatureGroups: &[]api.FeatureGroup{
+			{Name: &profile, Version: &profileVersion, Features: &[]string{"age", "country"}},
+			{Name: &activity, Version: &activityVersion, Features: &[]string{"score", "logins"}},
+		},
+		Entry: tu.NewFiltersKVs("id", id),
+	}
+}
+
+func sendFeatureVectorRequest(t *testing.T, tc common.TestContext, url string,
+	params *api.FeatureVectorParams, expectedStatus int, expectedErrMsg string) ([]interface{}, []bool) {
+	t.Helper()
+	body, _ := json.MarshalIndent(params, "", "\t")
+	_, resp := tu.SendHttpRequest(t, tc, config.FEATURE_VECTOR_HTTP_VERB, url, string(body),
+		expectedStatus, expectedErrMsg)
+	if expectedStatus != http.StatusOK {
+		return nil, nil
+	}
+
+	var vector struct {
+		Features []interface{} `json:"features"`
+		Nulls    []bool        `json:"nulls"`
+	}
+	if err := json.Unmarshal([]byte(resp), &vector); err != nil {
+		t.Fatalf("Failed to unmarshal response object %v", err)
+	}
+	return vector.Features, vector.Nulls
+}
+
+func checkFeatureVector(t *testing.T, features []interface{}, nulls []bool, expected []interface{}) {
+	t.Helper()
+	if !reflect.DeepEqual(features, expected) {
+		t.Fatalf("Features do not match. Expected: %v, Got: %v", expected, features)
+	}
+	for i, feature := range expected {
+		if nulls[i] != (feature == nil) {
+			t.Fatalf("Null marker of feature %d does not match. Got: %v", i, nulls)
+		}
+	}
+}
+
+func getFeatureVectorHandler() *handlers.AllHandlers {
+	return &handlers.AllHandlers{
+		Batcher:             GetBatcher(),
+		FeatureVectorReader: GetFeatureVectorReader(),
+	}
+}
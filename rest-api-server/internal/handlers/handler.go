@@ -18,6 +18,8 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
 	"hopsworks.ai/rdrs/pkg/api"
 )
@@ -26,21 +28,73 @@ type RegisterHandlers func(*gin.Engine)
 
 type PKReader interface {
 	PkReadHttpHandler(c *gin.Context)
-	PkReadHandler(pkReadParams *api.PKReadParams, apiKey *string, response api.PKReadResponse) (int, error)
+	UniqueKeyReadHttpHandler(c *gin.Context)
+	PkReadHandler(ctx context.Context, pkReadParams *api.PKReadParams, apiKey *string, response api.PKReadResponse) (int, error)
+}
+
+type PKWriter interface {
+	PkInsertHttpHandler(c *gin.Context)
+	PkUpdateHttpHandler(c *gin.Context)
+	PkUpsertHttpHandler(c *gin.Context)
+	PkDeleteHttpHandler(c *gin.Context)
+	PkWriteHandler(ctx context.Context, pkWriteParams *api.PKWriteParams, apiKey *string, response api.PKWriteResponse) (int, error)
+}
+
+type IndexScanner interface {
+	IndexScanHttpHandler(c *gin.Context)
+	IndexScanHandler(ctx context.Context, scanParams *api.IndexScanParams, apiKey *string, response api.ScanResponse) (int, error)
+}
+
+type TableScanner interface {
+	TableScanHttpHandler(c *gin.Context)
+	TableScanHandler(ctx context.Context, scanParams *api.TableScanParams, apiKey *string, response api.ScanResponse) (int, error)
+	TableScanStreamHandler(ctx context.Context, scanParams *api.TableScanParams, apiKey *string, response api.ScanStream) (int, error)
+}
+
+type SchemaReader interface {
+	TableSchemaHttpHandler(c *gin.Context)
+	TableSchemaHandler(ctx context.Context, schemaParams *api.TableSchemaParams, apiKey *string, response *api.TableSchemaResponse) (int, error)
+}
+
+type Lister interface {
+	DatabasesHttpHandler(c *gin.Context)
+	DatabasesHandler(ctx context.Context, apiKey *string, response *api.DatabasesResponse) (int, error)
+	TablesHttpHandler(c *gin.Context)
+	TablesHandler(ctx context.Context, tablesParams *api.TablesParams, apiKey *string, response *api.TablesResponse) (int, error)
 }
 
 type Batcher interface {
 	BatchOpsHttpHandler(c *gin.Context)
-	BatchOpsHandler(pkOperations *[]*api.PKReadParams, apiKey *string, response api.BatchOpResponse) (int, error)
+	BatchOpsHandler(ctx context.Context, pkOperations *[]*api.PKReadParams, apiKey *string, response api.BatchOpResponse) (int, error)
+	BatchTxOpsHttpHandler(c *gin.Context)
+	BatchTxOpsHandler(ctx context.Context, txOperations *[]*api.BatchTxSubOpParams, apiKey *string, response api.BatchOpResponse) (int, error)
+}
+
+type FeatureVectorReader interface {
+	FeatureVectorHttpHandler(c *gin.Context)
+	FeatureVectorHandler(ctx context.Context, params *api.FeatureVectorParams, apiKey *string, response api.FeatureVectorResponse) (int, error)
 }
 
 type Stater interface {
 	StatOpsHttpHandler(c *gin.Context)
-	StatOpsHandler(response *api.StatResponse) (int, error)
+	StatOpsHandler(ctx context.Context, response *api.StatResponse) (int, error)
+}
+
+type HealthChecker interface {
+	LivenessHttpHandler(c *gin.Context)
+	ReadinessHttpHandler(c *gin.Context)
+	ReadinessHandler(ctx context.Context, response *api.HealthResponse) (int, error)
 }
 
 type AllHandlers struct {
-	PKReader PKReader
-	Batcher  Batcher
-	Stater   Stater
+	PKReader            PKReader
+	PKWriter            PKWriter
+	IndexScanner        IndexScanner
+	TableScanner        TableScanner
+	SchemaReader        SchemaReader
+	Lister              Lister
+	Batcher             Batcher
+	FeatureVectorReader FeatureVectorReader
+	Stater              Stater
+	HealthChecker       HealthChecker
 }
@@ -0,0 +1,93 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/handlers"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+type Health struct{}
+
+var _ handlers.HealthChecker = (*Health)(nil)
+var health Health
+
+func GetHealthChecker() handlers.HealthChecker {
+	return &health
+}
+
+// LivenessHttpHandler reports that the server process is running.
+// It does not depend on the state of the RonDB cluster
+func (h *Health) LivenessHttpHandler(c *gin.Context) {
+	common.SetResponseBody(c, http.StatusOK, &api.HealthResponse{Status: api.HEALTH_STATUS_UP})
+}
+
+func (h *Health) ReadinessHttpHandler(c *gin.Context) {
+	healthResp := api.HealthResponse{}
+	status, err := health.ReadinessHandler(c.Request.Context(), &healthResp)
+	if err != nil {
+		common.SetResponseBodyError(c, status, err)
+		return
+	}
+	common.SetResponseBody(c, status, &healthResp)
+}
+
+// ReadinessHandler checks that the server can serve requests, i.e., it is
+// connected to the RonDB cluster, at least one data node is alive and the
+// Hopsworks API key tables can be opened if the Hopsworks API keys are used.
+// Returns http.StatusServiceUnavailable if any of the checks fails
+func (h *Health) ReadinessHandler(ctx context.Context, healthResp *api.HealthResponse) (int, error) {
+	healthResp.Status = api.HEALTH_STATUS_UP
+
+	clusterStatus, err := dal.GetDataAccess().GetClusterStatus()
+	if err != nil {
+		return http.StatusServiceUnavailable, err
+	}
+
+	if !clusterStatus.Connected {
+		healthResp.AddCheck(api.RONDB_CONNECTION_CHECK, false, "Not connected to RonDB")
+		return http.StatusServiceUnavailable, nil
+	}
+	healthResp.AddCheck(api.RONDB_CONNECTION_CHECK, true, "")
+
+	healthResp.AddCheck(api.DATA_NODES_CHECK, clusterStatus.AliveDataNodes > 0,
+		fmt.Sprintf("%d of %d data nodes are alive", clusterStatus.AliveDataNodes,
+			clusterStatus.DataNodes))
+
+	// the tables can only be opened if the data nodes are alive
+	if config.Configuration().Security.UseHopsWorksAPIKeys && clusterStatus.AliveDataNodes > 0 {
+		if err := dal.GetDataAccess().CheckHopsworksTables(); err != nil {
+			healthResp.AddCheck(api.API_KEY_TABLES_CHECK, false, err.Error())
+		} else {
+			healthResp.AddCheck(api.API_KEY_TABLES_CHECK, true, "")
+		}
+	}
+
+	if healthResp.Status != api.HEALTH_STATUS_UP {
+		return http.StatusServiceUnavailable, nil
+	}
+	return http.StatusOK, nil
+}
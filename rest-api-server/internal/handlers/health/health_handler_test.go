@@ -0,0 +1,116 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/handlers"
+	tu "hopsworks.ai/rdrs/internal/handlers/utils"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+func TestHealth(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB004"},
+		getHealthHandlers(), func(tc common.TestContext) {
+			live := getHealth(t, tc, config.HEALTH_LIVE_PATH, http.StatusOK)
+			if live.Status != api.HEALTH_STATUS_UP {
+				t.Fatalf("Liveness status does not match. %#v", live)
+			}
+
+			ready := getHealth(t, tc, config.HEALTH_READY_PATH, http.StatusOK)
+			if ready.Status != api.HEALTH_STATUS_UP {
+				t.Fatalf("Readiness status does not match. %#v", ready)
+			}
+
+			expectedChecks := []string{api.RONDB_CONNECTION_CHECK, api.DATA_NODES_CHECK}
+			if config.Configuration().Security.UseHopsWorksAPIKeys {
+				expectedChecks = append(expectedChecks, api.API_KEY_TABLES_CHECK)
+			}
+			if len(ready.Checks) != len(expectedChecks) {
+				t.Fatalf("Readiness checks do not match. %#v", ready.Checks)
+			}
+			for i, check := range ready.Checks {
+				if check.Name != expectedChecks[i] || check.Status != api.HEALTH_STATUS_UP {
+					t.Fatalf("Readiness check does not match. %#v", check)
+				}
+			}
+		})
+}
+
+func TestHealthGRPC(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB004"},
+		getHealthHandlers(), func(tc common.TestContext) {
+			conn, err := grpc.Dial(fmt.Sprintf("%s:%d",
+				config.Configuration().RestServer.GRPCServerIP,
+				config.Configuration().RestServer.GRPCServerPort),
+				grpc.WithInsecure())
+			if err != nil {
+				t.Fatalf("Failed to connect to server %v", err)
+			}
+			defer conn.Close()
+			client := healthpb.NewHealthClient(conn)
+
+			for _, service := range []string{"", api.RonDBREST_ServiceDesc.ServiceName} {
+				resp, err := client.Check(context.Background(),
+					&healthpb.HealthCheckRequest{Service: service})
+				if err != nil {
+					t.Fatalf("Health check failed. Error: %v", err)
+				}
+				if resp.Status != healthpb.HealthCheckResponse_SERVING {
+					t.Fatalf("Service %q is not serving. Got: %v", service, resp.Status)
+				}
+			}
+
+			_, err = client.Check(context.Background(),
+				&healthpb.HealthCheckRequest{Service: "unknown"})
+			if status.Code(err) != codes.NotFound {
+				t.Fatalf("Expected NotFound for an unknown service. Got: %v", err)
+			}
+		})
+}
+
+func getHealth(t *testing.T, tc common.TestContext, path string, expectedStatus int) *api.HealthResponse {
+	_, respBody := tu.SendHttpRequest(t, tc, config.STAT_HTTP_VERB, tu.NewHealthURL(path), "",
+		expectedStatus, "")
+
+	var health api.HealthResponse
+	err := json.Unmarshal([]byte(respBody), &health)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	return &health
+}
+
+func getHealthHandlers() *handlers.AllHandlers {
+	return &handlers.AllHandlers{
+		HealthChecker: GetHealthChecker(),
+	}
+}
@@ -18,12 +18,9 @@
 
 package pkread
 
-/*
-#include "./../../../../data-access-rondb/src/rdrs-const.h"
-#include "./../../../../data-access-rondb/src/rdrs-dal.h"
-*/
-import "C"
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -32,6 +29,7 @@ import (
 
 	"hopsworks.ai/rdrs/internal/common"
 	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/dal/dalapi"
 	"hopsworks.ai/rdrs/pkg/api"
 )
 
@@ -43,7 +41,7 @@ import (
 //  HEADER
 //  ======
 //  [   4B   ][   4B   ][   4B   ][   4B   ][   4B   ][   4B   ][   4B   ][   4B   ][   4B   ] ....
-//    Type     Capacity  Length     DB         Table      PK     Read Cols    Op_ID    TX_ID
+//    Type     Capacity  Length     DB         Table      PK     Read Cols    Op_ID    Values
 //                               Offset      Offset    Offset     Offset     Offset   Offset
 //  BODY
 //  ====
@@ -70,180 +68,643 @@ import (
 //  [ bytes ... ] ...
 //    null terminated  operation Id
 //
+//  Write requests (insert, update, upsert, delete) do not have read columns. Instead the
+//  column values are stored in the same key/value format as the PK filters. A
+//  value offset of 0 sets the column to NULL.
+//
+//  Unique key reads store the name of the unique index in the index offset of the header.
+//  The filters contain the unique key columns instead of the primary key columns.
+//
+//  Index scan requests do not have PK filters. The header also contains the offsets of
+//  the null terminated index name, and the lower and upper bounds which are stored in
+//  the same key/value format as the PK filters. An offset of 0 means that the scan is
+//  not bounded. The header also contains the scan flags and the maximum number of rows
+//  to return.
+//
+//  [   4B   ][   4B   ][   4B   ][   4B   ][   4B   ]
+//    Index      Lower     Upper     Flags     Limit
+//    Offset     Offset    Offset
+//
+//  Index scan responses contain a rows section. It stores the offsets of the columns
+//  of each row. The columns of a row use the same format as PK read responses.
+//
+//  [   4B   ][   4B   ]...[   4B   ]
+//    Count    row 1         row n
+//             col offset    col offset
+//
 
 func CreateNativeRequest(pkrParams *api.PKReadParams) (*dal.NativeBuffer, *dal.NativeBuffer, error) {
+	return createNativeRequest(dalapi.RDRS_PK_REQ_ID, pkrParams.DB, pkrParams.Table, pkrParams.Index,
+		pkrParams.Filters, pkrParams.ReadColumns, nil, pkrParams.OperationID)
+}
+
+func CreateNativeWriteRequest(pkwParams *api.PKWriteParams) (*dal.NativeBuffer, *dal.NativeBuffer, error) {
+	opType, err := writeOperationType(pkwParams.Operation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return createNativeRequest(opType, pkwParams.DB, pkwParams.Table, nil, pkwParams.Filters,
+		nil, pkwParams.WriteColumns, pkwParams.OperationID)
+}
+
+func createNativeRequest(opType uint32, db *string, table *string, index *string, filters *[]api.Filter,
+	readColumns *[]api.ReadColumn, writeColumns *[]api.WriteColumn,
+	operationID *string) (*dal.NativeBuffer, *dal.NativeBuffer, error) {
 	response := dal.GetBuffer()
 	request := dal.GetBuffer()
-	iBuf := unsafe.Slice((*uint32)(request.Buffer), request.Size/C.ADDRESS_SIZE)
+	iBuf := unsafe.Slice((*uint32)(request.Buffer), request.Size/dalapi.ADDRESS_SIZE)
 
 	// First N bytes are for header
-	var head uint32 = C.PK_REQ_HEADER_END
+	var head uint32 = dalapi.PK_REQ_HEADER_END
 
 	dbOffSet := head
 
-	head, err := common.CopyGoStrToCStr([]byte(*pkrParams.DB), request, head)
+	head, err := common.CopyGoStrToCStr([]byte(*db), request, head)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	tableOffSet := head
-	head, err = common.CopyGoStrToCStr([]byte(*pkrParams.Table), request, head)
+	head, err = common.CopyGoStrToCStr([]byte(*table), request, head)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// Unique index. Set to 0 for primary key operations
+	var indexOffset uint32 = 0
+	if index != nil {
+		indexOffset = head
+		head, err = common.CopyGoStrToCStr([]byte(*index), request, head)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// PK Filters
+	pkOffset, head, err := copyFilters(filters, request, head)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Read Columns
+	readColsOffset, head, err := copyReadColumns(readColumns, request, head)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Write Columns
 	head = common.AlignWord(head)
-	pkOffset := head
-	iBuf[head/C.ADDRESS_SIZE] = uint32(len(*pkrParams.Filters))
-	head += C.ADDRESS_SIZE
+	var valuesOffset uint32 = 0
+	if writeColumns != nil {
+		valuesOffset = head
+		iBuf[head/dalapi.ADDRESS_SIZE] = uint32(len(*writeColumns))
+		head += dalapi.ADDRESS_SIZE
+
+		kvi := head / dalapi.ADDRESS_SIZE // index for storing offsets for each key/value pair
+		// skip for N number of offsets one for each key/value pair
+		head = head + (uint32(len(*writeColumns)) * dalapi.ADDRESS_SIZE)
+		for _, col := range *writeColumns {
+			head = common.AlignWord(head)
 
-	kvi := head / C.ADDRESS_SIZE // index for storing offsets for each key/value pair
+			tupleOffset := head
+
+			head = head + 8 //  for key and value offsets
+			keyOffset := head
+			head, err = common.CopyGoStrToCStr([]byte(*col.Column), request, head)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			var valueOffset uint32 = 0 // 0 is for NULL
+			if col.Value != nil && string(*col.Value) != "null" {
+				valueOffset = head
+				head, err = common.CopyGoStrToNDBStr(*col.Value, request, head)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+
+			iBuf[kvi] = tupleOffset
+			kvi++
+			iBuf[tupleOffset/dalapi.ADDRESS_SIZE] = keyOffset
+			iBuf[(tupleOffset/dalapi.ADDRESS_SIZE)+1] = valueOffset
+		}
+	}
+
+	// Operation ID
+	var opIdOffset uint32 = 0
+	if operationID != nil {
+		opIdOffset = head
+		head, err = common.CopyGoStrToCStr([]byte(*operationID), request, head)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// request buffer header
+	iBuf[dalapi.PK_REQ_OP_TYPE_IDX] = opType
+	iBuf[dalapi.PK_REQ_CAPACITY_IDX] = uint32(request.Size)
+	iBuf[dalapi.PK_REQ_LENGTH_IDX] = uint32(head)
+	iBuf[dalapi.PK_REQ_DB_IDX] = uint32(dbOffSet)
+	iBuf[dalapi.PK_REQ_TABLE_IDX] = uint32(tableOffSet)
+	iBuf[dalapi.PK_REQ_PK_COLS_IDX] = uint32(pkOffset)
+	iBuf[dalapi.PK_REQ_READ_COLS_IDX] = uint32(readColsOffset)
+	iBuf[dalapi.PK_REQ_OP_ID_IDX] = uint32(opIdOffset)
+	iBuf[dalapi.PK_REQ_VALUES_IDX] = uint32(valuesOffset)
+	iBuf[dalapi.PK_REQ_INDEX_IDX] = uint32(indexOffset)
+	iBuf[dalapi.PK_REQ_LOWER_IDX] = 0
+	iBuf[dalapi.PK_REQ_UPPER_IDX] = 0
+	iBuf[dalapi.PK_REQ_FLAGS_IDX] = 0
+	iBuf[dalapi.PK_REQ_LIMIT_IDX] = 0
+	iBuf[dalapi.PK_REQ_FILTER_IDX] = 0
+	iBuf[dalapi.PK_REQ_FILTER_VALUES_IDX] = 0
+
+	//xxd.Print(0, bBuf[:])
+	return request, response, nil
+}
+
+func CreateNativeIndexScanRequest(params *api.IndexScanParams) (*dal.NativeBuffer, *dal.NativeBuffer, error) {
+	return createNativeScanRequest(dalapi.RDRS_INDEX_SCAN_REQ_ID, params, nil)
+}
+
+// CreateNativeTableScanRequest encodes a table scan as a scan on the primary
+// key. The continuation token, if any, becomes an exclusive lower bound
+func CreateNativeTableScanRequest(params *api.TableScanParams) (*dal.NativeBuffer, *dal.NativeBuffer, error) {
+	scanParams := api.IndexScanParams{
+		DB:          params.DB,
+		Table:       params.Table,
+		Limit:       params.Limit,
+		ReadColumns: params.ReadColumns,
+		OperationID: params.OperationID,
+	}
+
+	if params.ContinuationToken != nil {
+		key, err := decodeContinuationToken(params.ContinuationToken)
+		if err != nil {
+			return nil, nil, err
+		}
+		inclusive := false
+		scanParams.LowerBound = &api.IndexBound{Columns: key, Inclusive: &inclusive}
+	}
+
+	var limit uint32 = api.TABLE_SCAN_DEFAULT_LIMIT
+	if scanParams.Limit == nil {
+		scanParams.Limit = &limit
+	}
+
+	return createNativeScanRequest(dalapi.RDRS_TABLE_SCAN_REQ_ID, &scanParams, params.Filter)
+}
+
+func createNativeScanRequest(opType uint32, params *api.IndexScanParams,
+	filter *api.FilterExpr) (*dal.NativeBuffer, *dal.NativeBuffer, error) {
+	response := dal.GetBuffer()
+	request := dal.GetBuffer()
+	iBuf := unsafe.Slice((*uint32)(request.Buffer), request.Size/dalapi.ADDRESS_SIZE)
+
+	// First N bytes are for header
+	var head uint32 = dalapi.PK_REQ_HEADER_END
+
+	dbOffSet := head
+	head, err := common.CopyGoStrToCStr([]byte(*params.DB), request, head)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tableOffSet := head
+	head, err = common.CopyGoStrToCStr([]byte(*params.Table), request, head)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// table scans do not have an index
+	var indexOffset uint32 = 0
+	if params.Index != nil {
+		indexOffset = head
+		head, err = common.CopyGoStrToCStr([]byte(*params.Index), request, head)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Bounds. Missing bounds are set to 0
+	var flags uint32 = 0
+	var lowerOffset uint32 = 0
+	if params.LowerBound != nil {
+		lowerOffset, head, err = copyFilters(params.LowerBound.Columns, request, head)
+		if err != nil {
+			return nil, nil, err
+		}
+		if params.LowerBound.IsInclusive() {
+			flags |= dalapi.RDRS_SCAN_LOWER_BOUND_INCLUSIVE
+		}
+	}
+
+	var upperOffset uint32 = 0
+	if params.UpperBound != nil {
+		upperOffset, head, err = copyFilters(params.UpperBound.Columns, request, head)
+		if err != nil {
+			return nil, nil, err
+		}
+		if params.UpperBound.IsInclusive() {
+			flags |= dalapi.RDRS_SCAN_UPPER_BOUND_INCLUSIVE
+		}
+	}
+
+	if params.Descending != nil && *params.Descending {
+		flags |= dalapi.RDRS_SCAN_DESCENDING
+	}
+
+	// Filter
+	var filterOffset uint32 = 0
+	var filterValuesOffset uint32 = 0
+	if filter != nil {
+		nodes := []uint32{}
+		values := []api.Filter{}
+		if err := flattenFilter(filter, &nodes, &values); err != nil {
+			return nil, nil, err
+		}
+
+		// [count][type][arg]...[type][arg]
+		head = common.AlignWord(head)
+		if head+uint32(len(nodes)+1)*dalapi.ADDRESS_SIZE > request.Size {
+			return nil, nil, fmt.Errorf("Trying to write more data than the buffer capacity")
+		}
+		filterOffset = head
+		iBuf[head/dalapi.ADDRESS_SIZE] = uint32(len(nodes) / 2)
+		head += dalapi.ADDRESS_SIZE
+		for _, n := range nodes {
+			iBuf[head/dalapi.ADDRESS_SIZE] = n
+			head += dalapi.ADDRESS_SIZE
+		}
+
+		filterValuesOffset, head, err = copyFilters(&values, request, head)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Read Columns
+	readColsOffset, head, err := copyReadColumns(params.ReadColumns, request, head)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Operation ID
+	var opIdOffset uint32 = 0
+	if params.OperationID != nil {
+		opIdOffset = head
+		head, err = common.CopyGoStrToCStr([]byte(*params.OperationID), request, head)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var limit uint32 = api.INDEX_SCAN_DEFAULT_LIMIT
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	// request buffer header
+	iBuf[dalapi.PK_REQ_OP_TYPE_IDX] = opType
+	iBuf[dalapi.PK_REQ_CAPACITY_IDX] = uint32(request.Size)
+	iBuf[dalapi.PK_REQ_LENGTH_IDX] = uint32(head)
+	iBuf[dalapi.PK_REQ_DB_IDX] = uint32(dbOffSet)
+	iBuf[dalapi.PK_REQ_TABLE_IDX] = uint32(tableOffSet)
+	iBuf[dalapi.PK_REQ_PK_COLS_IDX] = 0
+	iBuf[dalapi.PK_REQ_READ_COLS_IDX] = uint32(readColsOffset)
+	iBuf[dalapi.PK_REQ_OP_ID_IDX] = uint32(opIdOffset)
+	iBuf[dalapi.PK_REQ_VALUES_IDX] = 0
+	iBuf[dalapi.PK_REQ_INDEX_IDX] = uint32(indexOffset)
+	iBuf[dalapi.PK_REQ_LOWER_IDX] = uint32(lowerOffset)
+	iBuf[dalapi.PK_REQ_UPPER_IDX] = uint32(upperOffset)
+	iBuf[dalapi.PK_REQ_FLAGS_IDX] = flags
+	iBuf[dalapi.PK_REQ_LIMIT_IDX] = limit
+	iBuf[dalapi.PK_REQ_FILTER_IDX] = filterOffset
+	iBuf[dalapi.PK_REQ_FILTER_VALUES_IDX] = filterValuesOffset
+
+	return request, response, nil
+}
+
+var filterNodeTypes = map[string]uint32{
+	api.FILTER_AND:         dalapi.RDRS_FILTER_AND,
+	api.FILTER_OR:          dalapi.RDRS_FILTER_OR,
+	api.FILTER_NOT:         dalapi.RDRS_FILTER_NOT,
+	api.FILTER_EQ:          dalapi.RDRS_FILTER_EQ,
+	api.FILTER_NE:          dalapi.RDRS_FILTER_NE,
+	api.FILTER_LT:          dalapi.RDRS_FILTER_LT,
+	api.FILTER_LE:          dalapi.RDRS_FILTER_LE,
+	api.FILTER_GT:          dalapi.RDRS_FILTER_GT,
+	api.FILTER_GE:          dalapi.RDRS_FILTER_GE,
+	api.FILTER_LIKE:        dalapi.RDRS_FILTER_LIKE,
+	api.FILTER_IS_NULL:     dalapi.RDRS_FILTER_IS_NULL,
+	api.FILTER_IS_NOT_NULL: dalapi.RDRS_FILTER_IS_NOT_NULL,
+}
+
+var nullFilterValue = json.RawMessage("null")
+
+// flattenFilter converts the filter tree to a list of (type, arg) nodes in
+// pre-order. For logical nodes the arg is the number of operands. For
+// comparisons the arg is the index of the column/value pair in values.
+// between is sent as and(ge, le)
+func flattenFilter(expr *api.FilterExpr, nodes *[]uint32, values *[]api.Filter) error {
+	addCmp := func(nodeType uint32, value *json.RawMessage) {
+		*nodes = append(*nodes, nodeType, uint32(len(*values)))
+		*values = append(*values, api.Filter{Column: expr.Column, Value: value})
+	}
+
+	switch *expr.Op {
+	case api.FILTER_AND, api.FILTER_OR, api.FILTER_NOT:
+		*nodes = append(*nodes, filterNodeTypes[*expr.Op], uint32(len(*expr.Operands)))
+		for i := range *expr.Operands {
+			if err := flattenFilter(&(*expr.Operands)[i], nodes, values); err != nil {
+				return err
+			}
+		}
+	case api.FILTER_BETWEEN:
+		*nodes = append(*nodes, dalapi.RDRS_FILTER_AND, 2)
+		addCmp(dalapi.RDRS_FILTER_GE, expr.Lower)
+		addCmp(dalapi.RDRS_FILTER_LE, expr.Upper)
+	case api.FILTER_IS_NULL, api.FILTER_IS_NOT_NULL:
+		addCmp(filterNodeTypes[*expr.Op], &nullFilterValue)
+	default:
+		nodeType, ok := filterNodeTypes[*expr.Op]
+		if !ok {
+			return fmt.Errorf("Unknown filter operator %s", *expr.Op)
+		}
+		addCmp(nodeType, expr.Value)
+	}
+	return nil
+}
+
+// copyFilters copies the key/value pairs, e.g., primary key filters or index
+// bounds, to the request buffer. Returns the offset of the key/value section
+func copyFilters(filters *[]api.Filter, request *dal.NativeBuffer, head uint32) (uint32, uint32, error) {
+	iBuf := unsafe.Slice((*uint32)(request.Buffer), request.Size/dalapi.ADDRESS_SIZE)
+
+	head = common.AlignWord(head)
+	offset := head
+	iBuf[head/dalapi.ADDRESS_SIZE] = uint32(len(*filters))
+	head += dalapi.ADDRESS_SIZE
+
+	kvi := head / dalapi.ADDRESS_SIZE // index for storing offsets for each key/value pair
 	// skip for N number of offsets one for each key/value pair
-	head = head + (uint32(len(*pkrParams.Filters)) * C.ADDRESS_SIZE)
-	for _, filter := range *pkrParams.Filters {
+	head = head + (uint32(len(*filters)) * dalapi.ADDRESS_SIZE)
+	for _, filter := range *filters {
 		head = common.AlignWord(head)
 
 		tupleOffset := head
 
 		head = head + 8 //  for key and value offsets
 		keyOffset := head
+		var err error
 		head, err = common.CopyGoStrToCStr([]byte(*filter.Column), request, head)
 		if err != nil {
-			return nil, nil, err
+			return 0, 0, err
 		}
 		valueOffset := head
 		head, err = common.CopyGoStrToNDBStr(*filter.Value, request, head)
 		if err != nil {
-			return nil, nil, err
+			return 0, 0, err
 		}
 
 		iBuf[kvi] = tupleOffset
 		kvi++
-		iBuf[tupleOffset/C.ADDRESS_SIZE] = keyOffset
-		iBuf[(tupleOffset/C.ADDRESS_SIZE)+1] = valueOffset
+		iBuf[tupleOffset/dalapi.ADDRESS_SIZE] = keyOffset
+		iBuf[(tupleOffset/dalapi.ADDRESS_SIZE)+1] = valueOffset
 	}
+	return offset, head, nil
+}
 
-	// Read Columns
-	head = common.AlignWord(head)
-	var readColsOffset uint32 = 0
-	if pkrParams.ReadColumns != nil {
-		readColsOffset = head
-		iBuf[head/C.ADDRESS_SIZE] = uint32(len(*pkrParams.ReadColumns))
-		head += C.ADDRESS_SIZE
+// copyReadColumns copies the read columns and their data return types to the
+// request buffer. Returns the offset of the read columns section, or 0 if
+// the read columns are not set
+func copyReadColumns(readColumns *[]api.ReadColumn, request *dal.NativeBuffer,
+	head uint32) (uint32, uint32, error) {
+	iBuf := unsafe.Slice((*uint32)(request.Buffer), request.Size/dalapi.ADDRESS_SIZE)
 
-		rci := head / C.ADDRESS_SIZE // index for storing ofsets for each read column
-		// skip for N number of offsets one for each column name
-		head = head + (uint32(len(*pkrParams.ReadColumns)) * C.ADDRESS_SIZE)
+	head = common.AlignWord(head)
+	if readColumns == nil {
+		return 0, head, nil
+	}
 
-		for _, col := range *pkrParams.ReadColumns {
-			head = common.AlignWord(head)
+	offset := head
+	iBuf[head/dalapi.ADDRESS_SIZE] = uint32(len(*readColumns))
+	head += dalapi.ADDRESS_SIZE
 
-			iBuf[rci] = head
-			rci++
+	rci := head / dalapi.ADDRESS_SIZE // index for storing ofsets for each read column
+	// skip for N number of offsets one for each column name
+	head = head + (uint32(len(*readColumns)) * dalapi.ADDRESS_SIZE)
 
-			// return type
-			var drt uint32 = C.DEFAULT_DRT
-			if col.DataReturnType != nil {
-				drt, err = dataReturnType(col.DataReturnType)
-				if err != nil {
-					return nil, nil, err
-				}
-			}
+	var err error
+	for _, col := range *readColumns {
+		head = common.AlignWord(head)
 
-			iBuf[head/C.ADDRESS_SIZE] = drt
-			head += C.ADDRESS_SIZE
+		iBuf[rci] = head
+		rci++
 
-			// col name
-			head, err = common.CopyGoStrToCStr([]byte(*col.Column), request, head)
+		// return type
+		var drt uint32 = dalapi.DEFAULT_DRT
+		if col.DataReturnType != nil {
+			drt, err = dataReturnType(col.DataReturnType)
 			if err != nil {
-				return nil, nil, err
+				return 0, 0, err
 			}
 		}
-	}
 
-	// Operation ID
-	var opIdOffset uint32 = 0
-	if pkrParams.OperationID != nil {
-		opIdOffset = head
-		head, err = common.CopyGoStrToCStr([]byte(*pkrParams.OperationID), request, head)
+		iBuf[head/dalapi.ADDRESS_SIZE] = drt
+		head += dalapi.ADDRESS_SIZE
+
+		// col name
+		head, err = common.CopyGoStrToCStr([]byte(*col.Column), request, head)
 		if err != nil {
-			return nil, nil, err
+			return 0, 0, err
 		}
 	}
-
-	// request buffer header
-	iBuf[C.PK_REQ_OP_TYPE_IDX] = uint32(C.RDRS_PK_REQ_ID)
-	iBuf[C.PK_REQ_CAPACITY_IDX] = uint32(request.Size)
-	iBuf[C.PK_REQ_LENGTH_IDX] = uint32(head)
-	iBuf[C.PK_REQ_DB_IDX] = uint32(dbOffSet)
-	iBuf[C.PK_REQ_TABLE_IDX] = uint32(tableOffSet)
-	iBuf[C.PK_REQ_PK_COLS_IDX] = uint32(pkOffset)
-	iBuf[C.PK_REQ_READ_COLS_IDX] = uint32(readColsOffset)
-	iBuf[C.PK_REQ_OP_ID_IDX] = uint32(opIdOffset)
-
-	//xxd.Print(0, bBuf[:])
-	return request, response, nil
+	return offset, head, nil
 }
+
 func ProcessPKReadResponse(respBuff *dal.NativeBuffer, response api.PKReadResponse) (int32, error) {
 
 	iBuf := unsafe.Slice((*uint32)(respBuff.Buffer), respBuff.Size)
 
-	responseType := iBuf[C.PK_RESP_OP_TYPE_IDX]
-	if responseType != C.RDRS_PK_RESP_ID {
+	responseType := iBuf[dalapi.PK_RESP_OP_TYPE_IDX]
+	if responseType != dalapi.RDRS_PK_RESP_ID {
 		return http.StatusInternalServerError, fmt.Errorf("Wrong resonse type")
 	}
 
 	// some sanity checks
-	capacity := iBuf[C.PK_RESP_CAPACITY_IDX]
-	dataLength := iBuf[C.PK_RESP_LENGTH_IDX]
+	capacity := iBuf[dalapi.PK_RESP_CAPACITY_IDX]
+	dataLength := iBuf[dalapi.PK_RESP_LENGTH_IDX]
 	if respBuff.Size != capacity || !(dataLength < capacity) {
 		return http.StatusInternalServerError,
 			fmt.Errorf("Response buffer may be corrupt. Buffer capacity: %d, Buffer data lenght: %d", capacity, dataLength)
 	}
 
-	opIDX := iBuf[C.PK_RESP_OP_ID_IDX]
+	opIDX := iBuf[dalapi.PK_RESP_OP_ID_IDX]
 	if opIDX != 0 {
-		goOpID := C.GoString((*C.char)(unsafe.Pointer(uintptr(respBuff.Buffer) + uintptr(opIDX))))
+		goOpID := nativeString(respBuff, opIDX)
 		response.SetOperationID(&goOpID)
 	}
 
-	status := int32(iBuf[C.PK_RESP_OP_STATUS_IDX])
+	status := int32(iBuf[dalapi.PK_RESP_OP_STATUS_IDX])
 	if status == http.StatusOK { //
-		colIDX := iBuf[C.PK_RESP_COLS_IDX]
-		colCount := *(*uint32)(unsafe.Pointer(uintptr(respBuff.Buffer) + uintptr(colIDX)))
+		colIDX := iBuf[dalapi.PK_RESP_COLS_IDX]
+		processColumns(respBuff, colIDX, response)
+	}
 
-		for i := uint32(0); i < colCount; i++ {
-			colHeaderStart := (*uint32)(unsafe.Pointer(
-				uintptr(respBuff.Buffer) +
-					uintptr(colIDX+
-						uint32(C.ADDRESS_SIZE)+ // +1 for skipping the column count
-						(i*4*C.ADDRESS_SIZE)))) // 4 number of header fieldse
+	return status, nil
+}
+
+// ResponseDataLength returns the size of the data in a response buffer
+func ResponseDataLength(respBuff *dal.NativeBuffer) uint32 {
+	iBuf := unsafe.Slice((*uint32)(respBuff.Buffer), respBuff.Size)
+	return iBuf[dalapi.PK_RESP_LENGTH_IDX]
+}
+
+func ProcessIndexScanResponse(respBuff *dal.NativeBuffer, response api.ScanResponse) (int32, error) {
+
+	iBuf := unsafe.Slice((*uint32)(respBuff.Buffer), respBuff.Size)
 
-			colHeader := unsafe.Slice((*uint32)(colHeaderStart), 4)
+	responseType := iBuf[dalapi.PK_RESP_OP_TYPE_IDX]
+	if responseType != dalapi.RDRS_PK_RESP_ID {
+		return http.StatusInternalServerError, fmt.Errorf("Wrong resonse type")
+	}
 
-			nameAdd := colHeader[0]
-			name := C.GoString((*C.char)(unsafe.Pointer(uintptr(respBuff.Buffer) + uintptr(nameAdd))))
+	// some sanity checks
+	capacity := iBuf[dalapi.PK_RESP_CAPACITY_IDX]
+	dataLength := iBuf[dalapi.PK_RESP_LENGTH_IDX]
+	if respBuff.Size != capacity || !(dataLength < capacity) {
+		return http.StatusInternalServerError,
+			fmt.Errorf("Response buffer may be corrupt. Buffer capacity: %d, Buffer data lenght: %d", capacity, dataLength)
+	}
 
-			valueAdd := colHeader[1]
+	opIDX := iBuf[dalapi.PK_RESP_OP_ID_IDX]
+	if opIDX != 0 {
+		goOpID := nativeString(respBuff, opIDX)
+		response.SetOperationID(&goOpID)
+	}
 
-			isNull := colHeader[2]
-			dataType := colHeader[3]
+	status := int32(iBuf[dalapi.PK_RESP_OP_STATUS_IDX])
+	if status == http.StatusOK {
+		// [count][row 1 offset]...[row n offset]
+		rowsIDX := iBuf[dalapi.PK_RESP_ROWS_IDX]
+		rowCount := iBuf[rowsIDX/dalapi.ADDRESS_SIZE]
+		for i := uint32(0); i < rowCount; i++ {
+			colIDX := iBuf[(rowsIDX/dalapi.ADDRESS_SIZE)+1+i] // +1 for skipping the row count
+			response.AddRow()
+			processColumns(respBuff, colIDX, response)
+		}
 
-			if isNull == 0 {
-				value := C.GoString((*C.char)(unsafe.Pointer(uintptr(respBuff.Buffer) + uintptr(valueAdd))))
-				response.SetColumnData(&name, &value, dataType)
-			} else {
-				response.SetColumnData(&name, nil, dataType)
+		// key of the row where the next page starts
+		nextIDX := iBuf[dalapi.PK_RESP_NEXT_IDX]
+		if nextIDX != 0 {
+			key := api.ContinuationKey{}
+			processColumns(respBuff, nextIDX, &key)
+			token, err := encodeContinuationToken(key)
+			if err != nil {
+				return http.StatusInternalServerError, err
 			}
+			response.SetContinuationToken(&token)
 		}
 	}
 
 	return status, nil
 }
 
+type columnDataSetter interface {
+	SetColumnData(column, value *string, valueType uint32)
+}
+
+// processColumns reads the columns of a row stored at colIDX
+func processColumns(respBuff *dal.NativeBuffer, colIDX uint32, response columnDataSetter) {
+	colCount := *(*uint32)(unsafe.Pointer(uintptr(respBuff.Buffer) + uintptr(colIDX)))
+
+	for i := uint32(0); i < colCount; i++ {
+		colHeaderStart := (*uint32)(unsafe.Pointer(
+			uintptr(respBuff.Buffer) +
+				uintptr(colIDX+
+					uint32(dalapi.ADDRESS_SIZE)+ // +1 for skipping the column count
+					(i*4*dalapi.ADDRESS_SIZE)))) // 4 number of header fieldse
+
+		colHeader := unsafe.Slice((*uint32)(colHeaderStart), 4)
+
+		nameAdd := colHeader[0]
+		name := nativeString(respBuff, nameAdd)
+
+		valueAdd := colHeader[1]
+
+		isNull := colHeader[2]
+		dataType := colHeader[3]
+
+		if isNull == 0 {
+			value := nativeString(respBuff, valueAdd)
+			response.SetColumnData(&name, &value, dataType)
+		} else {
+			response.SetColumnData(&name, nil, dataType)
+		}
+	}
+}
+
+func ProcessPKWriteResponse(respBuff *dal.NativeBuffer, response api.PKWriteResponse) (int32, error) {
+
+	iBuf := unsafe.Slice((*uint32)(respBuff.Buffer), respBuff.Size)
+
+	responseType := iBuf[dalapi.PK_RESP_OP_TYPE_IDX]
+	if responseType != dalapi.RDRS_PK_RESP_ID {
+		return http.StatusInternalServerError, fmt.Errorf("Wrong resonse type")
+	}
+
+	// some sanity checks
+	capacity := iBuf[dalapi.PK_RESP_CAPACITY_IDX]
+	dataLength := iBuf[dalapi.PK_RESP_LENGTH_IDX]
+	if respBuff.Size != capacity || !(dataLength < capacity) {
+		return http.StatusInternalServerError,
+			fmt.Errorf("Response buffer may be corrupt. Buffer capacity: %d, Buffer data lenght: %d", capacity, dataLength)
+	}
+
+	opIDX := iBuf[dalapi.PK_RESP_OP_ID_IDX]
+	if opIDX != 0 {
+		goOpID := nativeString(respBuff, opIDX)
+		response.SetOperationID(&goOpID)
+	}
+
+	return int32(iBuf[dalapi.PK_RESP_OP_STATUS_IDX]), nil
+}
+
+// continuation tokens are the primary key of the last returned row encoded
+// as an opaque string
+func encodeContinuationToken(key api.ContinuationKey) (string, error) {
+	b, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeContinuationToken(token *string) (*[]api.Filter, error) {
+	invalid := fmt.Errorf("Invalid continuation token")
+	b, err := base64.RawURLEncoding.DecodeString(*token)
+	if err != nil {
+		return nil, invalid
+	}
+
+	key := []api.Filter{}
+	if err := json.Unmarshal(b, &key); err != nil || len(key) == 0 {
+		return nil, invalid
+	}
+
+	for _, col := range key {
+		if col.Column == nil || col.Value == nil || validateDBIdentifier(*col.Column) != nil {
+			return nil, invalid
+		}
+	}
+	return &key, nil
+}
+
 func convertToJsonRaw(dataType uint32, value *string) *json.RawMessage {
-	if dataType == C.RDRS_INTEGER_DATATYPE || dataType == C.RDRS_FLOAT_DATATYPE {
+	if dataType == dalapi.RDRS_INTEGER_DATATYPE || dataType == dalapi.RDRS_UNSIGNED_INTEGER_DATATYPE ||
+		dataType == dalapi.RDRS_FLOAT_DATATYPE || dataType == dalapi.RDRS_DECIMAL_DATATYPE {
 		valueBytes := json.RawMessage(*value)
 		return &valueBytes
 	} else {
@@ -254,9 +715,40 @@ func convertToJsonRaw(dataType uint32, value *string) *json.RawMessage {
 }
 
 func dataReturnType(drt *string) (uint32, error) {
-	if *drt == api.DRT_DEFAULT {
-		return C.DEFAULT_DRT, nil
-	} else {
+	switch *drt {
+	case api.DRT_DEFAULT:
+		return dalapi.DEFAULT_DRT, nil
+	case api.DRT_BASE64:
+		return dalapi.BASE64_DRT, nil
+	case api.DRT_HEX:
+		return dalapi.HEX_DRT, nil
+	case api.DRT_STRING:
+		return dalapi.STRING_DRT, nil
+	default:
 		return math.MaxUint32, fmt.Errorf("Return data type is not supported. Data type: " + *drt)
 	}
 }
+
+func writeOperationType(op *string) (uint32, error) {
+	switch *op {
+	case api.PK_INSERT:
+		return dalapi.RDRS_PK_INSERT_REQ_ID, nil
+	case api.PK_UPDATE:
+		return dalapi.RDRS_PK_UPDATE_REQ_ID, nil
+	case api.PK_UPSERT:
+		return dalapi.RDRS_PK_UPSERT_REQ_ID, nil
+	case api.PK_DELETE:
+		return dalapi.RDRS_PK_DELETE_REQ_ID, nil
+	default:
+		return math.MaxUint32, fmt.Errorf("Write operation is not supported. Operation: " + *op)
+	}
+}
+
+// nativeString returns the null terminated string at the offset of the buffer
+func nativeString(respBuff *dal.NativeBuffer, offset uint32) string {
+	buf := unsafe.Slice((*byte)(respBuff.Buffer), respBuff.Size)[offset:]
+	if end := bytes.IndexByte(buf, 0); end >= 0 {
+		buf = buf[:end]
+	}
+	return string(buf)
+}
@@ -0,0 +1,92 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pkread
+
+import (
+	"encoding/json"
+	"testing"
+	"unsafe"
+
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+// TestCopyFiltersMultiColumn decodes the key/value section written by
+// copyFilters. The read columns are copied after the filters, as in
+// createNativeRequest, and must not overwrite them
+func TestCopyFiltersMultiColumn(t *testing.T) {
+	words := make([]uint32, 256)
+	request := &dal.NativeBuffer{Size: uint32(len(words) * 4), Buffer: unsafe.Pointer(&words[0])}
+
+	filters := []api.Filter{
+		newFilter("id0", "1"),
+		newFilter("id1", "\"abc\""),
+		newFilter("id2_long_column_name", "12345678"),
+	}
+	expected := [][2]string{{"id0", "1"}, {"id1", "abc"}, {"id2_long_column_name", "12345678"}}
+
+	var head uint32 = 13
+	offset, head, err := copyFilters(&filters, request, head)
+	if err != nil {
+		t.Fatalf("Failed to copy filters. Error: %v", err)
+	}
+
+	col := "col0"
+	readColumns := []api.ReadColumn{{Column: &col}}
+	if _, _, err := copyReadColumns(&readColumns, request, head); err != nil {
+		t.Fatalf("Failed to copy read columns. Error: %v", err)
+	}
+
+	bytes := unsafe.Slice((*byte)(request.Buffer), request.Size)
+	count := words[offset/4]
+	if count != uint32(len(expected)) {
+		t.Fatalf("Wrong number of filters. Expected: %d, Got: %d", len(expected), count)
+	}
+
+	var end uint32
+	for i := uint32(0); i < count; i++ {
+		tuple := words[offset/4+1+i]
+		keyOffset := words[tuple/4]
+		valueOffset := words[tuple/4+1]
+
+		key := cString(bytes, keyOffset)
+		size := uint32(bytes[valueOffset]) + uint32(bytes[valueOffset+1])*256
+		value := string(bytes[valueOffset+2 : valueOffset+2+size])
+		if key != expected[i][0] || value != expected[i][1] {
+			t.Fatalf("Filter %d does not match. Expected: %v, Got: [%s %s]", i, expected[i], key, value)
+		}
+		end = valueOffset + 2 + size + 1
+	}
+
+	if head < end {
+		t.Fatalf("The returned offset %d is before the end of the filters %d", head, end)
+	}
+}
+
+func newFilter(column string, value string) api.Filter {
+	raw := json.RawMessage(value)
+	return api.Filter{Column: &column, Value: &raw}
+}
+
+func cString(bytes []byte, offset uint32) string {
+	end := offset
+	for bytes[end] != 0 {
+		end++
+	}
+	return string(bytes[offset:end])
+}
@@ -0,0 +1,230 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pkread
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/handlers"
+	"hopsworks.ai/rdrs/internal/log"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+type IndexScan struct{}
+
+var _ handlers.IndexScanner = (*IndexScan)(nil)
+var indexScan IndexScan
+
+func GetIndexScanner() handlers.IndexScanner {
+	return &indexScan
+}
+
+func (s *IndexScan) IndexScanHttpHandler(c *gin.Context) {
+	scanParams := api.IndexScanParams{}
+
+	err := ParseIndexScanRequest(c, &scanParams)
+	if err != nil {
+		if log.IsDebug() {
+			body, _ := ioutil.ReadAll(c.Request.Body)
+			log.Debugf("Unable to parse request. Error: %v. Body: %s\n", err, body)
+		}
+		common.SetResponseBodyError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if common.AcceptsNDJSON(c) {
+		writeNDJSONScan(c, func(response api.ScanStream) (int, error) {
+			return indexScan.IndexScanHandler(c.Request.Context(), &scanParams, getAPIKey(c), response)
+		})
+		return
+	}
+
+	var response api.ScanResponse = (api.ScanResponse)(&api.ScanResponseJSON{})
+	response.Init()
+
+	status, err := indexScan.IndexScanHandler(c.Request.Context(), &scanParams, getAPIKey(c), response)
+	if err != nil {
+		common.SetResponseBodyError(c, status, err)
+		return
+	}
+
+	common.SetResponseBody(c, status, &response)
+}
+
+// writeNDJSONScan writes the rows of the scan as newline delimited JSON
+// while they are read. Errors that happen after the first rows were sent
+// are reported in the last line as the status code can not be changed
+func writeNDJSONScan(c *gin.Context, scan func(response api.ScanStream) (int, error)) {
+	c.Header("Content-Type", common.NDJSON_CONTENT_TYPE)
+	response := api.NewScanResponseNDJSONWriter(c.Writer)
+	response.Init()
+
+	status, err := scan(response)
+	if err != nil {
+		if response.Written() {
+			response.CloseWithError(status, err)
+		} else {
+			c.Header("Content-Type", "application/json; charset=utf-8")
+			common.SetResponseBodyError(c, status, err)
+		}
+		return
+	}
+
+	c.Status(status)
+	if err := response.Close(); err != nil {
+		log.Warnf("Failed to write the scan response. Error: %v", err)
+	}
+}
+
+func (s *IndexScan) IndexScanHandler(ctx context.Context, scanParams *api.IndexScanParams, apiKey *string, response api.ScanResponse) (int, error) {
+	status, err := scanIndex(ctx, scanParams, apiKey, response)
+	return status, common.WithRequest(err, scanParams.DB, scanParams.Table, scanParams.OperationID)
+}
+
+func scanIndex(ctx context.Context, scanParams *api.IndexScanParams, apiKey *string, response api.ScanResponse) (int, error) {
+	err := checkAPIKey(ctx, apiKey, scanParams.DB)
+	if err != nil {
+		return APIKeyErrorStatus(err), err
+	}
+
+	err = ValidateIndexScanRequest(scanParams)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	reqBuff, respBuff, err := CreateNativeIndexScanRequest(scanParams)
+	defer dal.ReturnBuffer(reqBuff)
+	defer dal.ReturnBuffer(respBuff)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	span := StartNativeSpan(ctx, "index_scan", *scanParams.DB, *scanParams.Table)
+	dalErr := dal.GetDataAccess().IndexScan(ctx, reqBuff, respBuff)
+	EndNativeSpan(span, dalErr)
+	if dalErr != nil {
+		return dalErr.HttpCode, dalErr
+	}
+
+	status, err := ProcessIndexScanResponse(respBuff, response)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return int(status), nil
+}
+
+func ParseIndexScanRequest(c *gin.Context, scanParams *api.IndexScanParams) error {
+
+	body := api.IndexScanBody{}
+	pp := api.PKReadPP{}
+
+	if err := parseURI(c, &pp); err != nil {
+		return err
+	}
+
+	b := binding.JSON
+	if err := b.Bind(c.Request, &body); err != nil {
+		return err
+	}
+
+	scanParams.DB = pp.DB
+	scanParams.Table = pp.Table
+	scanParams.Index = body.Index
+	scanParams.LowerBound = body.LowerBound
+	scanParams.UpperBound = body.UpperBound
+	scanParams.Descending = body.Descending
+	scanParams.Limit = body.Limit
+	scanParams.ReadColumns = body.ReadColumns
+	scanParams.OperationID = body.OperationID
+
+	return ValidateIndexScanRequest(scanParams)
+}
+
+func ValidateIndexScanRequest(req *api.IndexScanParams) error {
+
+	if req.DB == nil || req.Table == nil || req.Index == nil {
+		return fmt.Errorf("db, table and index are required")
+	}
+
+	for _, identifier := range []string{*req.DB, *req.Table, *req.Index} {
+		if err := validateDBIdentifier(identifier); err != nil {
+			return err
+		}
+	}
+
+	// bounds use the same format as the pk filters
+	for _, bound := range []*api.IndexBound{req.LowerBound, req.UpperBound} {
+		if bound == nil {
+			continue
+		}
+
+		if bound.Columns == nil || len(*bound.Columns) == 0 {
+			return fmt.Errorf("Error:Field validation for 'Columns' failed on the 'required' tag")
+		}
+
+		if _, err := validateFilters(bound.Columns); err != nil {
+			return err
+		}
+	}
+
+	if req.Limit != nil && (*req.Limit < 1 || *req.Limit > api.INDEX_SCAN_MAX_LIMIT) {
+		return fmt.Errorf("Error:Field validation for 'Limit' failed. Limit must be between 1 and %d",
+			api.INDEX_SCAN_MAX_LIMIT)
+	}
+
+	return validateScanReadColumns(req.ReadColumns)
+}
+
+// validateScanReadColumns makes sure read columns and their return types are
+// valid and unique
+func validateScanReadColumns(readColumns *[]api.ReadColumn) error {
+	if readColumns != nil {
+		existingCols := make(map[string]bool)
+		for _, col := range *readColumns {
+			if col.Column == nil {
+				return fmt.Errorf("Error:Field validation for 'Column' failed on the 'required' tag")
+			}
+
+			if err := validateDBIdentifier(*col.Column); err != nil {
+				return err
+			}
+
+			if col.DataReturnType != nil {
+				if _, err := dataReturnType(col.DataReturnType); err != nil {
+					return err
+				}
+			}
+
+			if _, value := existingCols[*col.Column]; value {
+				return fmt.Errorf("field validation for 'ReadColumns' failed on the 'unique' tag.")
+			} else {
+				existingCols[*col.Column] = true
+			}
+		}
+	}
+
+	return nil
+}
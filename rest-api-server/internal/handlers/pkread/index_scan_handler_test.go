@@ -0,0 +1,183 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pkread
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/handlers"
+	tu "hopsworks.ai/rdrs/internal/handlers/utils"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+func TestIndexScan(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB025"},
+		getIndexScanHandler(), func(tc common.TestContext) {
+			url := tu.NewPKWriteURL("DB025", "events", config.INDEX_SCAN_DB_OPERATION)
+			index := "user_ts"
+			descending := true
+			limit := uint32(2)
+
+			// Test. latest two events of user 1
+			param := api.IndexScanBody{
+				Index:       &index,
+				LowerBound:  &api.IndexBound{Columns: tu.NewFiltersKVs("user_id", 1)},
+				UpperBound:  &api.IndexBound{Columns: tu.NewFiltersKVs("user_id", 1)},
+				Descending:  &descending,
+				Limit:       &limit,
+				ReadColumns: tu.NewReadColumn("id"),
+				OperationID: tu.NewOperationID(64),
+			}
+			res := sendIndexScanRequest(t, tc, url, &param, http.StatusOK, "")
+			checkScanIDs(t, res, "3", "2")
+			if res.OperationID == nil || *res.OperationID != *param.OperationID {
+				t.Fatalf("Operation ID does not match")
+			}
+
+			// Test. exclusive bounds on the last bound column
+			exclusive := false
+			param.LowerBound = &api.IndexBound{Columns: tu.NewFiltersKVs("user_id", 1, "ts", 100),
+				Inclusive: &exclusive}
+			param.UpperBound = &api.IndexBound{Columns: tu.NewFiltersKVs("user_id", 1, "ts", 300),
+				Inclusive: &exclusive}
+			param.Descending = nil
+			param.Limit = nil
+			res = sendIndexScanRequest(t, tc, url, &param, http.StatusOK, "")
+			checkScanIDs(t, res, "2")
+
+			// Test. unbounded scan returns all columns of all rows
+			param.LowerBound = nil
+			param.UpperBound = nil
+			param.ReadColumns = nil
+			res = sendIndexScanRequest(t, tc, url, &param, http.StatusOK, "")
+			checkScanIDs(t, res, "1", "2", "3", "4", "5")
+			event, found := (*res.Rows)[4]["event"]
+			if !found || event != nil {
+				t.Fatalf("Expecting null value for column event")
+			}
+		})
+}
+
+func TestIndexScanGRPC(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB025"},
+		getIndexScanHandler(), func(tc common.TestContext) {
+			db := "DB025"
+			table := "events"
+			index := "user_ts"
+			descending := true
+
+			// Test. events of user 2 in the descending order
+			params := api.IndexScanParams{
+				DB:          &db,
+				Table:       &table,
+				Index:       &index,
+				LowerBound:  &api.IndexBound{Columns: tu.NewFiltersKVs("user_id", 2)},
+				UpperBound:  &api.IndexBound{Columns: tu.NewFiltersKVs("user_id", 2)},
+				Descending:  &descending,
+				OperationID: tu.NewOperationID(64),
+			}
+			_, res := tu.SendGRPCIndexScanRequest(t, &params, http.StatusOK, "")
+			checkGRPCScanIDs(t, res, 5, 4)
+			if res.OperationID == nil || *res.OperationID != *params.OperationID {
+				t.Fatalf("Operation ID does not match")
+			}
+
+			// Test. index does not exist
+			index = "user_ts_XXX"
+			tu.SendGRPCIndexScanRequest(t, &params, http.StatusBadRequest, common.ERROR_040())
+		})
+}
+
+func TestIndexScanValidation(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB025"},
+		getIndexScanHandler(), func(tc common.TestContext) {
+			url := tu.NewPKWriteURL("DB025", "events", config.INDEX_SCAN_DB_OPERATION)
+			index := "user_ts_XXX"
+
+			// Test. index does not exist
+			param := api.IndexScanBody{Index: &index}
+			sendIndexScanRequest(t, tc, url, &param, http.StatusBadRequest, common.ERROR_040())
+
+			// Test. bound columns must be a prefix of the index columns
+			index = "user_ts"
+			param.LowerBound = &api.IndexBound{Columns: tu.NewFiltersKVs("ts", 100)}
+			sendIndexScanRequest(t, tc, url, &param, http.StatusBadRequest, common.ERROR_042())
+
+			// Test. omitting the index should result in 400 error
+			param = api.IndexScanBody{}
+			sendIndexScanRequest(t, tc, url, &param, http.StatusBadRequest,
+				"Error:Field validation for 'Index'")
+
+			// Test. limit is out of range
+			limit := uint32(api.INDEX_SCAN_MAX_LIMIT + 1)
+			param = api.IndexScanBody{Index: &index, Limit: &limit}
+			sendIndexScanRequest(t, tc, url, &param, http.StatusBadRequest,
+				"Error:Field validation for 'Limit'")
+		})
+}
+
+func sendIndexScanRequest(t testing.TB, tc common.TestContext, url string, param *api.IndexScanBody,
+	expectedStatus int, expectedErrMsg string) *api.ScanResponseJSON {
+	t.Helper()
+	body, _ := json.MarshalIndent(param, "", "\t")
+	_, resp := tu.SendHttpRequest(t, tc, config.INDEX_SCAN_HTTP_VERB, url, string(body),
+		expectedStatus, expectedErrMsg)
+	if expectedStatus != http.StatusOK {
+		return nil
+	}
+
+	var res api.ScanResponseJSON
+	err := json.Unmarshal([]byte(resp), &res)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal response object %v", err)
+	}
+	return &res
+}
+
+// checks the id column of the returned rows
+func checkScanIDs(t testing.TB, res *api.ScanResponseJSON, ids ...string) {
+	t.Helper()
+	if len(*res.Rows) != len(ids) {
+		t.Fatalf("Wrong number of rows. Expected: %d, Got: %d", len(ids), len(*res.Rows))
+	}
+
+	for i, row := range *res.Rows {
+		id, found := row["id"]
+		if !found || id == nil || string(*id) != ids[i] {
+			t.Fatalf("Row %d id mismatch. Expected: %s", i, ids[i])
+		}
+	}
+}
+
+func getIndexScanHandler() *handlers.AllHandlers {
+	return &handlers.AllHandlers{
+		Stater:       nil,
+		Batcher:      nil,
+		PKReader:     nil,
+		PKWriter:     nil,
+		IndexScanner: GetIndexScanner(),
+	}
+}
@@ -0,0 +1,151 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pkread
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/handlers"
+	"hopsworks.ai/rdrs/internal/security/apikey"
+	"hopsworks.ai/rdrs/internal/tracing"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+type Lister struct{}
+
+var _ handlers.Lister = (*Lister)(nil)
+var lister Lister
+
+func GetLister() handlers.Lister {
+	return &lister
+}
+
+func (l *Lister) DatabasesHttpHandler(c *gin.Context) {
+	response := api.DatabasesResponse{}
+	status, err := lister.DatabasesHandler(c.Request.Context(), getAPIKey(c), &response)
+	if err != nil {
+		common.SetResponseBodyError(c, status, err)
+		return
+	}
+
+	common.SetResponseBody(c, status, &response)
+}
+
+// DatabasesHandler returns the databases that have tables. Only the databases
+// of the projects of the API key are returned
+func (l *Lister) DatabasesHandler(ctx context.Context, apiKey *string, response *api.DatabasesResponse) (int, error) {
+	userDBs, err := userDatabases(ctx, apiKey)
+	if err != nil {
+		return APIKeyErrorStatus(err), err
+	}
+
+	span := StartNativeSpan(ctx, "list_databases", "", "")
+	dbs, dalErr := dal.GetDataAccess().ListDatabases()
+	EndNativeSpan(span, dalErr)
+	if dalErr != nil {
+		return dalErr.HttpCode, dalErr
+	}
+
+	response.Databases = []string{}
+	for _, db := range dbs {
+		if userDBs == nil || userDBs[db] {
+			response.Databases = append(response.Databases, db)
+		}
+	}
+	return http.StatusOK, nil
+}
+
+// userDatabases returns the databases of the API key, or nil if the API keys
+// are not used
+func userDatabases(ctx context.Context, apiKey *string) (map[string]bool, error) {
+	if !config.Configuration().Security.UseHopsWorksAPIKeys {
+		return nil, nil
+	}
+	if apiKey == nil || *apiKey == "" {
+		return nil, fmt.Errorf("Unauthorized. No API key supplied")
+	}
+
+	_, span := tracing.Tracer().Start(ctx, "GetUserDatabases")
+	defer span.End()
+	dbs, err := apikey.GetUserDatabases(apiKey)
+	tracing.SetError(span, err)
+	if err != nil {
+		return nil, err
+	}
+
+	userDBs := make(map[string]bool)
+	for _, db := range dbs {
+		userDBs[db] = true
+	}
+	return userDBs, nil
+}
+
+func (l *Lister) TablesHttpHandler(c *gin.Context) {
+	tablesParams := api.TablesParams{}
+	if err := c.ShouldBindUri(&tablesParams); err != nil {
+		common.SetResponseBodyError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response := api.TablesResponse{}
+	status, err := lister.TablesHandler(c.Request.Context(), &tablesParams, getAPIKey(c), &response)
+	if err != nil {
+		common.SetResponseBodyError(c, status, err)
+		return
+	}
+
+	common.SetResponseBody(c, status, &response)
+}
+
+// TablesHandler returns the tables of a database. The API key needs access
+// to the database, as for reads
+func (l *Lister) TablesHandler(ctx context.Context, tablesParams *api.TablesParams, apiKey *string, response *api.TablesResponse) (int, error) {
+	status, err := listTables(ctx, tablesParams, apiKey, response)
+	return status, common.WithRequest(err, tablesParams.DB, nil, nil)
+}
+
+func listTables(ctx context.Context, tablesParams *api.TablesParams, apiKey *string, response *api.TablesResponse) (int, error) {
+	// gRPC requests are not parsed by gin
+	if tablesParams.DB == nil {
+		return http.StatusBadRequest, fmt.Errorf("Database is required")
+	}
+	if err := validateDBIdentifier(*tablesParams.DB); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	if err := checkAPIKey(ctx, apiKey, tablesParams.DB); err != nil {
+		return APIKeyErrorStatus(err), err
+	}
+
+	span := StartNativeSpan(ctx, "list_tables", *tablesParams.DB, "")
+	tables, dalErr := dal.GetDataAccess().ListTables(*tablesParams.DB)
+	EndNativeSpan(span, dalErr)
+	if dalErr != nil {
+		return dalErr.HttpCode, dalErr
+	}
+
+	response.DB = *tablesParams.DB
+	response.Tables = tables
+	return http.StatusOK, nil
+}
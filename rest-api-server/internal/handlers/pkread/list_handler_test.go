@@ -0,0 +1,103 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pkread
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/handlers"
+	tu "hopsworks.ai/rdrs/internal/handlers/utils"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+func TestListing(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB025", "DB026"},
+		getListHandler(), func(tc common.TestContext) {
+			_, resp := tu.SendHttpRequest(t, tc, config.LIST_HTTP_VERB, tu.NewDatabasesURL(), "",
+				http.StatusOK, "")
+			var dbs api.DatabasesResponse
+			if err := json.Unmarshal([]byte(resp), &dbs); err != nil {
+				t.Fatalf("Failed to unmarshal response object %v", err)
+			}
+			checkNames(t, dbs.Databases, "DB025", "DB026")
+
+			_, resp = tu.SendHttpRequest(t, tc, config.LIST_HTTP_VERB, tu.NewTablesURL("DB026"), "",
+				http.StatusOK, "")
+			var tables api.TablesResponse
+			if err := json.Unmarshal([]byte(resp), &tables); err != nil {
+				t.Fatalf("Failed to unmarshal response object %v", err)
+			}
+			if tables.DB != "DB026" || len(tables.Tables) != 1 || tables.Tables[0] != "users" {
+				t.Fatalf("Tables do not match. %#v", tables)
+			}
+
+			// Test. the API key has no access to the database
+			tu.SendHttpRequest(t, tc, config.LIST_HTTP_VERB, tu.NewTablesURL("DB026_XXX"), "",
+				http.StatusUnauthorized, "")
+		})
+}
+
+func TestListingGRPC(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB025", "DB026"},
+		getListHandler(), func(tc common.TestContext) {
+			_, dbs := tu.SendGRPCDatabasesRequest(t, http.StatusOK, "")
+			checkNames(t, dbs.Databases, "DB025", "DB026")
+
+			db := "DB025"
+			params := api.TablesParams{DB: &db}
+			_, tables := tu.SendGRPCTablesRequest(t, &params, http.StatusOK, "")
+			if tables.DB != "DB025" || len(tables.Tables) != 1 || tables.Tables[0] != "events" {
+				t.Fatalf("Tables do not match. %#v", tables)
+			}
+
+			// Test. the API key has no access to the database
+			db = "DB025_XXX"
+			tu.SendGRPCTablesRequest(t, &params, http.StatusUnauthorized, "")
+		})
+}
+
+// checks that the names are listed. Other test databases can exist
+func checkNames(t testing.TB, names []string, expected ...string) {
+	t.Helper()
+	listed := make(map[string]bool)
+	for _, name := range names {
+		listed[name] = true
+	}
+	for _, name := range expected {
+		if !listed[name] {
+			t.Fatalf("%s is not listed. Got: %v", name, names)
+		}
+	}
+}
+
+func getListHandler() *handlers.AllHandlers {
+	return &handlers.AllHandlers{
+		Stater:   nil,
+		Batcher:  nil,
+		PKReader: nil,
+		PKWriter: nil,
+		Lister:   GetLister(),
+	}
+}
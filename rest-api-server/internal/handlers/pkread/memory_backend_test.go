@@ -0,0 +1,79 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+package pkread
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+	tu "hopsworks.ai/rdrs/internal/handlers/utils"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+const memoryFixture = "../../dal/memory/testdata/fixture.json"
+
+func TestPKReadMemoryBackendREST(t *testing.T) {
+
+	tu.WithMemoryBackend(t, memoryFixture,
+		getPKHandler(), func(tc common.TestContext) {
+			url := tu.NewPKReadURL("memdb", "users")
+
+			// Test. read a row of the fixture
+			param := api.PKReadBody{Filters: tu.NewFiltersKVs("id", 1)}
+			body, _ := json.MarshalIndent(param, "", "\t")
+			_, resp := tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body),
+				http.StatusOK, "")
+			checkUniqueKeyReadCol(t, resp, "name", "\"alice\"")
+			checkUniqueKeyReadCol(t, resp, "age", "30")
+
+			// Test. missing row
+			param = api.PKReadBody{Filters: tu.NewFiltersKVs("id", 3)}
+			body, _ = json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body),
+				http.StatusNotFound, "")
+		})
+}
+
+func TestPKReadMemoryBackendGRPC(t *testing.T) {
+
+	tu.WithMemoryBackend(t, memoryFixture,
+		getPKHandler(), func(tc common.TestContext) {
+
+			// Test. read a row of the fixture
+			_, resp := tu.SendGRPCPKReadRequest(t, api.PKTestInfo{
+				PkReq:    api.PKReadBody{Filters: tu.NewFiltersKVs("id", 5)},
+				Db:       "memdb",
+				Table:    "users",
+				HttpCode: http.StatusOK,
+			})
+			name := (*resp.Data)["name"]
+			if name == nil || *name != "frank" {
+				t.Fatalf("Wrong data read from the memory backend")
+			}
+
+			// Test. missing row
+			tu.SendGRPCPKReadRequest(t, api.PKTestInfo{
+				PkReq:    api.PKReadBody{Filters: tu.NewFiltersKVs("id", 3)},
+				Db:       "memdb",
+				Table:    "users",
+				HttpCode: http.StatusNotFound,
+			})
+		})
+}
@@ -19,18 +19,25 @@
 package pkread
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"hopsworks.ai/rdrs/internal/common"
 	"hopsworks.ai/rdrs/internal/config"
 	"hopsworks.ai/rdrs/internal/dal"
 	"hopsworks.ai/rdrs/internal/handlers"
 	"hopsworks.ai/rdrs/internal/log"
+	"hopsworks.ai/rdrs/internal/metrics"
 	"hopsworks.ai/rdrs/internal/security/apikey"
+	"hopsworks.ai/rdrs/internal/tracing"
 	"hopsworks.ai/rdrs/pkg/api"
 )
 
@@ -60,11 +67,28 @@ func (p *PKRead) PkReadHttpHandler(c *gin.Context) {
 	processRequestNSetStatus(c, &pkReadParams, apiKey)
 }
 
+func (p *PKRead) UniqueKeyReadHttpHandler(c *gin.Context) {
+	pkReadParams := api.PKReadParams{}
+
+	err := ParseUniqueKeyReadRequest(c, &pkReadParams)
+	if err != nil {
+		if log.IsDebug() {
+			body, _ := ioutil.ReadAll(c.Request.Body)
+			log.Debugf("Unable to parse request. Error: %v. Body: %s\n", err, body)
+		}
+		common.SetResponseBodyError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	apiKey := getAPIKey(c)
+	processRequestNSetStatus(c, &pkReadParams, apiKey)
+}
+
 func processRequestNSetStatus(c *gin.Context, pkReadParams *api.PKReadParams, apiKey *string) {
 	var response api.PKReadResponse = (api.PKReadResponse)(&api.PKReadResponseJSON{})
 	response.Init()
 
-	status, err := pkRead.PkReadHandler(pkReadParams, apiKey, response)
+	status, err := pkRead.PkReadHandler(c.Request.Context(), pkReadParams, apiKey, response)
 
 	if err != nil {
 		common.SetResponseBodyError(c, status, err)
@@ -74,30 +98,47 @@ func processRequestNSetStatus(c *gin.Context, pkReadParams *api.PKReadParams, ap
 	common.SetResponseBody(c, status, &response)
 }
 
-func (p *PKRead) PkReadHandler(pkReadParams *api.PKReadParams, apiKey *string, response api.PKReadResponse) (int, error) {
-	err := checkAPIKey(apiKey, pkReadParams.DB)
+func (p *PKRead) PkReadHandler(ctx context.Context, pkReadParams *api.PKReadParams, apiKey *string, response api.PKReadResponse) (int, error) {
+	err := checkAPIKey(ctx, apiKey, pkReadParams.DB)
 	if err != nil {
-		return http.StatusUnauthorized, err
+		return APIKeyErrorStatus(err), common.WithRequest(err, pkReadParams.DB, pkReadParams.Table, pkReadParams.OperationID)
 	}
 
+	// only the reads that reach RonDB are recorded in the table stats
+	start := time.Now()
+	status, bytes, err := readRow(ctx, pkReadParams, response)
+	metrics.ObserveTableRead(*pkReadParams.DB, *pkReadParams.Table, status, bytes, time.Since(start))
+	return status, common.WithRequest(err, pkReadParams.DB, pkReadParams.Table, pkReadParams.OperationID)
+}
+
+// readRow returns the status and the size of the data returned by RonDB
+func readRow(ctx context.Context, pkReadParams *api.PKReadParams, response api.PKReadResponse) (int, uint32, error) {
+	_, span := tracing.Tracer().Start(ctx, "CreateNativeRequest")
 	reqBuff, respBuff, err := CreateNativeRequest(pkReadParams)
+	tracing.SetError(span, err)
+	span.End()
 	defer dal.ReturnBuffer(reqBuff)
 	defer dal.ReturnBuffer(respBuff)
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return http.StatusInternalServerError, 0, err
 	}
 
-	dalErr := dal.RonDBPKRead(reqBuff, respBuff)
+	span = StartNativeSpan(ctx, "pk_read", *pkReadParams.DB, *pkReadParams.Table)
+	dalErr := dal.GetDataAccess().PKRead(ctx, reqBuff, respBuff)
+	EndNativeSpan(span, dalErr)
 	if dalErr != nil && dalErr.HttpCode != http.StatusNotFound { // any other error return immediately
-		return dalErr.HttpCode, dalErr
+		return dalErr.HttpCode, 0, dalErr
 	}
 
+	_, span = tracing.Tracer().Start(ctx, "ProcessPKReadResponse")
 	status, err := ProcessPKReadResponse(respBuff, response)
+	tracing.SetError(span, err)
+	span.End()
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return http.StatusInternalServerError, 0, err
 	}
 
-	return int(status), nil
+	return int(status), ResponseDataLength(respBuff), nil
 }
 
 func ParseRequest(c *gin.Context, pkReadParams *api.PKReadParams) error {
@@ -127,6 +168,32 @@ func ParseRequest(c *gin.Context, pkReadParams *api.PKReadParams) error {
 	return nil
 }
 
+// ParseUniqueKeyReadRequest parses unique key reads. The filters
+// contain the columns of the unique index instead of the primary key
+func ParseUniqueKeyReadRequest(c *gin.Context, pkReadParams *api.PKReadParams) error {
+
+	body := api.UniqueKeyReadBody{}
+	pp := api.PKReadPP{}
+
+	if err := parseURI(c, &pp); err != nil {
+		return err
+	}
+
+	b := binding.JSON
+	if err := b.Bind(c.Request, &body); err != nil {
+		return err
+	}
+
+	pkReadParams.DB = pp.DB
+	pkReadParams.Table = pp.Table
+	pkReadParams.Index = body.Index
+	pkReadParams.Filters = body.Filters
+	pkReadParams.ReadColumns = body.ReadColumns
+	pkReadParams.OperationID = body.OperationID
+
+	return ValidatePKReadRequest(pkReadParams)
+}
+
 func ParseBody(req *http.Request, params *api.PKReadBody) error {
 
 	b := binding.JSON
@@ -139,29 +206,23 @@ func ParseBody(req *http.Request, params *api.PKReadBody) error {
 
 func ValidateBody(params *api.PKReadParams) error {
 
-	for _, filter := range *params.Filters {
-		// make sure filter columns are valid
-		if err := validateDBIdentifier(*filter.Column); err != nil {
-			return err
-		}
-	}
-
-	// make sure that the columns are unique.
-	existingFilters := make(map[string]bool)
-	for _, filter := range *params.Filters {
-		if _, value := existingFilters[*filter.Column]; value {
-			return fmt.Errorf("field validation for filter failed on the 'unique' tag")
-		} else {
-			existingFilters[*filter.Column] = true
-		}
+	existingFilters, err := validateFilters(params.Filters)
+	if err != nil {
+		return err
 	}
 
-	// make sure read columns are valid
+	// make sure read columns and their return types are valid
 	if params.ReadColumns != nil {
 		for _, col := range *params.ReadColumns {
 			if err := validateDBIdentifier(*col.Column); err != nil {
 				return err
 			}
+
+			if col.DataReturnType != nil {
+				if _, err := dataReturnType(col.DataReturnType); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -185,6 +246,28 @@ func ValidateBody(params *api.PKReadParams) error {
 	return nil
 }
 
+// validateFilters checks that the filter columns are valid and unique.
+// Returns the set of filter columns
+func validateFilters(filters *[]api.Filter) (map[string]bool, error) {
+	for _, filter := range *filters {
+		// make sure filter columns are valid
+		if err := validateDBIdentifier(*filter.Column); err != nil {
+			return nil, err
+		}
+	}
+
+	// make sure that the columns are unique.
+	existingFilters := make(map[string]bool)
+	for _, filter := range *filters {
+		if _, value := existingFilters[*filter.Column]; value {
+			return nil, fmt.Errorf("field validation for filter failed on the 'unique' tag")
+		} else {
+			existingFilters[*filter.Column] = true
+		}
+	}
+	return existingFilters, nil
+}
+
 func parseURI(c *gin.Context, resource *api.PKReadPP) error {
 	err := c.ShouldBindUri(&resource)
 	if err != nil {
@@ -212,17 +295,52 @@ func getAPIKey(c *gin.Context) *string {
 	return &apiKey
 }
 
-func checkAPIKey(apiKey *string, db *string) error {
+func checkAPIKey(ctx context.Context, apiKey *string, db *string) error {
 	// check for Hopsworks api keys
 	if config.Configuration().Security.UseHopsWorksAPIKeys {
 		if apiKey == nil || *apiKey == "" { // not set
 			return fmt.Errorf("Unauthorized. No API key supplied")
 		}
-		return apikey.ValidateAPIKey(apiKey, db)
+
+		_, span := tracing.Tracer().Start(ctx, "ValidateAPIKey")
+		defer span.End()
+		err := apikey.ValidateAPIKey(apiKey, db)
+		tracing.SetError(span, err)
+		return err
 	}
 	return nil
 }
 
+// APIKeyErrorStatus returns the status of a failed API key check. The key
+// can not be validated while RonDB is unavailable or overloaded
+func APIKeyErrorStatus(err error) int {
+	var dalErr *dal.DalError
+	if errors.As(err, &dalErr) && dalErr.IsTemporary() {
+		return dalErr.HttpCode
+	}
+	return http.StatusUnauthorized
+}
+
+// StartNativeSpan starts the span of a call into the native layer
+func StartNativeSpan(ctx context.Context, operation, db, table string) trace.Span {
+	_, span := tracing.Tracer().Start(ctx, operation, trace.WithSpanKind(trace.SpanKindClient))
+	span.SetAttributes(attribute.String("db.system", "rondb"), attribute.String("db.name", db),
+		attribute.String("db.sql.table", table))
+	return span
+}
+
+// EndNativeSpan ends the span of a call into the native layer. Not found is
+// not an error
+func EndNativeSpan(span trace.Span, dalErr *dal.DalError) {
+	if dalErr != nil {
+		span.SetAttributes(attribute.Int("http.status_code", dalErr.HttpCode))
+		if dalErr.HttpCode != http.StatusNotFound {
+			tracing.SetError(span, dalErr)
+		}
+	}
+	span.End()
+}
+
 func ValidatePKReadRequest(req *api.PKReadParams) error {
 
 	if err := validateDBIdentifier(*req.DB); err != nil {
@@ -233,6 +351,12 @@ func ValidatePKReadRequest(req *api.PKReadParams) error {
 		return err
 	}
 
+	if req.Index != nil {
+		if err := validateDBIdentifier(*req.Index); err != nil {
+			return err
+		}
+	}
+
 	err := ValidateBody(req)
 	if err != nil {
 		return err
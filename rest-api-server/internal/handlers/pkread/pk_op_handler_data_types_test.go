@@ -18,11 +18,15 @@
 package pkread
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
-	_ "github.com/ianlancetaylor/cgosymbolizer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
 	tu "hopsworks.ai/rdrs/internal/handlers/utils"
 	"hopsworks.ai/rdrs/pkg/api"
 )
@@ -749,23 +753,31 @@ func TestDataTypesBlobs(t *testing.T) {
 			},
 			Table:          "blob_table",
 			Db:             testDb,
-			HttpCode:       http.StatusInternalServerError,
-			ErrMsgContains: common.ERROR_026(),
-			RespKVs:        []interface{}{},
+			HttpCode:       http.StatusOK,
+			ErrMsgContains: "",
+			RespKVs:        []interface{}{"col0"},
 		},
 
-		"blob2": {
+		"blob2": { // null blob and blob larger than the response buffer
 			PkReq: api.PKReadBody{
-				Filters:     tu.NewFiltersKVs("id0", "1"),
-				ReadColumns: tu.NewReadColumn("col1"),
+				Filters:     tu.NewFiltersKVs("id0", "2"),
 				OperationID: tu.NewOperationID(5),
 			},
 			Table:          "blob_table",
 			Db:             testDb,
 			HttpCode:       http.StatusOK,
 			ErrMsgContains: "",
-			RespKVs:        []interface{}{"col1"},
+			RespKVs:        []interface{}{"col0", "col2"},
 		},
+	}
+
+	tu.PkTest(t, tests, true, getPKHandler())
+}
+
+func TestDataTypesText(t *testing.T) {
+
+	testDb := "DB013"
+	tests := map[string]api.PKTestInfo{
 
 		"text1": {
 			PkReq: api.PKReadBody{
@@ -775,28 +787,162 @@ func TestDataTypesBlobs(t *testing.T) {
 			},
 			Table:          "text_table",
 			Db:             testDb,
-			HttpCode:       http.StatusInternalServerError,
+			HttpCode:       http.StatusOK,
 			ErrMsgContains: "",
-			RespKVs:        []interface{}{},
+			RespKVs:        []interface{}{"col0", "col1"},
 		},
 
-		"text2": {
+		"text2": { // json payload, null text and text larger than the response buffer
+			PkReq: api.PKReadBody{
+				Filters:     tu.NewFiltersKVs("id0", "2"),
+				OperationID: tu.NewOperationID(5),
+			},
+			Table:          "text_table",
+			Db:             testDb,
+			HttpCode:       http.StatusOK,
+			ErrMsgContains: "",
+			RespKVs:        []interface{}{"col0", "col1", "col2"},
+		},
+
+		"text3": {
 			PkReq: api.PKReadBody{
 				Filters:     tu.NewFiltersKVs("id0", "1"),
-				ReadColumns: tu.NewReadColumn("col1"),
+				ReadColumns: tu.NewReadColumn("col2"),
 				OperationID: tu.NewOperationID(5),
 			},
 			Table:          "text_table",
 			Db:             testDb,
 			HttpCode:       http.StatusOK,
 			ErrMsgContains: "",
-			RespKVs:        []interface{}{"col1"},
+			RespKVs:        []interface{}{"col2"},
 		},
 	}
 
 	tu.PkTest(t, tests, false, getPKHandler())
 }
 
+func TestDataReturnTypes(t *testing.T) {
+
+	bigintFilter := tu.NewFiltersKVs("id0", int64(9223372036854775807), "id1", uint64(18446744073709551615))
+	tests := map[string]struct {
+		db       string
+		table    string
+		filters  *[]api.Filter
+		column   string
+		drt      string
+		httpCode int
+		expected string // raw JSON value or error message
+	}{
+		"default_bigint": {"DB005", "bigint_table", bigintFilter, "col1", api.DRT_DEFAULT,
+			http.StatusOK, "18446744073709551615"},
+		"string_unsigned_bigint": {"DB005", "bigint_table", bigintFilter, "col1", api.DRT_STRING,
+			http.StatusOK, "\"18446744073709551615\""},
+		"string_bigint": {"DB005", "bigint_table", bigintFilter, "col0", api.DRT_STRING,
+			http.StatusOK, "\"9223372036854775807\""},
+		"hex_varchar": {"DB014", "table1", tu.NewFiltersKVs("id0", "3"), "col0", api.DRT_HEX,
+			http.StatusOK, "\"610A62\""},
+		"base64_varchar": {"DB014", "table1", tu.NewFiltersKVs("id0", "3"), "col0", api.DRT_BASE64,
+			http.StatusOK, "\"YQpi\""},
+		"hex_text": {"DB013", "text_table", tu.NewFiltersKVs("id0", 1), "col0", api.DRT_HEX,
+			http.StatusOK, "\"46464646\""},
+		"hex_blob": {"DB013", "blob_table", tu.NewFiltersKVs("id0", 1), "col0", api.DRT_HEX,
+			http.StatusOK, "\"FFFF\""},
+		"base64_blob": {"DB013", "blob_table", tu.NewFiltersKVs("id0", 1), "col0", api.DRT_BASE64,
+			http.StatusOK, "\"//8=\""},
+		"hex_int": {"DB005", "bigint_table", bigintFilter, "col0", api.DRT_HEX,
+			http.StatusBadRequest, common.ERROR_025()},
+		"unknown": {"DB005", "bigint_table", bigintFilter, "col0", "xyz",
+			http.StatusBadRequest, "Return data type is not supported"},
+	}
+
+	tu.WithDBs(t, []string{"DB005", "DB013", "DB014"}, getPKHandler(), func(tc common.TestContext) {
+		for name, test := range tests {
+			t.Run(name, func(t *testing.T) {
+				param := api.PKReadBody{
+					Filters:     test.filters,
+					ReadColumns: tu.NewReadColumnWithDRT(test.column, test.drt),
+				}
+				body, _ := json.MarshalIndent(param, "", "\t")
+				url := tu.NewPKReadURL(test.db, test.table)
+
+				if test.httpCode != http.StatusOK {
+					tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), test.httpCode,
+						test.expected)
+					return
+				}
+
+				_, resp := tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body),
+					test.httpCode, "")
+				var res api.PKReadResponseJSON
+				err := json.Unmarshal([]byte(resp), &res)
+				if err != nil {
+					t.Fatalf("Failed to unmarshal response object %v", err)
+				}
+
+				value, found := (*res.Data)[test.column]
+				if !found || value == nil || string(*value) != test.expected {
+					t.Fatalf("Column %s data mismatch. Expected: %s", test.column, test.expected)
+				}
+			})
+		}
+	})
+}
+
+// Test typed column values returned by the gRPC API
+func TestDataTypesGRPCValues(t *testing.T) {
+
+	bigintFilter := tu.NewFiltersKVs("id0", int64(9223372036854775807), "id1", uint64(18446744073709551615))
+	datetime := time.Date(1111, 11, 11, 11, 11, 11, 0, time.UTC)
+	tests := map[string]struct {
+		db       string
+		table    string
+		filters  *[]api.Filter
+		column   string
+		expected *api.ColumnValueProto
+	}{
+		"bigint": {"DB005", "bigint_table", bigintFilter, "col0",
+			&api.ColumnValueProto{Value: &api.ColumnValueProto_Int64Value{Int64Value: 9223372036854775807}}},
+		"unsigned_bigint": {"DB005", "bigint_table", bigintFilter, "col1",
+			&api.ColumnValueProto{Value: &api.ColumnValueProto_Uint64Value{Uint64Value: 18446744073709551615}}},
+		"null": {"DB005", "bigint_table", tu.NewFiltersKVs("id0", 1, "id1", 1), "col0",
+			&api.ColumnValueProto{Value: &api.ColumnValueProto_NullValue{NullValue: true}}},
+		"decimal": {"DB003", "number_table", tu.NewFiltersKVs("id0", 1), "col5",
+			&api.ColumnValueProto{Value: &api.ColumnValueProto_DecimalValue{DecimalValue: "99"}}},
+		"double": {"DB003", "number_table", tu.NewFiltersKVs("id0", 1), "col7",
+			&api.ColumnValueProto{Value: &api.ColumnValueProto_DoubleValue{DoubleValue: 99.99}}},
+		"datetime": {"DB003", "date_table", tu.NewFiltersKVs("id0", 1), "col2",
+			&api.ColumnValueProto{Value: &api.ColumnValueProto_TimestampValue{
+				TimestampValue: timestamppb.New(datetime)}}},
+		"blob": {"DB013", "blob_table", tu.NewFiltersKVs("id0", 1), "col0",
+			&api.ColumnValueProto{Value: &api.ColumnValueProto_BytesValue{BytesValue: []byte{0xFF, 0xFF}}}},
+		"text": {"DB013", "text_table", tu.NewFiltersKVs("id0", 1), "col0",
+			&api.ColumnValueProto{Value: &api.ColumnValueProto_StringValue{StringValue: "FFFF"}}},
+	}
+
+	tu.WithDBs(t, []string{"DB003", "DB005", "DB013"}, getPKHandler(), func(tc common.TestContext) {
+		for name, test := range tests {
+			t.Run(name, func(t *testing.T) {
+				testInfo := api.PKTestInfo{
+					PkReq: api.PKReadBody{
+						Filters:     test.filters,
+						ReadColumns: tu.NewReadColumn(test.column),
+					},
+					Table:    test.table,
+					Db:       test.db,
+					HttpCode: http.StatusOK,
+				}
+				_, resp := tu.SendGRPCPKReadRequest(t, testInfo)
+
+				value, found := (*resp.Values)[test.column]
+				if !found || !proto.Equal(&api.ColumnValueProto{Value: value.Value}, test.expected) {
+					t.Fatalf("Column %s value mismatch. Expected: %v, Got: %v", test.column,
+						test.expected, value)
+				}
+			})
+		}
+	})
+}
+
 func TestDataTypesChar(t *testing.T) {
 	ArrayColumnTest(t, "table1", "DB012", false, 100, true)
 }
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"hopsworks.ai/rdrs/internal/common"
@@ -279,6 +280,104 @@ func TestPKERROR_013_ERROR_014(t *testing.T) {
 		})
 }
 
+func TestUniqueKeyRead(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB026"},
+		getPKHandler(), func(tc common.TestContext) {
+			url := tu.NewPKWriteURL("DB026", "users", config.UNIQUE_KEY_READ_DB_OPERATION)
+			index := "email_idx"
+
+			// Test. read all columns using the email
+			param := api.UniqueKeyReadBody{
+				Index:       &index,
+				Filters:     tu.NewFiltersKVs("email", "alice@example.com"),
+				OperationID: tu.NewOperationID(64),
+			}
+			body, _ := json.MarshalIndent(param, "", "\t")
+			_, resp := tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusOK, "")
+			checkUniqueKeyReadCol(t, resp, "id", "1")
+			checkUniqueKeyReadCol(t, resp, "name", "\"alice\"")
+
+			// Test. read the primary key using the external id
+			index = "ext_idx"
+			param.Filters = tu.NewFiltersKVs("ext_id", 20)
+			param.ReadColumns = tu.NewReadColumn("id")
+			body, _ = json.MarshalIndent(param, "", "\t")
+			_, resp = tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusOK, "")
+			checkUniqueKeyReadCol(t, resp, "id", "2")
+
+			// Test. row does not exist
+			param.Filters = tu.NewFiltersKVs("ext_id", 30)
+			body, _ = json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusNotFound, "")
+
+			// Test. wrong unique key column
+			param.Filters = tu.NewFiltersKVs("email", "bob@example.com")
+			body, _ = json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusBadRequest,
+				common.ERROR_046())
+
+			// Test. non unique indexes can not be used
+			index = "name_idx"
+			param.Filters = tu.NewFiltersKVs("name", "bob")
+			body, _ = json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusBadRequest,
+				common.ERROR_040())
+
+			// Test. omitting the index should result in 400 error
+			param.Index = nil
+			body, _ = json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusBadRequest,
+				"Error:Field validation for 'Index'")
+		})
+}
+
+func TestPKReadAfterAlterTable(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB027"},
+		getPKHandler(), func(tc common.TestContext) {
+			url := tu.NewPKReadURL("DB027", "altered")
+			param := api.PKReadBody{
+				Filters:     tu.NewFiltersKVs("id", 1),
+				ReadColumns: tu.NewReadColumn("col0"),
+				OperationID: tu.NewOperationID(64),
+			}
+			body, _ := json.MarshalIndent(param, "", "\t")
+			_, resp := tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusOK, "")
+			checkUniqueKeyReadCol(t, resp, "col0", "10")
+
+			common.RunQueries(t, "ALTER TABLE DB027.altered ADD COLUMN col1 int DEFAULT 5")
+			// the cached table is read again at most once per second
+			time.Sleep(1100 * time.Millisecond)
+
+			// Test. the new column can be read without restarting the server
+			param.ReadColumns = tu.NewReadColumn("col1")
+			body, _ = json.MarshalIndent(param, "", "\t")
+			_, resp = tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusOK, "")
+			checkUniqueKeyReadCol(t, resp, "col1", "5")
+
+			// Test. columns that do not exist are still rejected
+			param.ReadColumns = tu.NewReadColumn("col2")
+			body, _ = json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusBadRequest,
+				common.ERROR_012())
+		})
+}
+
+func checkUniqueKeyReadCol(t testing.TB, resp string, col string, expected string) {
+	t.Helper()
+	var res api.PKReadResponseJSON
+	err := json.Unmarshal([]byte(resp), &res)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal response object %v", err)
+	}
+
+	value, found := (*res.Data)[col]
+	if !found || value == nil || string(*value) != expected {
+		t.Fatalf("Column %s data mismatch. Expected: %s", col, expected)
+	}
+}
+
 func getPKHandler() *handlers.AllHandlers {
 	return &handlers.AllHandlers{
 		Stater:   nil,
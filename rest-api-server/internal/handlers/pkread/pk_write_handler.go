@@ -0,0 +1,219 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pkread
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/handlers"
+	"hopsworks.ai/rdrs/internal/log"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+type PKWrite struct{}
+
+var _ handlers.PKWriter = (*PKWrite)(nil)
+var pkWrite PKWrite
+
+func GetPKWriter() handlers.PKWriter {
+	return &pkWrite
+}
+
+func (p *PKWrite) PkInsertHttpHandler(c *gin.Context) {
+	pkWriteHttpHandler(c, api.PK_INSERT)
+}
+
+func (p *PKWrite) PkUpdateHttpHandler(c *gin.Context) {
+	pkWriteHttpHandler(c, api.PK_UPDATE)
+}
+
+func (p *PKWrite) PkUpsertHttpHandler(c *gin.Context) {
+	pkWriteHttpHandler(c, api.PK_UPSERT)
+}
+
+func (p *PKWrite) PkDeleteHttpHandler(c *gin.Context) {
+	pkWriteHttpHandler(c, api.PK_DELETE)
+}
+
+func pkWriteHttpHandler(c *gin.Context, operation string) {
+	pkWriteParams := api.PKWriteParams{}
+
+	err := ParseWriteRequest(c, operation, &pkWriteParams)
+	if err != nil {
+		if log.IsDebug() {
+			body, _ := ioutil.ReadAll(c.Request.Body)
+			log.Debugf("Unable to parse request. Error: %v. Body: %s\n", err, body)
+		}
+		common.SetResponseBodyError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var response api.PKWriteResponse = (api.PKWriteResponse)(&api.PKWriteResponseJSON{})
+	response.Init()
+
+	status, err := pkWrite.PkWriteHandler(c.Request.Context(), &pkWriteParams, getAPIKey(c), response)
+	if err != nil {
+		common.SetResponseBodyError(c, status, err)
+		return
+	}
+
+	common.SetResponseBody(c, status, &response)
+}
+
+func (p *PKWrite) PkWriteHandler(ctx context.Context, pkWriteParams *api.PKWriteParams, apiKey *string, response api.PKWriteResponse) (int, error) {
+	status, err := writeRow(ctx, pkWriteParams, apiKey, response)
+	return status, common.WithRequest(err, pkWriteParams.DB, pkWriteParams.Table, pkWriteParams.OperationID)
+}
+
+func writeRow(ctx context.Context, pkWriteParams *api.PKWriteParams, apiKey *string, response api.PKWriteResponse) (int, error) {
+	err := checkAPIKey(ctx, apiKey, pkWriteParams.DB)
+	if err != nil {
+		return APIKeyErrorStatus(err), err
+	}
+
+	// gRPC requests are not parsed by gin
+	err = ValidatePKWriteRequest(pkWriteParams)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	reqBuff, respBuff, err := CreateNativeWriteRequest(pkWriteParams)
+	defer dal.ReturnBuffer(reqBuff)
+	defer dal.ReturnBuffer(respBuff)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	span := StartNativeSpan(ctx, "pk_write", *pkWriteParams.DB, *pkWriteParams.Table)
+	dalErr := dal.GetDataAccess().PKWrite(ctx, reqBuff, respBuff)
+	EndNativeSpan(span, dalErr)
+	if dalErr != nil && dalErr.HttpCode != http.StatusNotFound { // any other error return immediately
+		return dalErr.HttpCode, dalErr
+	}
+
+	status, err := ProcessPKWriteResponse(respBuff, response)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return int(status), nil
+}
+
+func ParseWriteRequest(c *gin.Context, operation string, pkWriteParams *api.PKWriteParams) error {
+
+	pp := api.PKReadPP{}
+
+	if err := parseURI(c, &pp); err != nil {
+		return err
+	}
+
+	b := binding.JSON
+	if operation == api.PK_DELETE {
+		body := api.PKDeleteBody{}
+		if err := b.Bind(c.Request, &body); err != nil {
+			return err
+		}
+		pkWriteParams.Filters = body.Filters
+		pkWriteParams.OperationID = body.OperationID
+	} else {
+		body := api.PKWriteBody{}
+		if err := b.Bind(c.Request, &body); err != nil {
+			return err
+		}
+		pkWriteParams.Filters = body.Filters
+		pkWriteParams.WriteColumns = body.WriteColumns
+		pkWriteParams.OperationID = body.OperationID
+	}
+
+	pkWriteParams.DB = pp.DB
+	pkWriteParams.Table = pp.Table
+	pkWriteParams.Operation = &operation
+
+	return ValidatePKWriteRequest(pkWriteParams)
+}
+
+func ValidatePKWriteRequest(req *api.PKWriteParams) error {
+
+	if req.DB == nil || req.Table == nil || req.Operation == nil {
+		return fmt.Errorf("db, table and operation are required")
+	}
+
+	if err := validateDBIdentifier(*req.DB); err != nil {
+		return err
+	}
+
+	if err := validateDBIdentifier(*req.Table); err != nil {
+		return err
+	}
+
+	if req.Filters == nil || len(*req.Filters) == 0 {
+		return fmt.Errorf("Error:Field validation for 'Filters' failed on the 'required' tag")
+	}
+
+	existingFilters, err := validateFilters(req.Filters)
+	if err != nil {
+		return err
+	}
+
+	if *req.Operation != api.PK_INSERT && *req.Operation != api.PK_UPSERT &&
+		*req.Operation != api.PK_UPDATE && *req.Operation != api.PK_DELETE {
+		return fmt.Errorf("Write operation is not supported. Operation: %s", *req.Operation)
+	}
+
+	if *req.Operation == api.PK_DELETE && req.WriteColumns != nil && len(*req.WriteColumns) > 0 {
+		return fmt.Errorf("field validation for 'WriteColumns' failed. Delete operation does not accept write columns")
+	}
+
+	// update without any columns to write is a no-op
+	if *req.Operation == api.PK_UPDATE && (req.WriteColumns == nil || len(*req.WriteColumns) == 0) {
+		return fmt.Errorf("Error:Field validation for 'WriteColumns' failed on the 'required' tag")
+	}
+
+	// make sure that write columns are valid, unique, and do not overlap filter columns
+	if req.WriteColumns != nil {
+		existingCols := make(map[string]bool)
+		for _, col := range *req.WriteColumns {
+			if col.Column == nil {
+				return fmt.Errorf("Error:Field validation for 'Column' failed on the 'required' tag")
+			}
+
+			if err := validateDBIdentifier(*col.Column); err != nil {
+				return err
+			}
+
+			if _, value := existingFilters[*col.Column]; value {
+				return fmt.Errorf("field validation for write columns faild. '%s' already included in filter", *col.Column)
+			}
+
+			if _, value := existingCols[*col.Column]; value {
+				return fmt.Errorf("field validation for 'WriteColumns' failed on the 'unique' tag.")
+			} else {
+				existingCols[*col.Column] = true
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,278 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pkread
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/handlers"
+	tu "hopsworks.ai/rdrs/internal/handlers/utils"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+func TestPKWriteREST(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB004"},
+		getPKWriteHandler(), func(tc common.TestContext) {
+			db := "DB004"
+			table := "int_table"
+
+			// Test. insert a new row
+			param := api.PKWriteBody{
+				Filters:      tu.NewFiltersKVs("id0", 10, "id1", 10),
+				WriteColumns: tu.NewWriteColumnsKVs("col0", 100, "col1", 100),
+				OperationID:  tu.NewOperationID(64),
+			}
+			body, _ := json.MarshalIndent(param, "", "\t")
+			url := tu.NewPKWriteURL(db, table, config.PK_INSERT_DB_OPERATION)
+			_, resp := tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusOK, "")
+			checkOpID(t, resp, param.OperationID)
+			checkIntTableRow(t, tc, 10, 10, "100", "100")
+
+			// Test. inserting the same row again fails
+			_, resp = tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body),
+				http.StatusConflict, common.ERROR_036())
+			var errResp common.ErrorResponse
+			if err := json.Unmarshal([]byte(resp), &errResp); err != nil {
+				t.Fatalf("Failed to unmarshal error response. Error: %v", err)
+			}
+			if errResp.Code != "ERROR_036" || errResp.Reason != common.REASON_CONFLICT ||
+				errResp.NdbCode != 630 {
+				t.Fatalf("Wrong error code. Code: %s, Reason: %s, NDB code: %d", errResp.Code,
+					errResp.Reason, errResp.NdbCode)
+			}
+			if errResp.DB != db || errResp.Table != table || errResp.OperationID != *param.OperationID {
+				t.Fatalf("Wrong request in the error response. %v", errResp)
+			}
+
+			// Test. update the row
+			param.WriteColumns = tu.NewWriteColumnsKVs("col0", 200)
+			body, _ = json.MarshalIndent(param, "", "\t")
+			url = tu.NewPKWriteURL(db, table, config.PK_UPDATE_DB_OPERATION)
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusOK, "")
+			checkIntTableRow(t, tc, 10, 10, "200", "100")
+
+			// Test. set a column to NULL
+			param.WriteColumns = tu.NewWriteColumnsKVs("col1", nil)
+			body, _ = json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusOK, "")
+			checkIntTableRow(t, tc, 10, 10, "200", "null")
+
+			// Test. updating a row that does not exist
+			param.Filters = tu.NewFiltersKVs("id0", 11, "id1", 11)
+			body, _ = json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusNotFound, "")
+
+			// Test. upsert inserts a new row and then overwrites it
+			param.WriteColumns = tu.NewWriteColumnsKVs("col0", 300, "col1", 300)
+			body, _ = json.MarshalIndent(param, "", "\t")
+			url = tu.NewPKWriteURL(db, table, config.PK_UPSERT_DB_OPERATION)
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusOK, "")
+			checkIntTableRow(t, tc, 11, 11, "300", "300")
+
+			param.WriteColumns = tu.NewWriteColumnsKVs("col0", 400, "col1", 400)
+			body, _ = json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusOK, "")
+			checkIntTableRow(t, tc, 11, 11, "400", "400")
+		})
+}
+
+func TestPKWriteGRPC(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB004"},
+		getPKWriteHandler(), func(tc common.TestContext) {
+			db := "DB004"
+			table := "int_table"
+			operation := api.PK_INSERT
+
+			params := api.PKWriteParams{
+				DB:           &db,
+				Table:        &table,
+				Operation:    &operation,
+				Filters:      tu.NewFiltersKVs("id0", 20, "id1", 20),
+				WriteColumns: tu.NewWriteColumnsKVs("col0", 100, "col1", nil),
+				OperationID:  tu.NewOperationID(64),
+			}
+			_, resp := tu.SendGRPCPKWriteRequest(t, &params, http.StatusOK, "")
+			if resp.OperationID == nil || *resp.OperationID != *params.OperationID {
+				t.Fatalf("Operation ID does not match")
+			}
+			checkIntTableRow(t, tc, 20, 20, "100", "null")
+
+			tu.SendGRPCPKWriteRequest(t, &params, http.StatusConflict, common.ERROR_036())
+
+			operation = api.PK_UPDATE
+			params.WriteColumns = tu.NewWriteColumnsKVs("col1", 200)
+			tu.SendGRPCPKWriteRequest(t, &params, http.StatusOK, "")
+			checkIntTableRow(t, tc, 20, 20, "100", "200")
+
+			operation = api.PK_UPSERT
+			params.Filters = tu.NewFiltersKVs("id0", 21, "id1", 21)
+			tu.SendGRPCPKWriteRequest(t, &params, http.StatusOK, "")
+			checkIntTableRow(t, tc, 21, 21, "null", "200")
+		})
+}
+
+func TestPKDelete(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB004"},
+		getPKWriteHandler(), func(tc common.TestContext) {
+			db := "DB004"
+			table := "int_table"
+
+			// Test. delete an existing row
+			param := api.PKDeleteBody{
+				Filters:     tu.NewFiltersKVs("id0", 0, "id1", 0),
+				OperationID: tu.NewOperationID(64),
+			}
+			body, _ := json.MarshalIndent(param, "", "\t")
+			url := tu.NewPKWriteURL(db, table, config.PK_DELETE_DB_OPERATION)
+			_, resp := tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusOK, "")
+			checkOpID(t, resp, param.OperationID)
+
+			readParam := api.PKReadBody{Filters: param.Filters}
+			readBody, _ := json.MarshalIndent(readParam, "", "\t")
+			readURL := tu.NewPKReadURL(db, table)
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, readURL, string(readBody), http.StatusNotFound, "")
+
+			// Test. deleting the same row again returns 404
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusNotFound, "")
+
+			// Test. omitting filters should result in 400 error
+			param.Filters = nil
+			body, _ = json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusBadRequest,
+				"Error:Field validation for 'Filters'")
+
+			// Test. gRPC delete
+			operation := api.PK_DELETE
+			params := api.PKWriteParams{
+				DB:          &db,
+				Table:       &table,
+				Operation:   &operation,
+				Filters:     tu.NewFiltersKVs("id0", 1, "id1", 1),
+				OperationID: tu.NewOperationID(64),
+			}
+			tu.SendGRPCPKWriteRequest(t, &params, http.StatusOK, "")
+			tu.SendGRPCPKWriteRequest(t, &params, http.StatusNotFound, "")
+		})
+}
+
+func TestPKWriteValidation(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB004"},
+		getPKWriteHandler(), func(tc common.TestContext) {
+			url := tu.NewPKWriteURL("DB004", "int_table", config.PK_INSERT_DB_OPERATION)
+
+			// Test. Omitting filter should result in 400 error
+			param := api.PKWriteBody{
+				WriteColumns: tu.NewWriteColumnsKVs("col0", 1),
+				OperationID:  tu.NewOperationID(64),
+			}
+			body, _ := json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusBadRequest,
+				"Error:Field validation for 'Filters'")
+
+			// Test. write columns and filters must not overlap
+			param.Filters = tu.NewFiltersKVs("id0", 1, "id1", 1)
+			param.WriteColumns = tu.NewWriteColumnsKVs("id0", 1)
+			body, _ = json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusBadRequest,
+				"field validation for write columns faild. 'id0' already included in filter")
+
+			// Test. write columns must be unique
+			param.WriteColumns = tu.NewWriteColumnsKVs("col0", 1, "col0", 2)
+			body, _ = json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusBadRequest,
+				"field validation for 'WriteColumns' failed on the 'unique' tag")
+
+			// Test. write column does not exist
+			param.WriteColumns = tu.NewWriteColumnsKVs("col0_XXX", 1)
+			body, _ = json.MarshalIndent(param, "", "\t")
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusBadRequest,
+				common.ERROR_012())
+
+			// Test. update requires write columns
+			param.WriteColumns = nil
+			body, _ = json.MarshalIndent(param, "", "\t")
+			url = tu.NewPKWriteURL("DB004", "int_table", config.PK_UPDATE_DB_OPERATION)
+			tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusBadRequest,
+				"Error:Field validation for 'WriteColumns'")
+		})
+}
+
+func checkOpID(t testing.TB, resp string, opID *string) {
+	t.Helper()
+	var res api.PKWriteResponseJSON
+	err := json.Unmarshal([]byte(resp), &res)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal response object %v", err)
+	}
+
+	if res.OperationID == nil || *res.OperationID != *opID {
+		t.Fatalf("Operation ID does not match")
+	}
+}
+
+// reads back a row from DB004.int_table using pk-read and compares the column values
+func checkIntTableRow(t testing.TB, tc common.TestContext, id0, id1 int, col0, col1 string) {
+	t.Helper()
+	param := api.PKReadBody{
+		Filters: tu.NewFiltersKVs("id0", id0, "id1", id1),
+	}
+	body, _ := json.MarshalIndent(param, "", "\t")
+	url := tu.NewPKReadURL("DB004", "int_table")
+	_, resp := tu.SendHttpRequest(t, tc, config.PK_HTTP_VERB, url, string(body), http.StatusOK, "")
+
+	var res api.PKReadResponseJSON
+	err := json.Unmarshal([]byte(resp), &res)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal response object %v", err)
+	}
+
+	expected := map[string]string{"col0": col0, "col1": col1}
+	for col, val := range expected {
+		got, found := (*res.Data)[col]
+		if !found {
+			t.Fatalf("Column %s not found in the response", col)
+		}
+
+		gotStr := "null"
+		if got != nil {
+			gotStr = string(*got)
+		}
+
+		if gotStr != val {
+			t.Fatalf("Column %s data mismatch. Expected: %s, Got: %s", col, val, gotStr)
+		}
+	}
+}
+
+func getPKWriteHandler() *handlers.AllHandlers {
+	return &handlers.AllHandlers{
+		Stater:   nil,
+		Batcher:  nil,
+		PKReader: GetPKReader(),
+		PKWriter: GetPKWriter(),
+	}
+}
@@ -0,0 +1,103 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pkread
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/handlers"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+type Schema struct{}
+
+var _ handlers.SchemaReader = (*Schema)(nil)
+var schema Schema
+
+func GetSchemaReader() handlers.SchemaReader {
+	return &schema
+}
+
+func (s *Schema) TableSchemaHttpHandler(c *gin.Context) {
+	pp := api.PKReadPP{}
+	if err := parseURI(c, &pp); err != nil {
+		common.SetResponseBodyError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	schemaParams := api.TableSchemaParams{DB: pp.DB, Table: pp.Table}
+	response := api.TableSchemaResponse{}
+	status, err := schema.TableSchemaHandler(c.Request.Context(), &schemaParams, getAPIKey(c), &response)
+	if err != nil {
+		common.SetResponseBodyError(c, status, err)
+		return
+	}
+
+	common.SetResponseBody(c, status, &response)
+}
+
+// TableSchemaHandler returns the columns and indexes of a table. The API key
+// needs access to the database of the table, as for reads
+func (s *Schema) TableSchemaHandler(ctx context.Context, schemaParams *api.TableSchemaParams, apiKey *string, response *api.TableSchemaResponse) (int, error) {
+	status, err := readTableSchema(ctx, schemaParams, apiKey, response)
+	return status, common.WithRequest(err, schemaParams.DB, schemaParams.Table, nil)
+}
+
+func readTableSchema(ctx context.Context, schemaParams *api.TableSchemaParams, apiKey *string, response *api.TableSchemaResponse) (int, error) {
+	// gRPC requests are not parsed by gin
+	if err := ValidateTableSchemaRequest(schemaParams); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	if err := checkAPIKey(ctx, apiKey, schemaParams.DB); err != nil {
+		return APIKeyErrorStatus(err), err
+	}
+
+	span := StartNativeSpan(ctx, "table_schema", *schemaParams.DB, *schemaParams.Table)
+	tableSchema, dalErr := dal.GetDataAccess().GetTableSchema(*schemaParams.DB, *schemaParams.Table)
+	EndNativeSpan(span, dalErr)
+	if dalErr != nil {
+		return dalErr.HttpCode, dalErr
+	}
+
+	response.DB = *schemaParams.DB
+	response.Table = *schemaParams.Table
+	response.Columns = tableSchema.Columns
+	response.Indexes = tableSchema.Indexes
+	return http.StatusOK, nil
+}
+
+func ValidateTableSchemaRequest(req *api.TableSchemaParams) error {
+	if req.DB == nil || req.Table == nil {
+		return fmt.Errorf("Database and table are required")
+	}
+
+	if err := validateDBIdentifier(*req.DB); err != nil {
+		return err
+	}
+
+	if err := validateDBIdentifier(*req.Table); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pkread
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/handlers"
+	tu "hopsworks.ai/rdrs/internal/handlers/utils"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+func TestTableSchema(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB026"},
+		getSchemaHandler(), func(tc common.TestContext) {
+			url := tu.NewPKWriteURL("DB026", "users", config.SCHEMA_OPERATION)
+			res := sendTableSchemaRequest(t, tc, url, http.StatusOK, "")
+			checkUsersSchema(t, res)
+
+			// Test. table does not exist
+			url = tu.NewPKWriteURL("DB026", "users_XXX", config.SCHEMA_OPERATION)
+			sendTableSchemaRequest(t, tc, url, http.StatusBadRequest, common.ERROR_011())
+
+			// Test. the API key has no access to the database
+			url = tu.NewPKWriteURL("DB026_XXX", "users", config.SCHEMA_OPERATION)
+			sendTableSchemaRequest(t, tc, url, http.StatusUnauthorized, "")
+		})
+}
+
+func TestTableSchemaGRPC(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB026"},
+		getSchemaHandler(), func(tc common.TestContext) {
+			db := "DB026"
+			table := "users"
+			params := api.TableSchemaParams{DB: &db, Table: &table}
+			_, res := tu.SendGRPCTableSchemaRequest(t, &params, http.StatusOK, "")
+			checkUsersSchema(t, res)
+
+			// Test. table does not exist
+			table = "users_XXX"
+			tu.SendGRPCTableSchemaRequest(t, &params, http.StatusBadRequest, common.ERROR_011())
+		})
+}
+
+func sendTableSchemaRequest(t testing.TB, tc common.TestContext, url string,
+	expectedStatus int, expectedErrMsg string) *api.TableSchemaResponse {
+	t.Helper()
+	_, resp := tu.SendHttpRequest(t, tc, config.SCHEMA_HTTP_VERB, url, "",
+		expectedStatus, expectedErrMsg)
+	if expectedStatus != http.StatusOK {
+		return nil
+	}
+
+	var res api.TableSchemaResponse
+	err := json.Unmarshal([]byte(resp), &res)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal response object %v", err)
+	}
+	return &res
+}
+
+// checks the schema of DB026.users
+func checkUsersSchema(t testing.TB, res *api.TableSchemaResponse) {
+	t.Helper()
+	if res.DB != "DB026" || res.Table != "users" {
+		t.Fatalf("Wrong table. Got: %s.%s", res.DB, res.Table)
+	}
+
+	expected := []dal.ColumnSchema{
+		{Name: "id", NdbType: "Int", MySQLType: "int", PrimaryKey: true},
+		{Name: "email", NdbType: "Varchar", MySQLType: "varchar(100)", Length: 100},
+		{Name: "ext_id", NdbType: "Int", MySQLType: "int", Nullable: true},
+		{Name: "name", NdbType: "Varchar", MySQLType: "varchar(100)", Length: 100, Nullable: true},
+	}
+	if len(res.Columns) != len(expected) {
+		t.Fatalf("Wrong number of columns. Expected: %d, Got: %d", len(expected), len(res.Columns))
+	}
+	for i, col := range res.Columns {
+		// the charset depends on the server defaults
+		col.Charset = ""
+		col.Precision = 0
+		col.Scale = 0
+		if col != expected[i] {
+			t.Fatalf("Column %d mismatch. Expected: %#v, Got: %#v", i, expected[i], res.Columns[i])
+		}
+	}
+	if res.Columns[1].Charset == "" {
+		t.Fatalf("Expecting a charset for column email")
+	}
+
+	for _, index := range []dal.IndexSchema{
+		{Name: "PRIMARY", Type: dal.ORDERED_INDEX, Columns: []string{"id"}},
+		{Name: "email_idx", Type: dal.UNIQUE_INDEX, Columns: []string{"email"}},
+		{Name: "ext_idx", Type: dal.UNIQUE_INDEX, Columns: []string{"ext_id"}},
+		{Name: "name_idx", Type: dal.ORDERED_INDEX, Columns: []string{"name"}},
+	} {
+		if !hasIndex(res.Indexes, index) {
+			t.Fatalf("Index %s is missing. Got: %#v", index.Name, res.Indexes)
+		}
+	}
+}
+
+func hasIndex(indexes []dal.IndexSchema, expected dal.IndexSchema) bool {
+	for _, index := range indexes {
+		if index.Name == expected.Name && index.Type == expected.Type &&
+			len(index.Columns) == len(expected.Columns) {
+			for i := range index.Columns {
+				if index.Columns[i] != expected.Columns[i] {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func getSchemaHandler() *handlers.AllHandlers {
+	return &handlers.AllHandlers{
+		Stater:       nil,
+		Batcher:      nil,
+		PKReader:     nil,
+		PKWriter:     nil,
+		SchemaReader: GetSchemaReader(),
+	}
+}
@@ -0,0 +1,339 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pkread
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/handlers"
+	"hopsworks.ai/rdrs/internal/log"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+type TableScan struct{}
+
+var _ handlers.TableScanner = (*TableScan)(nil)
+var tableScan TableScan
+
+func GetTableScanner() handlers.TableScanner {
+	return &tableScan
+}
+
+// TableScanHttpHandler streams the rows to the client while they are read
+// from the response buffer
+func (s *TableScan) TableScanHttpHandler(c *gin.Context) {
+	scanParams := api.TableScanParams{}
+
+	err := ParseTableScanRequest(c, &scanParams)
+	if err != nil {
+		if log.IsDebug() {
+			body, _ := ioutil.ReadAll(c.Request.Body)
+			log.Debugf("Unable to parse request. Error: %v. Body: %s\n", err, body)
+		}
+		common.SetResponseBodyError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if common.AcceptsNDJSON(c) {
+		writeNDJSONScan(c, func(response api.ScanStream) (int, error) {
+			return tableScan.TableScanStreamHandler(c.Request.Context(), &scanParams, getAPIKey(c),
+				response)
+		})
+		return
+	}
+
+	c.Header("Content-Type", "application/json; charset=utf-8")
+	response := api.NewScanResponseJSONWriter(c.Writer)
+	response.Init()
+
+	status, err := tableScan.TableScanHandler(c.Request.Context(), &scanParams, getAPIKey(c), response)
+	if err != nil {
+		if response.Written() {
+			response.CloseWithError(status, err)
+		} else {
+			common.SetResponseBodyError(c, status, err)
+		}
+		return
+	}
+
+	c.Status(status)
+	if err := response.Close(); err != nil {
+		log.Warnf("Failed to write the table scan response. Error: %v", err)
+	}
+}
+
+func (s *TableScan) TableScanHandler(ctx context.Context, scanParams *api.TableScanParams, apiKey *string, response api.ScanResponse) (int, error) {
+	status, err := scanTable(ctx, scanParams, apiKey, response)
+	return status, common.WithRequest(err, scanParams.DB, scanParams.Table, scanParams.OperationID)
+}
+
+func scanTable(ctx context.Context, scanParams *api.TableScanParams, apiKey *string, response api.ScanResponse) (int, error) {
+	err := checkAPIKey(ctx, apiKey, scanParams.DB)
+	if err != nil {
+		return APIKeyErrorStatus(err), err
+	}
+
+	err = ValidateTableScanRequest(scanParams)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	return scanTablePage(ctx, scanParams, response)
+}
+
+// number of rows read at a time by streamed table scans
+var scanStreamPageSize uint32 = api.SCAN_STREAM_PAGE_SIZE
+
+// TableScanStreamHandler reads the table in pages and flushes each page to
+// the client before the next page is read. Flush blocks while the client is
+// not reading, so a slow client does not make the server buffer the table.
+// The limit, if set, is the total number of rows. All rows are read otherwise
+func (s *TableScan) TableScanStreamHandler(ctx context.Context, scanParams *api.TableScanParams,
+	apiKey *string, response api.ScanStream) (int, error) {
+	status, err := streamTable(ctx, scanParams, apiKey, response)
+	return status, common.WithRequest(err, scanParams.DB, scanParams.Table, scanParams.OperationID)
+}
+
+func streamTable(ctx context.Context, scanParams *api.TableScanParams, apiKey *string,
+	response api.ScanStream) (int, error) {
+	err := checkAPIKey(ctx, apiKey, scanParams.DB)
+	if err != nil {
+		return APIKeyErrorStatus(err), err
+	}
+
+	err = ValidateTableScanRequest(scanParams)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	pageParams := *scanParams
+	page := scanStreamPage{ScanStream: response}
+	for {
+		pageLimit := scanStreamPageSize
+		if scanParams.Limit != nil && *scanParams.Limit-page.rows < pageLimit {
+			pageLimit = *scanParams.Limit - page.rows
+		}
+		pageParams.Limit = &pageLimit
+		page.token = nil
+
+		status, err := scanTablePage(ctx, &pageParams, &page)
+		if err != nil || status != http.StatusOK {
+			return status, err
+		}
+
+		if err := response.Flush(); err != nil {
+			return http.StatusInternalServerError, err
+		}
+
+		if page.token == nil || (scanParams.Limit != nil && page.rows == *scanParams.Limit) {
+			break
+		}
+		pageParams.ContinuationToken = page.token
+	}
+
+	// more rows can be read using the token of the last page
+	response.SetContinuationToken(page.token)
+	return http.StatusOK, nil
+}
+
+// scanStreamPage counts the streamed rows and keeps the continuation token
+// of the last page
+type scanStreamPage struct {
+	api.ScanStream
+	rows  uint32
+	token *string
+}
+
+func (p *scanStreamPage) AddRow() {
+	p.rows++
+	p.ScanStream.AddRow()
+}
+
+func (p *scanStreamPage) SetContinuationToken(token *string) {
+	p.token = token
+}
+
+func scanTablePage(ctx context.Context, scanParams *api.TableScanParams, response api.ScanResponse) (int, error) {
+	reqBuff, respBuff, err := CreateNativeTableScanRequest(scanParams)
+	defer dal.ReturnBuffer(reqBuff)
+	defer dal.ReturnBuffer(respBuff)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	// table scans use the same native entry point as index scans
+	span := StartNativeSpan(ctx, "table_scan", *scanParams.DB, *scanParams.Table)
+	dalErr := dal.GetDataAccess().IndexScan(ctx, reqBuff, respBuff)
+	EndNativeSpan(span, dalErr)
+	if dalErr != nil {
+		return dalErr.HttpCode, dalErr
+	}
+
+	status, err := ProcessIndexScanResponse(respBuff, response)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return int(status), nil
+}
+
+func ParseTableScanRequest(c *gin.Context, scanParams *api.TableScanParams) error {
+
+	body := api.TableScanBody{}
+	pp := api.PKReadPP{}
+
+	if err := parseURI(c, &pp); err != nil {
+		return err
+	}
+
+	b := binding.JSON
+	if err := b.Bind(c.Request, &body); err != nil {
+		return err
+	}
+
+	scanParams.DB = pp.DB
+	scanParams.Table = pp.Table
+	scanParams.Filter = body.Filter
+	scanParams.Limit = body.Limit
+	scanParams.ContinuationToken = body.ContinuationToken
+	scanParams.ReadColumns = body.ReadColumns
+	scanParams.OperationID = body.OperationID
+
+	return ValidateTableScanRequest(scanParams)
+}
+
+func ValidateTableScanRequest(req *api.TableScanParams) error {
+
+	if req.DB == nil || req.Table == nil {
+		return fmt.Errorf("db and table are required")
+	}
+
+	for _, identifier := range []string{*req.DB, *req.Table} {
+		if err := validateDBIdentifier(identifier); err != nil {
+			return err
+		}
+	}
+
+	if req.Filter != nil {
+		nodes := 0
+		if err := validateFilterExpr(req.Filter, &nodes); err != nil {
+			return err
+		}
+	}
+
+	if req.Limit != nil && (*req.Limit < 1 || *req.Limit > api.TABLE_SCAN_MAX_LIMIT) {
+		return fmt.Errorf("Error:Field validation for 'Limit' failed. Limit must be between 1 and %d",
+			api.TABLE_SCAN_MAX_LIMIT)
+	}
+
+	if req.ContinuationToken != nil {
+		if _, err := decodeContinuationToken(req.ContinuationToken); err != nil {
+			return err
+		}
+	}
+
+	return validateScanReadColumns(req.ReadColumns)
+}
+
+// validateFilterExpr checks the structure of the filter tree. The column
+// names and the value types are checked by the native layer
+func validateFilterExpr(expr *api.FilterExpr, nodes *int) error {
+	*nodes++
+	if *nodes > api.TABLE_SCAN_MAX_FILTER_NODES {
+		return fmt.Errorf("Invalid filter. The filter can not have more than %d nodes",
+			api.TABLE_SCAN_MAX_FILTER_NODES)
+	}
+
+	if expr.Op == nil {
+		return fmt.Errorf("Error:Field validation for 'Op' failed on the 'required' tag")
+	}
+
+	switch *expr.Op {
+	case api.FILTER_AND, api.FILTER_OR, api.FILTER_NOT:
+		if expr.Column != nil || expr.Value != nil || expr.Lower != nil || expr.Upper != nil {
+			return fmt.Errorf("Invalid filter. Operator '%s' only accepts operands", *expr.Op)
+		}
+		if expr.Operands == nil || len(*expr.Operands) == 0 {
+			return fmt.Errorf("Invalid filter. Operator '%s' requires operands", *expr.Op)
+		}
+		if *expr.Op == api.FILTER_NOT && len(*expr.Operands) != 1 {
+			return fmt.Errorf("Invalid filter. Operator '%s' requires exactly one operand", *expr.Op)
+		}
+		for i := range *expr.Operands {
+			if err := validateFilterExpr(&(*expr.Operands)[i], nodes); err != nil {
+				return err
+			}
+		}
+		return nil
+	case api.FILTER_EQ, api.FILTER_NE, api.FILTER_LT, api.FILTER_LE, api.FILTER_GT,
+		api.FILTER_GE, api.FILTER_LIKE, api.FILTER_BETWEEN, api.FILTER_IS_NULL,
+		api.FILTER_IS_NOT_NULL:
+	default:
+		return fmt.Errorf("Invalid filter. Unknown operator '%s'", *expr.Op)
+	}
+
+	if expr.Operands != nil {
+		return fmt.Errorf("Invalid filter. Operator '%s' does not accept operands", *expr.Op)
+	}
+
+	if expr.Column == nil {
+		return fmt.Errorf("Invalid filter. Operator '%s' requires a column", *expr.Op)
+	}
+	if err := validateDBIdentifier(*expr.Column); err != nil {
+		return err
+	}
+
+	switch *expr.Op {
+	case api.FILTER_BETWEEN:
+		if expr.Value != nil || !isFilterValue(expr.Lower) || !isFilterValue(expr.Upper) {
+			return fmt.Errorf("Invalid filter. Operator '%s' requires lower and upper values",
+				*expr.Op)
+		}
+	case api.FILTER_IS_NULL, api.FILTER_IS_NOT_NULL:
+		if expr.Value != nil || expr.Lower != nil || expr.Upper != nil {
+			return fmt.Errorf("Invalid filter. Operator '%s' does not accept values", *expr.Op)
+		}
+	default:
+		if expr.Lower != nil || expr.Upper != nil || !isFilterValue(expr.Value) {
+			return fmt.Errorf("Invalid filter. Operator '%s' requires a value", *expr.Op)
+		}
+
+		// like patterns are strings
+		if *expr.Op == api.FILTER_LIKE {
+			var pattern string
+			if err := json.Unmarshal(*expr.Value, &pattern); err != nil {
+				return fmt.Errorf("Invalid filter. Operator '%s' requires a string value", *expr.Op)
+			}
+		}
+	}
+
+	return nil
+}
+
+// null can not be compared. Use is-null instead
+func isFilterValue(value *json.RawMessage) bool {
+	return value != nil && len(*value) > 0 && string(*value) != "null"
+}
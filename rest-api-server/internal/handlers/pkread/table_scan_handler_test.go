@@ -0,0 +1,327 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package pkread
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/handlers"
+	tu "hopsworks.ai/rdrs/internal/handlers/utils"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+func TestTableScan(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB025"},
+		getTableScanHandler(), func(tc common.TestContext) {
+			url := tu.NewPKWriteURL("DB025", "events", config.TABLE_SCAN_DB_OPERATION)
+
+			// Test. unfiltered scan returns all rows ordered by the primary key
+			param := api.TableScanBody{OperationID: tu.NewOperationID(64)}
+			res := sendTableScanRequest(t, tc, url, &param, http.StatusOK, "")
+			checkScanIDs(t, res, "1", "2", "3", "4", "5")
+			if res.OperationID == nil || *res.OperationID != *param.OperationID {
+				t.Fatalf("Operation ID does not match")
+			}
+			if res.ContinuationToken != nil {
+				t.Fatalf("Unexpected continuation token")
+			}
+
+			// Test. comparison filters
+			param.Filter = filterCmp(api.FILTER_EQ, "user_id", 2)
+			checkScanIDs(t, sendTableScanRequest(t, tc, url, &param, http.StatusOK, ""), "4", "5")
+
+			param.Filter = filterBetween("ts", 150, 300)
+			checkScanIDs(t, sendTableScanRequest(t, tc, url, &param, http.StatusOK, ""), "2", "3", "5")
+
+			param.Filter = filterCmp(api.FILTER_LIKE, "event", "%c%")
+			checkScanIDs(t, sendTableScanRequest(t, tc, url, &param, http.StatusOK, ""), "3")
+
+			param.Filter = filterCmp(api.FILTER_IS_NULL, "event", nil)
+			checkScanIDs(t, sendTableScanRequest(t, tc, url, &param, http.StatusOK, ""), "5")
+
+			// Test. logical operators
+			param.Filter = filterGroup(api.FILTER_AND,
+				filterCmp(api.FILTER_EQ, "user_id", 1),
+				filterGroup(api.FILTER_NOT, filterCmp(api.FILTER_EQ, "ts", 200)))
+			checkScanIDs(t, sendTableScanRequest(t, tc, url, &param, http.StatusOK, ""), "1", "3")
+
+			param.Filter = filterGroup(api.FILTER_OR,
+				filterCmp(api.FILTER_LT, "ts", 200),
+				filterCmp(api.FILTER_IS_NULL, "event", nil))
+			checkScanIDs(t, sendTableScanRequest(t, tc, url, &param, http.StatusOK, ""), "1", "4", "5")
+		})
+}
+
+func TestTableScanPaging(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB025"},
+		getTableScanHandler(), func(tc common.TestContext) {
+			url := tu.NewPKWriteURL("DB025", "events", config.TABLE_SCAN_DB_OPERATION)
+			limit := uint32(2)
+
+			// Test. pages of filtered rows are returned until the table is exhausted
+			param := api.TableScanBody{
+				Filter:      filterCmp(api.FILTER_NE, "ts", 300),
+				Limit:       &limit,
+				ReadColumns: tu.NewReadColumn("id"),
+			}
+			expected := [][]string{{"1", "2"}, {"4", "5"}}
+			for i, ids := range expected {
+				res := sendTableScanRequest(t, tc, url, &param, http.StatusOK, "")
+				checkScanIDs(t, res, ids...)
+				if i == len(expected)-1 {
+					if res.ContinuationToken != nil {
+						t.Fatalf("Unexpected continuation token on the last page")
+					}
+				} else if res.ContinuationToken == nil {
+					t.Fatalf("Continuation token is missing for page %d", i)
+				}
+				param.ContinuationToken = res.ContinuationToken
+			}
+
+			// Test. invalid continuation token
+			token := "not-a-token"
+			param.ContinuationToken = &token
+			sendTableScanRequest(t, tc, url, &param, http.StatusBadRequest, "Invalid continuation token")
+		})
+}
+
+func TestTableScanStream(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB025"},
+		getTableScanHandler(), func(tc common.TestContext) {
+			url := tu.NewPKWriteURL("DB025", "events", config.TABLE_SCAN_DB_OPERATION)
+
+			// read the table in multiple pages
+			defer func(size uint32) { scanStreamPageSize = size }(scanStreamPageSize)
+			scanStreamPageSize = 2
+
+			// Test. all rows are streamed when there is no limit
+			param := api.TableScanBody{OperationID: tu.NewOperationID(64)}
+			rows, trailer := sendTableScanNDJSONRequest(t, tc, url, &param, http.StatusOK, "")
+			checkNDJSONIDs(t, rows, "1", "2", "3", "4", "5")
+			if trailer.RowCount == nil || *trailer.RowCount != 5 || trailer.ContinuationToken != nil {
+				t.Fatalf("Wrong trailer %v", trailer)
+			}
+			if trailer.OperationID == nil || *trailer.OperationID != *param.OperationID {
+				t.Fatalf("Operation ID does not match")
+			}
+
+			// Test. the limit is the total number of rows
+			limit := uint32(3)
+			param = api.TableScanBody{Filter: filterCmp(api.FILTER_NE, "id", 2), Limit: &limit}
+			rows, trailer = sendTableScanNDJSONRequest(t, tc, url, &param, http.StatusOK, "")
+			checkNDJSONIDs(t, rows, "1", "3", "4")
+			if trailer.ContinuationToken == nil {
+				t.Fatalf("Continuation token is missing")
+			}
+
+			param.ContinuationToken = trailer.ContinuationToken
+			rows, _ = sendTableScanNDJSONRequest(t, tc, url, &param, http.StatusOK, "")
+			checkNDJSONIDs(t, rows, "5")
+
+			// Test. errors before the first row change the status code
+			param.Filter = filterCmp(api.FILTER_EQ, "id_XXX", 1)
+			sendTableScanNDJSONRequest(t, tc, url, &param, http.StatusBadRequest, common.ERROR_012())
+		})
+}
+
+func TestTableScanGRPC(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB025"},
+		getTableScanHandler(), func(tc common.TestContext) {
+			db := "DB025"
+			table := "events"
+
+			defer func(size uint32) { scanStreamPageSize = size }(scanStreamPageSize)
+			scanStreamPageSize = 2
+
+			// Test. filtered rows are streamed
+			params := api.TableScanParams{
+				DB:          &db,
+				Table:       &table,
+				Filter:      filterCmp(api.FILTER_GE, "ts", 200),
+				OperationID: tu.NewOperationID(64),
+			}
+			_, res := tu.SendGRPCTableScanRequest(t, &params, http.StatusOK, "")
+			checkGRPCScanIDs(t, res, 2, 3, 5)
+			if res.OperationID == nil || *res.OperationID != *params.OperationID {
+				t.Fatalf("Operation ID does not match")
+			}
+
+			// Test. paging with the continuation token
+			limit := uint32(1)
+			params.Limit = &limit
+			_, res = tu.SendGRPCTableScanRequest(t, &params, http.StatusOK, "")
+			checkGRPCScanIDs(t, res, 2)
+			params.ContinuationToken = res.ContinuationToken
+			_, res = tu.SendGRPCTableScanRequest(t, &params, http.StatusOK, "")
+			checkGRPCScanIDs(t, res, 3)
+
+			// Test. invalid filter
+			params.Filter = filterCmp(api.FILTER_EQ, "id_XXX", 1)
+			tu.SendGRPCTableScanRequest(t, &params, http.StatusBadRequest, common.ERROR_012())
+		})
+}
+
+func TestTableScanValidation(t *testing.T) {
+
+	tu.WithDBs(t, []string{"DB025"},
+		getTableScanHandler(), func(tc common.TestContext) {
+			url := tu.NewPKWriteURL("DB025", "events", config.TABLE_SCAN_DB_OPERATION)
+
+			// Test. unknown operator
+			param := api.TableScanBody{Filter: filterCmp("xx", "id", 1)}
+			sendTableScanRequest(t, tc, url, &param, http.StatusBadRequest, "Unknown operator")
+
+			// Test. comparisons require a value
+			param.Filter = filterCmp(api.FILTER_EQ, "id", nil)
+			sendTableScanRequest(t, tc, url, &param, http.StatusBadRequest, "requires a value")
+
+			// Test. not has exactly one operand
+			param.Filter = filterGroup(api.FILTER_NOT,
+				filterCmp(api.FILTER_EQ, "id", 1), filterCmp(api.FILTER_EQ, "id", 2))
+			sendTableScanRequest(t, tc, url, &param, http.StatusBadRequest, "exactly one operand")
+
+			// Test. filter column does not exist
+			param.Filter = filterCmp(api.FILTER_EQ, "id_XXX", 1)
+			sendTableScanRequest(t, tc, url, &param, http.StatusBadRequest, common.ERROR_012())
+
+			// Test. like is only supported for string columns
+			param.Filter = filterCmp(api.FILTER_LIKE, "id", "1%")
+			sendTableScanRequest(t, tc, url, &param, http.StatusBadRequest, common.ERROR_048())
+
+			// Test. limit is out of range
+			limit := uint32(api.TABLE_SCAN_MAX_LIMIT + 1)
+			param = api.TableScanBody{Limit: &limit}
+			sendTableScanRequest(t, tc, url, &param, http.StatusBadRequest,
+				"Error:Field validation for 'Limit'")
+		})
+}
+
+func filterCmp(op string, column string, value interface{}) *api.FilterExpr {
+	expr := api.FilterExpr{Op: &op, Column: &column}
+	if value != nil {
+		v, _ := json.Marshal(value)
+		raw := json.RawMessage(v)
+		expr.Value = &raw
+	}
+	return &expr
+}
+
+func filterBetween(column string, lower, upper interface{}) *api.FilterExpr {
+	expr := filterCmp(api.FILTER_BETWEEN, column, nil)
+	l, _ := json.Marshal(lower)
+	u, _ := json.Marshal(upper)
+	rawL, rawU := json.RawMessage(l), json.RawMessage(u)
+	expr.Lower = &rawL
+	expr.Upper = &rawU
+	return expr
+}
+
+func filterGroup(op string, operands ...*api.FilterExpr) *api.FilterExpr {
+	ops := make([]api.FilterExpr, len(operands))
+	for i, operand := range operands {
+		ops[i] = *operand
+	}
+	return &api.FilterExpr{Op: &op, Operands: &ops}
+}
+
+func sendTableScanNDJSONRequest(t testing.TB, tc common.TestContext, url string,
+	param *api.TableScanBody, expectedStatus int, expectedErrMsg string) ([]map[string]*json.RawMessage,
+	*api.ScanStreamTrailerJSON) {
+	t.Helper()
+	body, _ := json.MarshalIndent(param, "", "\t")
+	headers := map[string]string{"Accept": common.NDJSON_CONTENT_TYPE}
+	_, resp := tu.SendHttpRequestWithHeaders(t, tc, config.TABLE_SCAN_HTTP_VERB, url, string(body),
+		headers, expectedStatus, expectedErrMsg)
+	if expectedStatus != http.StatusOK {
+		return nil, nil
+	}
+
+	// row lines followed by the trailer
+	lines := strings.Split(strings.TrimSuffix(resp, "\n"), "\n")
+	rows := []map[string]*json.RawMessage{}
+	for _, line := range lines[:len(lines)-1] {
+		var row api.ScanStreamRowJSON
+		if err := json.Unmarshal([]byte(line), &row); err != nil || row.Row == nil {
+			t.Fatalf("Failed to unmarshal row %s. Error: %v", line, err)
+		}
+		rows = append(rows, *row.Row)
+	}
+
+	var trailer api.ScanStreamTrailerJSON
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &trailer); err != nil || trailer.Error != nil {
+		t.Fatalf("Invalid trailer %s. Error: %v", lines[len(lines)-1], err)
+	}
+	return rows, &trailer
+}
+
+func checkNDJSONIDs(t testing.TB, rows []map[string]*json.RawMessage, ids ...string) {
+	t.Helper()
+	checkScanIDs(t, &api.ScanResponseJSON{Rows: &rows}, ids...)
+}
+
+func checkGRPCScanIDs(t testing.TB, res *api.ScanResponseGRPC, ids ...int64) {
+	t.Helper()
+	if len(*res.Rows) != len(ids) {
+		t.Fatalf("Wrong number of rows. Expected: %d, Got: %d", len(ids), len(*res.Rows))
+	}
+
+	for i, row := range *res.Rows {
+		id, found := row["id"]
+		if !found || id == nil || id.GetInt64Value() != ids[i] {
+			t.Fatalf("Row %d id mismatch. Expected: %d", i, ids[i])
+		}
+	}
+}
+
+func sendTableScanRequest(t testing.TB, tc common.TestContext, url string, param *api.TableScanBody,
+	expectedStatus int, expectedErrMsg string) *api.ScanResponseJSON {
+	t.Helper()
+	body, _ := json.MarshalIndent(param, "", "\t")
+	_, resp := tu.SendHttpRequest(t, tc, config.TABLE_SCAN_HTTP_VERB, url, string(body),
+		expectedStatus, expectedErrMsg)
+	if expectedStatus != http.StatusOK {
+		return nil
+	}
+
+	var res api.ScanResponseJSON
+	err := json.Unmarshal([]byte(resp), &res)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal response object %v", err)
+	}
+	return &res
+}
+
+func getTableScanHandler() *handlers.AllHandlers {
+	return &handlers.AllHandlers{
+		Stater:       nil,
+		Batcher:      nil,
+		PKReader:     nil,
+		PKWriter:     nil,
+		TableScanner: GetTableScanner(),
+	}
+}
@@ -18,12 +18,14 @@
 package stat
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"hopsworks.ai/rdrs/internal/common"
 	"hopsworks.ai/rdrs/internal/dal"
 	"hopsworks.ai/rdrs/internal/handlers"
+	"hopsworks.ai/rdrs/internal/metrics"
 	"hopsworks.ai/rdrs/pkg/api"
 )
 
@@ -40,7 +42,7 @@ func GetStater() handlers.Stater {
 
 func (s *Stat) StatOpsHttpHandler(c *gin.Context) {
 	statResp := api.StatResponse{}
-	stats, err := stat.StatOpsHandler(&statResp)
+	stats, err := stat.StatOpsHandler(c.Request.Context(), &statResp)
 	if err != nil {
 		common.SetResponseBodyError(c, http.StatusInternalServerError, err)
 		return
@@ -48,9 +50,14 @@ func (s *Stat) StatOpsHttpHandler(c *gin.Context) {
 	common.SetResponseBody(c, stats, &statResp)
 }
 
-func (s *Stat) StatOpsHandler(statResp *api.StatResponse) (int, error) {
+func (s *Stat) StatOpsHandler(ctx context.Context, statResp *api.StatResponse) (int, error) {
 
-	rondbStats, err := dal.GetRonDBStats()
+	rondbStats, err := dal.GetDataAccess().GetRonDBStats()
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	connectionStats, err := dal.GetDataAccess().GetConnectionStats()
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -58,6 +65,8 @@ func (s *Stat) StatOpsHandler(statResp *api.StatResponse) (int, error) {
 	nativeBuffersStats := dal.GetNativeBuffersStats()
 	statResp.MemoryStats = nativeBuffersStats
 	statResp.RonDBStats = *rondbStats
+	statResp.DBStats = metrics.GetDBStats()
+	statResp.ConnectionStats = connectionStats
 
 	return http.StatusOK, nil
 }
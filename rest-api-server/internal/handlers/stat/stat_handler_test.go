@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"google.golang.org/grpc"
@@ -30,6 +31,7 @@ import (
 	"hopsworks.ai/rdrs/internal/handlers"
 	"hopsworks.ai/rdrs/internal/handlers/pkread"
 	tu "hopsworks.ai/rdrs/internal/handlers/utils"
+	"hopsworks.ai/rdrs/internal/metrics"
 	"hopsworks.ai/rdrs/pkg/api"
 )
 
@@ -65,6 +67,43 @@ func TestStat(t *testing.T) {
 		})
 }
 
+func TestMetrics(t *testing.T) {
+
+	db := "DB004"
+	table := "int_table"
+
+	ch := make(chan int)
+
+	numOps := 5
+
+	tu.WithDBs(t, []string{db},
+		getStatHandlers(), func(tc common.TestContext) {
+			for i := 0; i < numOps; i++ {
+				go performPkOp(t, tc, db, table, ch)
+			}
+			for i := 0; i < numOps; i++ {
+				<-ch
+			}
+
+			// the metrics are reset when the server is stopped
+			sendGRPCStatRequest(t)
+			_, metrics := tu.SendHttpRequest(t, tc, config.STAT_HTTP_VERB, tu.NewMetricsURL(), "",
+				http.StatusOK, "")
+
+			expected := []string{
+				fmt.Sprintf("rdrs_requests_total{operation=\"pk-read\",transport=\"rest\"} %d\n", numOps),
+				"rdrs_requests_total{operation=\"stat\",transport=\"grpc\"} 1\n",
+				fmt.Sprintf("rdrs_request_duration_seconds_count{operation=\"pk-read\",transport=\"rest\"} %d\n", numOps),
+				"# TYPE rdrs_ndb_objects gauge\n",
+			}
+			for _, line := range expected {
+				if !strings.Contains(metrics, line) {
+					t.Fatalf("Metrics do not contain %q. Got: %s", line, metrics)
+				}
+			}
+		})
+}
+
 func compare(t *testing.T, stats *api.StatResponse, expectedAllocations int64, numOps int64) {
 	if stats.MemoryStats.AllocationsCount != expectedAllocations ||
 		stats.MemoryStats.BuffersCount != expectedAllocations ||
@@ -77,6 +116,34 @@ func compare(t *testing.T, stats *api.StatResponse, expectedAllocations int64, n
 		stats.RonDBStats.NdbObjectsFreeCount != numOps {
 		t.Fatalf("RonDB stats do not match. %#v", stats.RonDBStats)
 	}
+
+	if len(stats.DBStats) != 1 || stats.DBStats[0].DB != "DB004" ||
+		len(stats.DBStats[0].Tables) != 1 || stats.DBStats[0].Tables[0].Table != "int_table" {
+		t.Fatalf("DB stats do not match. %#v", stats.DBStats)
+	}
+
+	if len(stats.ConnectionStats) != int(config.Configuration().RonDBConfig.ConnectionPoolSize) {
+		t.Fatalf("Connection stats do not match. %#v", stats.ConnectionStats)
+	}
+	requests := int64(0)
+	for _, connStats := range stats.ConnectionStats {
+		if connStats.NodeID <= 0 || connStats.NdbObjectsInUse != 0 {
+			t.Fatalf("Connection stats do not match. %#v", connStats)
+		}
+		requests += connStats.Requests
+	}
+	if requests < numOps {
+		t.Fatalf("Connection requests do not match. Expected at least %d, Got: %d", numOps, requests)
+	}
+
+	for _, readStats := range []metrics.ReadStats{stats.DBStats[0].ReadStats,
+		stats.DBStats[0].Tables[0].ReadStats} {
+		if readStats.Reads != numOps || readStats.Found != numOps || readStats.NotFound != 0 ||
+			readStats.Errors != 0 || readStats.BytesReturned <= 0 || readStats.P50LatencyUs <= 0 ||
+			readStats.P99LatencyUs < readStats.P50LatencyUs {
+			t.Fatalf("Read stats do not match. %#v", readStats)
+		}
+	}
 }
 
 func performPkOp(t *testing.T, tc common.TestContext, db string, table string, ch chan int) {
@@ -23,6 +23,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math/rand"
 	"net/http"
@@ -33,6 +34,7 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 	"hopsworks.ai/rdrs/internal/common"
 	"hopsworks.ai/rdrs/internal/config"
 	"hopsworks.ai/rdrs/internal/dal"
@@ -47,6 +49,13 @@ import (
 func SendHttpRequest(t testing.TB, tc common.TestContext, httpVerb string,
 	url string, body string, expectedStatus int, expectedErrMsg string) (int, string) {
 	t.Helper()
+	return SendHttpRequestWithHeaders(t, tc, httpVerb, url, body, nil, expectedStatus, expectedErrMsg)
+}
+
+func SendHttpRequestWithHeaders(t testing.TB, tc common.TestContext, httpVerb string,
+	url string, body string, headers map[string]string, expectedStatus int,
+	expectedErrMsg string) (int, string) {
+	t.Helper()
 
 	client := setupClient(tc)
 	var req *http.Request
@@ -72,6 +81,10 @@ func SendHttpRequest(t testing.TB, tc common.TestContext, httpVerb string,
 		req.Header.Set(config.API_KEY_NAME, common.HOPSWORKS_TEST_API_KEY)
 	}
 
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
 	resp, err = client.Do(req)
 	if err != nil {
 		t.Fatalf("Test failed to perform request. Error: %v", err)
@@ -329,6 +342,13 @@ func NewReadColumn(col string) *[]api.ReadColumn {
 	return &readColumns
 }
 
+func NewReadColumnWithDRT(col string, drt string) *[]api.ReadColumn {
+	readColumns := make([]api.ReadColumn, 1)
+	readColumns[0].Column = &col
+	readColumns[0].DataReturnType = &drt
+	return &readColumns
+}
+
 func NewPKReadURL(db string, table string) string {
 
 	url := fmt.Sprintf("%s:%d%s%s", config.Configuration().RestServer.RESTServerIP,
@@ -340,6 +360,17 @@ func NewPKReadURL(db string, table string) string {
 	return url
 }
 
+func NewPKWriteURL(db string, table string, operation string) string {
+
+	url := fmt.Sprintf("%s:%d%s%s", config.Configuration().RestServer.RESTServerIP,
+		config.Configuration().RestServer.RESTServerPort,
+		config.DB_OPS_EP_GROUP, operation)
+	url = strings.Replace(url, ":"+config.DB_PP, db, 1)
+	url = strings.Replace(url, ":"+config.TABLE_PP, table, 1)
+	appendURLProtocol(&url)
+	return url
+}
+
 func NewBatchReadURL() string {
 	url := fmt.Sprintf("%s:%d/%s/%s", config.Configuration().RestServer.RESTServerIP,
 		config.Configuration().RestServer.RESTServerPort,
@@ -348,6 +379,14 @@ func NewBatchReadURL() string {
 	return url
 }
 
+func NewBatchTxURL() string {
+	url := fmt.Sprintf("%s:%d/%s/%s", config.Configuration().RestServer.RESTServerIP,
+		config.Configuration().RestServer.RESTServerPort,
+		version.API_VERSION, config.BATCH_TX_OPERATION)
+	appendURLProtocol(&url)
+	return url
+}
+
 func NewStatURL() string {
 	url := fmt.Sprintf("%s:%d/%s/%s", config.Configuration().RestServer.RESTServerIP,
 		config.Configuration().RestServer.RESTServerPort,
@@ -356,6 +395,44 @@ func NewStatURL() string {
 	return url
 }
 
+func NewDatabasesURL() string {
+	url := fmt.Sprintf("%s:%d/%s/%s", config.Configuration().RestServer.RESTServerIP,
+		config.Configuration().RestServer.RESTServerPort,
+		version.API_VERSION, config.DATABASES_OPERATION)
+	appendURLProtocol(&url)
+	return url
+}
+
+func NewTablesURL(db string) string {
+	url := fmt.Sprintf("%s:%d/%s/%s/%s", config.Configuration().RestServer.RESTServerIP,
+		config.Configuration().RestServer.RESTServerPort,
+		version.API_VERSION, db, config.TABLES_OPERATION)
+	appendURLProtocol(&url)
+	return url
+}
+
+func NewFeatureVectorURL() string {
+	url := fmt.Sprintf("%s:%d/%s/%s", config.Configuration().RestServer.RESTServerIP,
+		config.Configuration().RestServer.RESTServerPort,
+		version.API_VERSION, config.FEATURE_VECTOR_OPERATION)
+	appendURLProtocol(&url)
+	return url
+}
+
+func NewMetricsURL() string {
+	url := fmt.Sprintf("%s:%d%s", config.Configuration().RestServer.RESTServerIP,
+		config.Configuration().RestServer.RESTServerPort, config.METRICS_PATH)
+	appendURLProtocol(&url)
+	return url
+}
+
+func NewHealthURL(path string) string {
+	url := fmt.Sprintf("%s:%d%s", config.Configuration().RestServer.RESTServerIP,
+		config.Configuration().RestServer.RESTServerPort, path)
+	appendURLProtocol(&url)
+	return url
+}
+
 func appendURLProtocol(url *string) {
 	if config.Configuration().Security.EnableTLS {
 		*url = fmt.Sprintf("https://%s", *url)
@@ -416,6 +493,23 @@ func NewFiltersKVs(vals ...interface{}) *[]api.Filter {
 	return &filters
 }
 
+func NewWriteColumnsKVs(vals ...interface{}) *[]api.WriteColumn {
+	if len(vals)%2 != 0 {
+		log.Panic("Expecting key value pairs")
+	}
+
+	cols := make([]api.WriteColumn, len(vals)/2)
+	cidx := 0
+	for i := 0; i < len(vals); {
+		c := fmt.Sprintf("%v", vals[i])
+		v := RawBytes(vals[i+1])
+		cols[cidx] = api.WriteColumn{Column: &c, Value: &v}
+		cidx++
+		i += 2
+	}
+	return &cols
+}
+
 var letterRunes = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_$")
 
 func RandString(n int) string {
@@ -441,8 +535,12 @@ func WithDBs(t testing.TB, dbs []string, handlers *handlers.AllHandlers,
 
 	rand.Seed(int64(time.Now().Nanosecond()))
 
-	common.CreateDatabases(t, dbs...)
-	defer common.DropDatabases(t, dbs...)
+	// the memory backend serves the rows of the fixture file, and does not
+	// need the databases in MySQL
+	if config.Configuration().DataAccess.Backend != config.BACKEND_MEMORY {
+		common.CreateDatabases(t, dbs...)
+		defer common.DropDatabases(t, dbs...)
+	}
 
 	routerCtx := server.CreateRouterContext()
 
@@ -469,6 +567,21 @@ func WithDBs(t testing.TB, dbs []string, handlers *handlers.AllHandlers,
 	}
 }
 
+// WithMemoryBackend is WithDBs using the memory backend loaded with the
+// fixture file. RonDB and MySQL are not used, so the tests also run without
+// cgo
+func WithMemoryBackend(t testing.TB, fixtureFile string, handlers *handlers.AllHandlers,
+	fn func(tc common.TestContext)) {
+	t.Helper()
+
+	dataAccess := config.Configuration().DataAccess
+	config.Configuration().DataAccess = config.DataAccess{Backend: config.BACKEND_MEMORY,
+		FixtureFile: fixtureFile}
+	defer func() { config.Configuration().DataAccess = dataAccess }()
+
+	WithDBs(t, nil, handlers, fn)
+}
+
 func shutDownRouter(t testing.TB, router server.Router) error {
 	t.Helper()
 	return router.StopRouter()
@@ -489,14 +602,14 @@ func PkTest(t *testing.T, tests map[string]api.PKTestInfo, isBinaryData bool, ha
 }
 
 func pkGRPCTest(t *testing.T, testInfo api.PKTestInfo, tc common.TestContext, isBinaryData bool) {
-	respCode, resp := sendGRPCPKReadRequest(t, testInfo)
+	respCode, resp := SendGRPCPKReadRequest(t, testInfo)
 
 	if respCode == http.StatusOK {
 		ValidateResGRPC(t, testInfo, resp, isBinaryData)
 	}
 }
 
-func sendGRPCPKReadRequest(t *testing.T, testInfo api.PKTestInfo) (int, *api.PKReadResponseGRPC) {
+func SendGRPCPKReadRequest(t *testing.T, testInfo api.PKTestInfo) (int, *api.PKReadResponseGRPC) {
 	// Create gRPC client
 	conn, err := grpc.Dial(fmt.Sprintf("%s:%d",
 		config.Configuration().RestServer.GRPCServerIP,
@@ -545,6 +658,239 @@ func sendGRPCPKReadRequest(t *testing.T, testInfo api.PKTestInfo) (int, *api.PKR
 	}
 }
 
+func SendGRPCPKWriteRequest(t *testing.T, pkWriteParams *api.PKWriteParams,
+	expectedStatus int, expectedErrMsg string) (int, *api.PKWriteResponseGRPC) {
+	// Create gRPC client
+	conn, err := grpc.Dial(fmt.Sprintf("%s:%d",
+		config.Configuration().RestServer.GRPCServerIP,
+		config.Configuration().RestServer.GRPCServerPort),
+		grpc.WithInsecure())
+	defer conn.Close()
+
+	if err != nil {
+		t.Fatalf("Failed to connect to server %v", err)
+	}
+	client := api.NewRonDBRESTClient(conn)
+
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+	reqProto := api.ConvertPKWriteParams(pkWriteParams, &apiKey)
+
+	respCode := 200
+	var errStr string
+	var respProto *api.PKWriteResponseProto
+	if *pkWriteParams.Operation == api.PK_DELETE {
+		var delRespProto *api.PKDeleteResponseProto
+		delRespProto, err = client.PKDelete(context.Background(),
+			api.ConvertPKDeleteParams(pkWriteParams, &apiKey))
+		if err == nil {
+			respProto = &api.PKWriteResponseProto{OperationID: delRespProto.OperationID}
+		}
+	} else {
+		respProto, err = client.PKWrite(context.Background(), reqProto)
+	}
+	if err != nil {
+		respCode = GetStatusCodeFromError(t, err)
+		errStr = fmt.Sprintf("%v", err)
+	}
+
+	if respCode != expectedStatus {
+		t.Fatalf("Test failed. Expected: %d, Got: %d. Complete Error Message: %v ", expectedStatus, respCode, errStr)
+	}
+
+	if respCode != http.StatusOK && !strings.Contains(errStr, expectedErrMsg) {
+		t.Fatalf("Test failed. Error does not contain string: %s. Complete Error Message: %s", expectedErrMsg, errStr)
+	}
+
+	if respCode == http.StatusOK {
+		return respCode, api.ConvertPKWriteResponseProto(respProto)
+	} else {
+		return respCode, nil
+	}
+}
+
+func SendGRPCIndexScanRequest(t *testing.T, params *api.IndexScanParams,
+	expectedStatus int, expectedErrMsg string) (int, *api.ScanResponseGRPC) {
+	return sendGRPCScanRequest(t, expectedStatus, expectedErrMsg,
+		func(client api.RonDBRESTClient, apiKey *string) (scanStream, error) {
+			return client.IndexScan(context.Background(), api.ConvertIndexScanParams(params, apiKey))
+		})
+}
+
+func SendGRPCTableScanRequest(t *testing.T, params *api.TableScanParams,
+	expectedStatus int, expectedErrMsg string) (int, *api.ScanResponseGRPC) {
+	return sendGRPCScanRequest(t, expectedStatus, expectedErrMsg,
+		func(client api.RonDBRESTClient, apiKey *string) (scanStream, error) {
+			return client.TableScan(context.Background(), api.ConvertTableScanParams(params, apiKey))
+		})
+}
+
+func SendGRPCTableSchemaRequest(t *testing.T, params *api.TableSchemaParams,
+	expectedStatus int, expectedErrMsg string) (int, *api.TableSchemaResponse) {
+	// Create gRPC client
+	conn, err := grpc.Dial(fmt.Sprintf("%s:%d",
+		config.Configuration().RestServer.GRPCServerIP,
+		config.Configuration().RestServer.GRPCServerPort),
+		grpc.WithInsecure())
+	defer conn.Close()
+
+	if err != nil {
+		t.Fatalf("Failed to connect to server %v", err)
+	}
+	client := api.NewRonDBRESTClient(conn)
+
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+	respCode := 200
+	var errStr string
+	respProto, err := client.TableSchema(context.Background(),
+		api.ConvertTableSchemaParams(params, &apiKey))
+	if err != nil {
+		respCode = GetStatusCodeFromError(t, err)
+		errStr = fmt.Sprintf("%v", err)
+	}
+
+	if respCode != expectedStatus {
+		t.Fatalf("Test failed. Expected: %d, Got: %d. Complete Error Message: %v ", expectedStatus, respCode, errStr)
+	}
+
+	if respCode != http.StatusOK && !strings.Contains(errStr, expectedErrMsg) {
+		t.Fatalf("Test failed. Error does not contain string: %s. Complete Error Message: %s", expectedErrMsg, errStr)
+	}
+
+	if respCode == http.StatusOK {
+		return respCode, api.ConvertTableSchemaResponseProto(respProto)
+	} else {
+		return respCode, nil
+	}
+}
+
+func SendGRPCDatabasesRequest(t *testing.T, expectedStatus int,
+	expectedErrMsg string) (int, *api.DatabasesResponse) {
+	// Create gRPC client
+	conn, err := grpc.Dial(fmt.Sprintf("%s:%d",
+		config.Configuration().RestServer.GRPCServerIP,
+		config.Configuration().RestServer.GRPCServerPort),
+		grpc.WithInsecure())
+	defer conn.Close()
+
+	if err != nil {
+		t.Fatalf("Failed to connect to server %v", err)
+	}
+	client := api.NewRonDBRESTClient(conn)
+
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+	respCode := 200
+	var errStr string
+	respProto, err := client.Databases(context.Background(), api.ConvertDatabasesRequest(&apiKey))
+	if err != nil {
+		respCode = GetStatusCodeFromError(t, err)
+		errStr = fmt.Sprintf("%v", err)
+	}
+
+	if respCode != expectedStatus {
+		t.Fatalf("Test failed. Expected: %d, Got: %d. Complete Error Message: %v ", expectedStatus, respCode, errStr)
+	}
+
+	if respCode != http.StatusOK && !strings.Contains(errStr, expectedErrMsg) {
+		t.Fatalf("Test failed. Error does not contain string: %s. Complete Error Message: %s", expectedErrMsg, errStr)
+	}
+
+	if respCode == http.StatusOK {
+		return respCode, api.ConvertDatabasesResponseProto(respProto)
+	} else {
+		return respCode, nil
+	}
+}
+
+func SendGRPCTablesRequest(t *testing.T, params *api.TablesParams, expectedStatus int,
+	expectedErrMsg string) (int, *api.TablesResponse) {
+	// Create gRPC client
+	conn, err := grpc.Dial(fmt.Sprintf("%s:%d",
+		config.Configuration().RestServer.GRPCServerIP,
+		config.Configuration().RestServer.GRPCServerPort),
+		grpc.WithInsecure())
+	defer conn.Close()
+
+	if err != nil {
+		t.Fatalf("Failed to connect to server %v", err)
+	}
+	client := api.NewRonDBRESTClient(conn)
+
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+	respCode := 200
+	var errStr string
+	respProto, err := client.Tables(context.Background(),
+		api.ConvertTablesParams(params, &apiKey))
+	if err != nil {
+		respCode = GetStatusCodeFromError(t, err)
+		errStr = fmt.Sprintf("%v", err)
+	}
+
+	if respCode != expectedStatus {
+		t.Fatalf("Test failed. Expected: %d, Got: %d. Complete Error Message: %v ", expectedStatus, respCode, errStr)
+	}
+
+	if respCode != http.StatusOK && !strings.Contains(errStr, expectedErrMsg) {
+		t.Fatalf("Test failed. Error does not contain string: %s. Complete Error Message: %s", expectedErrMsg, errStr)
+	}
+
+	if respCode == http.StatusOK {
+		return respCode, api.ConvertTablesResponseProto(respProto)
+	} else {
+		return respCode, nil
+	}
+}
+
+type scanStream interface {
+	Recv() (*api.ScanResponseProto, error)
+}
+
+// sendGRPCScanRequest receives all the messages of a streamed scan
+func sendGRPCScanRequest(t *testing.T, expectedStatus int, expectedErrMsg string,
+	scan func(client api.RonDBRESTClient, apiKey *string) (scanStream, error)) (int, *api.ScanResponseGRPC) {
+	// Create gRPC client
+	conn, err := grpc.Dial(fmt.Sprintf("%s:%d",
+		config.Configuration().RestServer.GRPCServerIP,
+		config.Configuration().RestServer.GRPCServerPort),
+		grpc.WithInsecure())
+	defer conn.Close()
+
+	if err != nil {
+		t.Fatalf("Failed to connect to server %v", err)
+	}
+	client := api.NewRonDBRESTClient(conn)
+
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+	respCode := 200
+	var errStr string
+	var respProtos []*api.ScanResponseProto
+	stream, err := scan(client, &apiKey)
+	for err == nil {
+		var respProto *api.ScanResponseProto
+		respProto, err = stream.Recv()
+		if err == nil {
+			respProtos = append(respProtos, respProto)
+		}
+	}
+	if err != io.EOF {
+		respCode = GetStatusCodeFromError(t, err)
+		errStr = fmt.Sprintf("%v", err)
+	}
+
+	if respCode != expectedStatus {
+		t.Fatalf("Test failed. Expected: %d, Got: %d. Complete Error Message: %v ", expectedStatus, respCode, errStr)
+	}
+
+	if respCode != http.StatusOK && !strings.Contains(errStr, expectedErrMsg) {
+		t.Fatalf("Test failed. Error does not contain string: %s. Complete Error Message: %s", expectedErrMsg, errStr)
+	}
+
+	if respCode == http.StatusOK {
+		return respCode, api.ConvertScanResponseProto(respProtos)
+	} else {
+		return respCode, nil
+	}
+}
+
 func GetStatusCodeFromError(t *testing.T, errGot error) int {
 	errStr := fmt.Sprintf("%v", errGot)
 	// error code is sandwiched b/w these two substrings
@@ -665,6 +1011,92 @@ func sendGRPCBatchRequest(t *testing.T, testInfo api.BatchOperationTestInfo) (in
 	}
 }
 
+func SendGRPCBatchTxRequest(t *testing.T, operations []*api.BatchTxSubOpParams,
+	expectedStatus int, expectedErrMsg string) (int, *api.BatchResponseGRPC) {
+	// Create gRPC client
+	conn, err := grpc.Dial(fmt.Sprintf("%s:%d",
+		config.Configuration().RestServer.GRPCServerIP,
+		config.Configuration().RestServer.GRPCServerPort),
+		grpc.WithInsecure())
+	defer conn.Close()
+
+	if err != nil {
+		t.Fatalf("Failed to connect to server %v", err)
+	}
+	client := api.NewRonDBRESTClient(conn)
+
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+	reqProto := api.ConvertBatchTxOpRequest(operations, &apiKey)
+
+	respCode := 200
+	var errStr string
+	respProto, err := client.BatchTx(context.Background(), reqProto)
+	if err != nil {
+		respCode = GetStatusCodeFromError(t, err)
+		errStr = fmt.Sprintf("%v", err)
+	}
+
+	if respCode != expectedStatus {
+		t.Fatalf("Test failed. Expected: %d, Got: %d. Complete Error Message: %v ", expectedStatus, respCode, errStr)
+	}
+
+	if respCode != http.StatusOK && !strings.Contains(errStr, expectedErrMsg) {
+		t.Fatalf("Test failed. Error does not contain string: %s. Complete Error Message: %s", expectedErrMsg, errStr)
+	}
+
+	if respCode == http.StatusOK {
+		return respCode, api.ConvertBatchResponseProto(respProto)
+	}
+
+	// rolled back transactions return the codes of the sub operations in the
+	// error details
+	for _, detail := range status.Convert(err).Details() {
+		if respProto, ok := detail.(*api.BatchResponseProto); ok {
+			return respCode, api.ConvertBatchResponseProto(respProto)
+		}
+	}
+	return respCode, nil
+}
+
+func SendGRPCFeatureVectorRequest(t *testing.T, params *api.FeatureVectorParams,
+	expectedStatus int, expectedErrMsg string) (int, *api.FeatureVectorResponseGRPC) {
+	// Create gRPC client
+	conn, err := grpc.Dial(fmt.Sprintf("%s:%d",
+		config.Configuration().RestServer.GRPCServerIP,
+		config.Configuration().RestServer.GRPCServerPort),
+		grpc.WithInsecure())
+	defer conn.Close()
+
+	if err != nil {
+		t.Fatalf("Failed to connect to server %v", err)
+	}
+	client := api.NewRonDBRESTClient(conn)
+
+	apiKey := common.HOPSWORKS_TEST_API_KEY
+	respCode := 200
+	var errStr string
+	respProto, err := client.FeatureVector(context.Background(),
+		api.ConvertFeatureVectorParams(params, &apiKey))
+	if err != nil {
+		respCode = GetStatusCodeFromError(t, err)
+		errStr = fmt.Sprintf("%v", err)
+	}
+
+	if respCode != expectedStatus {
+		t.Fatalf("Test failed. Expected: %d, Got: %d. Complete Error Message: %v ", expectedStatus, respCode, errStr)
+	}
+
+	if respCode != http.StatusOK && !strings.Contains(errStr, expectedErrMsg) {
+		t.Fatalf("Test failed. Error does not contain string: %s. Complete Error Message: %s", expectedErrMsg, errStr)
+	}
+
+	if respCode == http.StatusOK {
+		return respCode, api.ConvertFeatureVectorResponseProto(respProto)
+	} else {
+		return respCode, nil
+	}
+}
+
 func batchRESTTest(t *testing.T, testInfo api.BatchOperationTestInfo, tc common.TestContext, isBinaryData bool) {
 	//batch operation
 	subOps := []api.BatchSubOp{}
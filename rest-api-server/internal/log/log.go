@@ -38,8 +38,6 @@ func InitLogger(logConfig LogConfig) {
 	} else {
 		log.SetOutput(os.Stdout)
 	}
-
-	RegisterLogCallBack()
 }
 
 func SetLevel(levelStr string) {
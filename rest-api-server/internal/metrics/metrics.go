@@ -0,0 +1,295 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package metrics collects request metrics and exposes them, together with
+// the native buffer and Ndb object pool stats, in the Prometheus text format
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/log"
+)
+
+const (
+	REST_TRANSPORT = "rest"
+	GRPC_TRANSPORT = "grpc"
+
+	CONTENT_TYPE = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// upper bounds of the latency histogram buckets in seconds
+var latencyBuckets = []float64{0.0001, 0.00025, 0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025,
+	0.05, 0.1, 0.25, 0.5, 1, 2.5, 5}
+
+type opKey struct {
+	operation string
+	transport string
+}
+
+type errorKey struct {
+	opKey
+	code int
+}
+
+// histogram is updated with atomic operations only. The last bucket is +Inf
+type histogram struct {
+	bounds   []float64
+	buckets  []uint64
+	count    uint64
+	sumNanos uint64
+}
+
+func newHistogram(bounds []float64) *histogram {
+	return &histogram{bounds: bounds, buckets: make([]uint64, len(bounds)+1)}
+}
+
+func (h *histogram) observe(duration time.Duration) {
+	seconds := duration.Seconds()
+	i := sort.SearchFloat64s(h.bounds, seconds)
+	atomic.AddUint64(&h.buckets[i], 1)
+	atomic.AddUint64(&h.sumNanos, uint64(duration.Nanoseconds()))
+	atomic.AddUint64(&h.count, 1)
+}
+
+// quantile estimates the q-quantile in seconds by interpolating inside the
+// bucket of the quantile. Values in the +Inf bucket are reported as the
+// largest bound
+func (h *histogram) quantile(q float64) float64 {
+	counts := make([]uint64, len(h.buckets))
+	var total uint64 = 0
+	for i := range h.buckets {
+		counts[i] = atomic.LoadUint64(&h.buckets[i])
+		total += counts[i]
+	}
+	if total == 0 {
+		return 0
+	}
+
+	rank := q * float64(total)
+	var cumulative uint64 = 0
+	for i, count := range counts {
+		if count == 0 || float64(cumulative+count) < rank {
+			cumulative += count
+			continue
+		}
+		if i == len(h.bounds) {
+			break
+		}
+		lower := 0.0
+		if i > 0 {
+			lower = h.bounds[i-1]
+		}
+		return lower + (h.bounds[i]-lower)*(rank-float64(cumulative))/float64(count)
+	}
+	return h.bounds[len(h.bounds)-1]
+}
+
+// metrics are created on the first request of an operation. After that the
+// hot path only loads the existing entries, which does not take a lock
+var latencies sync.Map   // opKey -> *histogram
+var errorCounts sync.Map // errorKey -> *uint64
+
+// ObserveRequest records the latency of a request, and counts it as an error
+// if the status code is not 2xx
+func ObserveRequest(operation, transport string, code int, duration time.Duration) {
+	key := opKey{operation: operation, transport: transport}
+	h, ok := latencies.Load(key)
+	if !ok {
+		h, _ = latencies.LoadOrStore(key, newHistogram(latencyBuckets))
+	}
+	h.(*histogram).observe(duration)
+
+	if code < http.StatusOK || code >= http.StatusMultipleChoices {
+		errKey := errorKey{opKey: key, code: code}
+		c, ok := errorCounts.Load(errKey)
+		if !ok {
+			c, _ = errorCounts.LoadOrStore(errKey, new(uint64))
+		}
+		atomic.AddUint64(c.(*uint64), 1)
+	}
+}
+
+// Reset removes all request metrics
+func Reset() {
+	latencies.Range(func(key, _ interface{}) bool {
+		latencies.Delete(key)
+		return true
+	})
+	errorCounts.Range(func(key, _ interface{}) bool {
+		errorCounts.Delete(key)
+		return true
+	})
+	resetTableStats()
+}
+
+// GinMiddleware records the requests of the registered REST endpoints.
+// The operation is the last element of the route, e.g., pk-read.
+// The skipPaths, e.g., the metrics endpoint, are not recorded
+func GinMiddleware(skipPaths ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			c.Next()
+			return
+		}
+		for _, skipPath := range skipPaths {
+			if route == skipPath {
+				c.Next()
+				return
+			}
+		}
+
+		start := time.Now()
+		c.Next()
+		ObserveRequest(path.Base(route), REST_TRANSPORT, c.Writer.Status(), time.Since(start))
+	}
+}
+
+// HttpHandler serves the metrics in the Prometheus text format
+func HttpHandler(c *gin.Context) {
+	c.Header("Content-Type", CONTENT_TYPE)
+	c.Status(http.StatusOK)
+	if err := WriteMetrics(c.Writer); err != nil {
+		log.Warnf("Failed to write metrics. Error: %v", err)
+	}
+}
+
+// WriteMetrics writes all the metrics in the Prometheus text format
+func WriteMetrics(out io.Writer) error {
+	w := bufio.NewWriter(out)
+	writeRequestMetrics(w)
+	writePoolMetrics(w)
+	return w.Flush()
+}
+
+func writeRequestMetrics(w io.Writer) {
+	keys := []opKey{}
+	latencies.Range(func(key, _ interface{}) bool {
+		keys = append(keys, key.(opKey))
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool { return keys[i].labels() < keys[j].labels() })
+
+	errKeys := []errorKey{}
+	errorCounts.Range(func(key, _ interface{}) bool {
+		errKeys = append(errKeys, key.(errorKey))
+		return true
+	})
+	sort.Slice(errKeys, func(i, j int) bool { return errKeys[i].labels() < errKeys[j].labels() })
+
+	writeHeader(w, "rdrs_requests_total", "counter", "Number of requests.")
+	for _, key := range keys {
+		h := loadHistogram(key)
+		fmt.Fprintf(w, "rdrs_requests_total{%s} %d\n", key.labels(), atomic.LoadUint64(&h.count))
+	}
+
+	writeHeader(w, "rdrs_request_errors_total", "counter", "Number of failed requests by status code.")
+	for _, key := range errKeys {
+		c, _ := errorCounts.Load(key)
+		fmt.Fprintf(w, "rdrs_request_errors_total{%s} %d\n", key.labels(), atomic.LoadUint64(c.(*uint64)))
+	}
+
+	writeHeader(w, "rdrs_request_duration_seconds", "histogram", "Request latency in seconds.")
+	for _, key := range keys {
+		h := loadHistogram(key)
+
+		// the count is the +Inf bucket so that they match while requests are observed
+		var cumulative uint64 = 0
+		for i, bound := range latencyBuckets {
+			cumulative += atomic.LoadUint64(&h.buckets[i])
+			fmt.Fprintf(w, "rdrs_request_duration_seconds_bucket{%s,le=\"%s\"} %d\n", key.labels(),
+				formatFloat(bound), cumulative)
+		}
+		cumulative += atomic.LoadUint64(&h.buckets[len(latencyBuckets)])
+		fmt.Fprintf(w, "rdrs_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", key.labels(),
+			cumulative)
+		fmt.Fprintf(w, "rdrs_request_duration_seconds_sum{%s} %s\n", key.labels(),
+			formatFloat(float64(atomic.LoadUint64(&h.sumNanos))/float64(time.Second)))
+		fmt.Fprintf(w, "rdrs_request_duration_seconds_count{%s} %d\n", key.labels(), cumulative)
+	}
+}
+
+func writePoolMetrics(w io.Writer) {
+	buffers := dal.GetNativeBuffersStats()
+	writeGauge(w, "rdrs_native_buffers", "Number of allocated native buffers.", buffers.BuffersCount)
+	writeGauge(w, "rdrs_native_buffers_free", "Number of free native buffers.", buffers.FreeBuffers)
+	writeCounter(w, "rdrs_native_buffer_allocations_total", "Number of native buffer allocations.",
+		buffers.AllocationsCount)
+	writeCounter(w, "rdrs_native_buffer_deallocations_total", "Number of native buffer deallocations.",
+		buffers.DeallocationsCount)
+
+	// the Ndb object stats are not available if RonDB is not connected
+	rondb, err := dal.GetDataAccess().GetRonDBStats()
+	if err != nil {
+		return
+	}
+	writeGauge(w, "rdrs_ndb_objects", "Number of Ndb objects.", rondb.NdbObjectsTotalCount)
+	writeGauge(w, "rdrs_ndb_objects_free", "Number of free Ndb objects.", rondb.NdbObjectsFreeCount)
+	writeCounter(w, "rdrs_ndb_objects_created_total", "Number of created Ndb objects.",
+		rondb.NdbObjectsCreationCount)
+	writeCounter(w, "rdrs_ndb_objects_deleted_total", "Number of deleted Ndb objects.",
+		rondb.NdbObjectsDeletionCount)
+	writeCounter(w, "rdrs_ndb_operation_retries_total",
+		"Number of retries of operations that failed with temporary errors.", rondb.NdbOpRetries)
+	writeCounter(w, "rdrs_ndb_operation_retries_exhausted_total",
+		"Number of operations that failed with temporary errors after all the retries.",
+		rondb.NdbOpRetriesExhausted)
+}
+
+func loadHistogram(key opKey) *histogram {
+	h, _ := latencies.Load(key)
+	return h.(*histogram)
+}
+
+func (k opKey) labels() string {
+	return fmt.Sprintf("operation=%s,transport=%s", strconv.Quote(k.operation),
+		strconv.Quote(k.transport))
+}
+
+func (k errorKey) labels() string {
+	return fmt.Sprintf("%s,code=\"%d\"", k.opKey.labels(), k.code)
+}
+
+func writeHeader(w io.Writer, name, metricType, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+func writeGauge(w io.Writer, name, help string, value int64) {
+	writeHeader(w, name, "gauge", help)
+	fmt.Fprintf(w, "%s %d\n", name, value)
+}
+
+func writeCounter(w io.Writer, name, help string, value int64) {
+	writeHeader(w, name, "counter", help)
+	fmt.Fprintf(w, "%s %d\n", name, value)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
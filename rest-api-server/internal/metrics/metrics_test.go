@@ -0,0 +1,64 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package metrics
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequestMetrics(t *testing.T) {
+	Reset()
+	defer Reset()
+
+	ObserveRequest("pk-read", REST_TRANSPORT, http.StatusOK, 200*time.Microsecond)
+	ObserveRequest("pk-read", REST_TRANSPORT, http.StatusNotFound, 2*time.Millisecond)
+	ObserveRequest("pk-read", REST_TRANSPORT, http.StatusNotFound, 10*time.Second)
+	ObserveRequest("batch", GRPC_TRANSPORT, http.StatusOK, time.Millisecond)
+
+	var out bytes.Buffer
+	writeRequestMetrics(&out)
+	metrics := out.String()
+
+	expected := []string{
+		"# TYPE rdrs_requests_total counter\n",
+		"rdrs_requests_total{operation=\"pk-read\",transport=\"rest\"} 3\n",
+		"rdrs_requests_total{operation=\"batch\",transport=\"grpc\"} 1\n",
+		"rdrs_request_errors_total{operation=\"pk-read\",transport=\"rest\",code=\"404\"} 2\n",
+		"# TYPE rdrs_request_duration_seconds histogram\n",
+		"rdrs_request_duration_seconds_bucket{operation=\"pk-read\",transport=\"rest\",le=\"0.00025\"} 1\n",
+		"rdrs_request_duration_seconds_bucket{operation=\"pk-read\",transport=\"rest\",le=\"0.0025\"} 2\n",
+		"rdrs_request_duration_seconds_bucket{operation=\"pk-read\",transport=\"rest\",le=\"5\"} 2\n",
+		"rdrs_request_duration_seconds_bucket{operation=\"pk-read\",transport=\"rest\",le=\"+Inf\"} 3\n",
+		"rdrs_request_duration_seconds_sum{operation=\"pk-read\",transport=\"rest\"} 10.0022\n",
+		"rdrs_request_duration_seconds_count{operation=\"pk-read\",transport=\"rest\"} 3\n",
+		"rdrs_request_duration_seconds_bucket{operation=\"batch\",transport=\"grpc\",le=\"0.001\"} 1\n",
+	}
+	for _, line := range expected {
+		if !strings.Contains(metrics, line) {
+			t.Fatalf("Metrics do not contain %q. Got: %s", line, metrics)
+		}
+	}
+
+	if strings.Contains(metrics, "transport=\"grpc\",code=") {
+		t.Fatalf("Successful requests must not be counted as errors. Got: %s", metrics)
+	}
+}
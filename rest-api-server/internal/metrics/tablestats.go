@@ -0,0 +1,195 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package metrics
+
+import (
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// stats are not collected for new tables after this many tables, so that
+// requests for random table names can not use up the memory
+const MAX_TABLE_STATS = 10000
+
+// exponential buckets from 10 us to about 60 s. The p50 and p99 latencies are
+// interpolated inside these buckets
+var tableLatencyBuckets = func() []float64 {
+	bounds := []float64{}
+	for bound := 0.00001; bound < 60; bound *= 1.25 {
+		bounds = append(bounds, bound)
+	}
+	return bounds
+}()
+
+type ReadStats struct {
+	Reads         int64
+	Found         int64
+	NotFound      int64
+	Errors        int64
+	BytesReturned int64
+	P50LatencyUs  int64
+	P99LatencyUs  int64
+}
+
+type TableStats struct {
+	Table string
+	ReadStats
+}
+
+type DBStats struct {
+	DB string
+	ReadStats
+	Tables []TableStats
+}
+
+type tableKey struct {
+	db    string
+	table string
+}
+
+type tableCounters struct {
+	found         uint64
+	notFound      uint64
+	errors        uint64
+	bytesReturned uint64
+	latency       *histogram
+}
+
+var tables sync.Map // tableKey -> *tableCounters
+var tableCount int64
+
+// ObserveTableRead records a read of a table. Reads with status 200 are
+// found, 404 are not found and the server side status codes are errors. The
+// bytes are the size of the data returned by RonDB.
+//
+// Reads rejected with any other client error, e.g., because the table does
+// not exist (ERROR_011) or the request is not authorized, are not recorded,
+// so that made up table names can not use up the MAX_TABLE_STATS entries
+func ObserveTableRead(db, table string, code int, bytes uint32, duration time.Duration) {
+	if code >= http.StatusBadRequest && code < http.StatusInternalServerError &&
+		code != http.StatusNotFound {
+		return
+	}
+
+	key := tableKey{db: db, table: table}
+	c, ok := tables.Load(key)
+	if !ok {
+		if atomic.LoadInt64(&tableCount) >= MAX_TABLE_STATS {
+			return
+		}
+		var loaded bool
+		c, loaded = tables.LoadOrStore(key,
+			&tableCounters{latency: newHistogram(tableLatencyBuckets)})
+		if !loaded {
+			atomic.AddInt64(&tableCount, 1)
+		}
+	}
+
+	counters := c.(*tableCounters)
+	switch code {
+	case http.StatusOK:
+		atomic.AddUint64(&counters.found, 1)
+		atomic.AddUint64(&counters.bytesReturned, uint64(bytes))
+	case http.StatusNotFound:
+		atomic.AddUint64(&counters.notFound, 1)
+	default:
+		atomic.AddUint64(&counters.errors, 1)
+	}
+	counters.latency.observe(duration)
+}
+
+// GetDBStats returns the read stats of the databases and their tables,
+// sorted by name. The stats of a database are the sum of its tables
+func GetDBStats() []DBStats {
+	byDB := map[string]*DBStats{}
+	dbLatencies := map[string]*histogram{}
+	tables.Range(func(k, c interface{}) bool {
+		key := k.(tableKey)
+		counters := c.(*tableCounters)
+
+		dbStats, ok := byDB[key.db]
+		if !ok {
+			dbStats = &DBStats{DB: key.db, Tables: []TableStats{}}
+			byDB[key.db] = dbStats
+			dbLatencies[key.db] = newHistogram(tableLatencyBuckets)
+		}
+
+		tableStats := TableStats{Table: key.table, ReadStats: counters.readStats()}
+		dbStats.Tables = append(dbStats.Tables, tableStats)
+		dbStats.add(&tableStats.ReadStats)
+		dbLatencies[key.db].merge(counters.latency)
+		return true
+	})
+
+	dbs := make([]DBStats, 0, len(byDB))
+	for db, dbStats := range byDB {
+		dbStats.P50LatencyUs = toMicros(dbLatencies[db].quantile(0.5))
+		dbStats.P99LatencyUs = toMicros(dbLatencies[db].quantile(0.99))
+		sort.Slice(dbStats.Tables, func(i, j int) bool {
+			return dbStats.Tables[i].Table < dbStats.Tables[j].Table
+		})
+		dbs = append(dbs, *dbStats)
+	}
+	sort.Slice(dbs, func(i, j int) bool { return dbs[i].DB < dbs[j].DB })
+	return dbs
+}
+
+func (c *tableCounters) readStats() ReadStats {
+	stats := ReadStats{
+		Found:         int64(atomic.LoadUint64(&c.found)),
+		NotFound:      int64(atomic.LoadUint64(&c.notFound)),
+		Errors:        int64(atomic.LoadUint64(&c.errors)),
+		BytesReturned: int64(atomic.LoadUint64(&c.bytesReturned)),
+		P50LatencyUs:  toMicros(c.latency.quantile(0.5)),
+		P99LatencyUs:  toMicros(c.latency.quantile(0.99)),
+	}
+	stats.Reads = stats.Found + stats.NotFound + stats.Errors
+	return stats
+}
+
+func (s *ReadStats) add(other *ReadStats) {
+	s.Reads += other.Reads
+	s.Found += other.Found
+	s.NotFound += other.NotFound
+	s.Errors += other.Errors
+	s.BytesReturned += other.BytesReturned
+}
+
+// merge adds the buckets of other. It is only used on histograms that are
+// not shared
+func (h *histogram) merge(other *histogram) {
+	for i := range other.buckets {
+		h.buckets[i] += atomic.LoadUint64(&other.buckets[i])
+	}
+}
+
+func toMicros(seconds float64) int64 {
+	return int64(math.Round(seconds * 1e6))
+}
+
+func resetTableStats() {
+	tables.Range(func(key, _ interface{}) bool {
+		tables.Delete(key)
+		return true
+	})
+	atomic.StoreInt64(&tableCount, 0)
+}
@@ -0,0 +1,88 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTableStats(t *testing.T) {
+	Reset()
+	defer Reset()
+
+	for i := 0; i < 98; i++ {
+		ObserveTableRead("db1", "t1", http.StatusOK, 10, time.Millisecond)
+	}
+	ObserveTableRead("db1", "t1", http.StatusNotFound, 0, time.Millisecond)
+	ObserveTableRead("db1", "t1", http.StatusInternalServerError, 0, time.Second)
+	ObserveTableRead("db1", "t0", http.StatusOK, 5, time.Millisecond)
+	ObserveTableRead("db0", "t0", http.StatusNotFound, 0, time.Millisecond)
+	ObserveTableRead("db0", "missing", http.StatusBadRequest, 0, time.Millisecond)
+	ObserveTableRead("db2", "t0", http.StatusUnauthorized, 0, time.Millisecond)
+
+	dbs := GetDBStats()
+	if len(dbs) != 2 || dbs[0].DB != "db0" || dbs[1].DB != "db1" {
+		t.Fatalf("Wrong databases. Got: %#v", dbs)
+	}
+
+	// rejected reads are not recorded
+	if len(dbs[0].Tables) != 1 || dbs[0].Reads != 1 {
+		t.Fatalf("Rejected reads should not be recorded. Got: %#v", dbs[0])
+	}
+
+	db1 := dbs[1]
+	if len(db1.Tables) != 2 || db1.Tables[0].Table != "t0" || db1.Tables[1].Table != "t1" {
+		t.Fatalf("Wrong tables. Got: %#v", db1.Tables)
+	}
+
+	t1 := db1.Tables[1].ReadStats
+	if t1.Reads != 100 || t1.Found != 98 || t1.NotFound != 1 || t1.Errors != 1 ||
+		t1.BytesReturned != 980 {
+		t.Fatalf("Wrong table counters. Got: %#v", t1)
+	}
+
+	// the latencies are interpolated inside buckets that are 25% wide
+	if t1.P50LatencyUs < 800 || t1.P50LatencyUs > 1000 {
+		t.Fatalf("Wrong p50 latency. Got: %d", t1.P50LatencyUs)
+	}
+	if t1.P99LatencyUs < 800 || t1.P99LatencyUs > 1100 {
+		t.Fatalf("Wrong p99 latency. Got: %d", t1.P99LatencyUs)
+	}
+
+	if db1.Reads != 101 || db1.Found != 99 || db1.NotFound != 1 || db1.Errors != 1 ||
+		db1.BytesReturned != 985 {
+		t.Fatalf("Wrong database counters. Got: %#v", db1.ReadStats)
+	}
+}
+
+func TestTableStatsLimit(t *testing.T) {
+	Reset()
+	defer Reset()
+
+	for i := 0; i < MAX_TABLE_STATS+10; i++ {
+		ObserveTableRead("db", fmt.Sprintf("t%d", i), http.StatusOK, 0, time.Millisecond)
+	}
+
+	dbs := GetDBStats()
+	if len(dbs) != 1 || len(dbs[0].Tables) != MAX_TABLE_STATS {
+		t.Fatalf("Expected stats for %d tables", MAX_TABLE_STATS)
+	}
+}
@@ -104,7 +104,7 @@ func GetUserDatabases(apiKey *string) ([]string, error) {
 	prefix := splits[0]
 	secret := splits[1]
 
-	key, err := dal.GetAPIKey(prefix)
+	key, err := dal.GetDataAccess().GetAPIKey(prefix)
 	if err != nil {
 		return []string{}, err
 	}
@@ -116,7 +116,7 @@ func GetUserDatabases(apiKey *string) ([]string, error) {
 		return []string{}, fmt.Errorf("Wrong API Key")
 	}
 
-	dbs, err := dal.GetUserProjects(key.UserID)
+	dbs, err := dal.GetDataAccess().GetUserProjects(key.UserID)
 	if err != nil {
 		return dbs, err
 	}
@@ -18,10 +18,18 @@ package grpcsrv
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"strconv"
 
 	"fmt"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/dal"
 	"hopsworks.ai/rdrs/internal/handlers"
 	"hopsworks.ai/rdrs/pkg/api"
 )
@@ -54,7 +62,7 @@ func (s *GRPCServer) PKRead(c context.Context, reqProto *api.PKReadRequestProto)
 	var response api.PKReadResponse = (api.PKReadResponse)(&api.PKReadResponseGRPC{})
 	response.Init()
 
-	status, err := s.allHandlers.PKReader.PkReadHandler(req, &apiKey, response)
+	status, err := s.allHandlers.PKReader.PkReadHandler(c, req, &apiKey, response)
 	if err != nil {
 		return nil, mkError(status, err)
 	}
@@ -67,6 +75,54 @@ func (s *GRPCServer) PKRead(c context.Context, reqProto *api.PKReadRequestProto)
 	return respProto, nil
 }
 
+func (s *GRPCServer) PKWrite(c context.Context, reqProto *api.PKWriteRequestProto) (*api.PKWriteResponseProto, error) {
+
+	if s.allHandlers == nil || s.allHandlers.PKWriter == nil {
+		return nil, fmt.Errorf("PKWrite handler is not registered")
+	}
+
+	req, apiKey := api.ConvertPKWriteRequestProto(reqProto)
+
+	var response api.PKWriteResponse = (api.PKWriteResponse)(&api.PKWriteResponseGRPC{})
+	response.Init()
+
+	status, err := s.allHandlers.PKWriter.PkWriteHandler(c, req, &apiKey, response)
+	if err != nil {
+		return nil, mkError(status, err)
+	}
+
+	if status != http.StatusOK {
+		return nil, mkError(status, nil)
+	}
+
+	respProto := api.ConvertPKWriteResponse(response.(*api.PKWriteResponseGRPC))
+	return respProto, nil
+}
+
+func (s *GRPCServer) PKDelete(c context.Context, reqProto *api.PKDeleteRequestProto) (*api.PKDeleteResponseProto, error) {
+
+	if s.allHandlers == nil || s.allHandlers.PKWriter == nil {
+		return nil, fmt.Errorf("PKDelete handler is not registered")
+	}
+
+	req, apiKey := api.ConvertPKDeleteRequestProto(reqProto)
+
+	var response api.PKWriteResponse = (api.PKWriteResponse)(&api.PKWriteResponseGRPC{})
+	response.Init()
+
+	status, err := s.allHandlers.PKWriter.PkWriteHandler(c, req, &apiKey, response)
+	if err != nil {
+		return nil, mkError(status, err)
+	}
+
+	if status != http.StatusOK {
+		return nil, mkError(status, nil)
+	}
+
+	respProto := api.ConvertPKDeleteResponse(response.(*api.PKWriteResponseGRPC))
+	return respProto, nil
+}
+
 func (s *GRPCServer) Batch(c context.Context, reqProto *api.BatchRequestProto) (*api.BatchResponseProto, error) {
 
 	if s.allHandlers == nil || s.allHandlers.Batcher == nil {
@@ -78,7 +134,7 @@ func (s *GRPCServer) Batch(c context.Context, reqProto *api.BatchRequestProto) (
 	var response api.BatchOpResponse = (api.BatchOpResponse)(&api.BatchResponseGRPC{})
 	response.Init()
 
-	status, err := s.allHandlers.Batcher.BatchOpsHandler(req, &apikey, response)
+	status, err := s.allHandlers.Batcher.BatchOpsHandler(c, req, &apikey, response)
 	if err != nil {
 		return nil, mkError(status, err)
 	}
@@ -91,6 +147,108 @@ func (s *GRPCServer) Batch(c context.Context, reqProto *api.BatchRequestProto) (
 	return respProto, nil
 }
 
+func (s *GRPCServer) BatchTx(c context.Context, reqProto *api.BatchTxRequestProto) (*api.BatchResponseProto, error) {
+
+	if s.allHandlers == nil || s.allHandlers.Batcher == nil {
+		return nil, fmt.Errorf("Batch ops handler is not registered")
+	}
+
+	req, apikey := api.ConvertBatchTxRequestProto(reqProto)
+
+	var response api.BatchOpResponse = (api.BatchOpResponse)(&api.BatchResponseGRPC{})
+	response.Init()
+
+	status, err := s.allHandlers.Batcher.BatchTxOpsHandler(c, req, &apikey, response)
+	if err != nil {
+		return nil, mkError(status, err)
+	}
+
+	// the transaction was rolled back. The codes of the sub-operations are
+	// sent in the error details
+	if status != http.StatusOK {
+		grpcErr := mkError(status, nil).(*grpcError)
+		grpcErr.response = api.ConvertBatchOpResponse(response.(*api.BatchResponseGRPC))
+		return nil, grpcErr
+	}
+
+	respProto := api.ConvertBatchOpResponse(response.(*api.BatchResponseGRPC))
+	return respProto, nil
+}
+
+func (s *GRPCServer) FeatureVector(c context.Context, reqProto *api.FeatureVectorRequestProto) (*api.FeatureVectorResponseProto, error) {
+
+	if s.allHandlers == nil || s.allHandlers.FeatureVectorReader == nil {
+		return nil, fmt.Errorf("Feature vector handler is not registered")
+	}
+
+	req, apikey := api.ConvertFeatureVectorRequestProto(reqProto)
+
+	var response api.FeatureVectorResponse = (api.FeatureVectorResponse)(&api.FeatureVectorResponseGRPC{})
+	response.Init()
+
+	status, err := s.allHandlers.FeatureVectorReader.FeatureVectorHandler(c, req, &apikey, response)
+	if err != nil {
+		return nil, mkError(status, err)
+	}
+
+	if status != http.StatusOK {
+		return nil, mkError(status, nil)
+	}
+
+	respProto := api.ConvertFeatureVectorResponse(response.(*api.FeatureVectorResponseGRPC))
+	return respProto, nil
+}
+
+// IndexScan streams the rows of the scan in messages of up to
+// api.SCAN_STREAM_PAGE_SIZE rows
+func (s *GRPCServer) IndexScan(reqProto *api.IndexScanRequestProto, stream api.RonDBREST_IndexScanServer) error {
+
+	if s.allHandlers == nil || s.allHandlers.IndexScanner == nil {
+		return fmt.Errorf("Index scan handler is not registered")
+	}
+
+	req, apiKey := api.ConvertIndexScanRequestProto(reqProto)
+
+	response := api.NewScanResponseGRPCStream(stream.Send)
+	response.Init()
+
+	status, err := s.allHandlers.IndexScanner.IndexScanHandler(stream.Context(), req, &apiKey, response)
+	if err != nil {
+		return mkError(status, err)
+	}
+
+	if status != http.StatusOK {
+		return mkError(status, nil)
+	}
+
+	return response.Close()
+}
+
+// TableScan reads the table page by page. A page is sent before the next
+// page is read, and sending blocks while the client is not receiving
+func (s *GRPCServer) TableScan(reqProto *api.TableScanRequestProto, stream api.RonDBREST_TableScanServer) error {
+
+	if s.allHandlers == nil || s.allHandlers.TableScanner == nil {
+		return fmt.Errorf("Table scan handler is not registered")
+	}
+
+	req, apiKey := api.ConvertTableScanRequestProto(reqProto)
+
+	response := api.NewScanResponseGRPCStream(stream.Send)
+	response.Init()
+
+	status, err := s.allHandlers.TableScanner.TableScanStreamHandler(stream.Context(), req, &apiKey, response)
+	if err != nil {
+		return mkError(status, err)
+	}
+
+	if status != http.StatusOK {
+		return mkError(status, nil)
+	}
+
+	return response.Close()
+}
+
 func (s *GRPCServer) Stat(ctx context.Context, reqProto *api.StatRequestProto) (*api.StatResponseProto, error) {
 
 	if s.allHandlers == nil || s.allHandlers.Stater == nil {
@@ -98,7 +256,7 @@ func (s *GRPCServer) Stat(ctx context.Context, reqProto *api.StatRequestProto) (
 	}
 
 	response := &api.StatResponse{}
-	status, err := s.allHandlers.Stater.StatOpsHandler(response)
+	status, err := s.allHandlers.Stater.StatOpsHandler(ctx, response)
 	if err != nil {
 		return nil, mkError(status, err)
 	}
@@ -111,12 +269,193 @@ func (s *GRPCServer) Stat(ctx context.Context, reqProto *api.StatRequestProto) (
 	return respProto, nil
 }
 
+func (s *GRPCServer) TableSchema(ctx context.Context, reqProto *api.TableSchemaRequestProto) (*api.TableSchemaResponseProto, error) {
+
+	if s.allHandlers == nil || s.allHandlers.SchemaReader == nil {
+		return nil, fmt.Errorf("Table schema handler is not registered")
+	}
+
+	req, apiKey := api.ConvertTableSchemaRequestProto(reqProto)
+
+	response := &api.TableSchemaResponse{}
+	status, err := s.allHandlers.SchemaReader.TableSchemaHandler(ctx, req, &apiKey, response)
+	if err != nil {
+		return nil, mkError(status, err)
+	}
+
+	if status != http.StatusOK {
+		return nil, mkError(status, nil)
+	}
+
+	respProto := api.ConvertTableSchemaResponse(response)
+	return respProto, nil
+}
+
+func (s *GRPCServer) Databases(ctx context.Context, reqProto *api.DatabasesRequestProto) (*api.DatabasesResponseProto, error) {
+
+	if s.allHandlers == nil || s.allHandlers.Lister == nil {
+		return nil, fmt.Errorf("Lister handler is not registered")
+	}
+
+	apiKey := reqProto.GetAPIKey()
+
+	response := &api.DatabasesResponse{}
+	status, err := s.allHandlers.Lister.DatabasesHandler(ctx, &apiKey, response)
+	if err != nil {
+		return nil, mkError(status, err)
+	}
+
+	if status != http.StatusOK {
+		return nil, mkError(status, nil)
+	}
+
+	respProto := api.ConvertDatabasesResponse(response)
+	return respProto, nil
+}
+
+func (s *GRPCServer) Tables(ctx context.Context, reqProto *api.TablesRequestProto) (*api.TablesResponseProto, error) {
+
+	if s.allHandlers == nil || s.allHandlers.Lister == nil {
+		return nil, fmt.Errorf("Lister handler is not registered")
+	}
+
+	req, apiKey := api.ConvertTablesRequestProto(reqProto)
+
+	response := &api.TablesResponse{}
+	status, err := s.allHandlers.Lister.TablesHandler(ctx, req, &apiKey, response)
+	if err != nil {
+		return nil, mkError(status, err)
+	}
+
+	if status != http.StatusOK {
+		return nil, mkError(status, nil)
+	}
+
+	respProto := api.ConvertTablesResponse(response)
+	return respProto, nil
+}
+
+// ERROR_DOMAIN is the domain of the ErrorInfo details of the gRPC errors
+const ERROR_DOMAIN = "rondb.rest"
+
+// grpcError keeps the http status code of the failed request, which is also
+// part of the message sent to the client. The client receives the gRPC code
+// of the http status code, with the error response and an ErrorInfo in the
+// details. The partial response of the request, if any, is the last detail
+type grpcError struct {
+	code     int
+	msg      string
+	resp     *common.ErrorResponse
+	dalErr   *dal.DalError
+	response protoiface.MessageV1
+}
+
+func (e *grpcError) Error() string {
+	return e.msg
+}
+
+// GRPCStatus is used by the gRPC server to send the error
+func (e *grpcError) GRPCStatus() *status.Status {
+	st := status.New(grpcCode(e.code), e.msg)
+	info := &errdetails.ErrorInfo{
+		Reason:   e.resp.Reason,
+		Domain:   ERROR_DOMAIN,
+		Metadata: map[string]string{"httpCode": strconv.Itoa(e.code)},
+	}
+	if e.dalErr != nil && e.dalErr.IsNdbError() {
+		info.Metadata["ndbCode"] = strconv.Itoa(e.dalErr.NdbCode)
+		info.Metadata["mysqlCode"] = strconv.Itoa(e.dalErr.MySQLCode)
+		info.Metadata["ndbStatus"] = strconv.Itoa(e.dalErr.Status)
+		info.Metadata["ndbClassification"] = strconv.Itoa(e.dalErr.Classification)
+	}
+	details := []protoiface.MessageV1{convertErrorResponse(e.resp), info}
+	if e.response != nil {
+		details = append(details, e.response)
+	}
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		return withDetails
+	}
+	return st
+}
+
 func mkError(status int, err error) error {
+	grpcErr := &grpcError{code: status, resp: common.NewErrorResponse(status, err)}
 	if err != nil {
-		return fmt.Errorf("Error code: %d, Error: %v ", status, err)
+		grpcErr.msg = fmt.Sprintf("Error code: %d, Error: %v ", status, err)
+		errors.As(err, &grpcErr.dalErr)
 	} else {
-		return fmt.Errorf("Error code: %d", status)
+		grpcErr.msg = fmt.Sprintf("Error code: %d", status)
+	}
+	return grpcErr
+}
+
+func convertErrorResponse(resp *common.ErrorResponse) *api.ErrorResponseProto {
+	respProto := &api.ErrorResponseProto{Reason: &resp.Reason, Message: &resp.Message}
+	if resp.Code != "" {
+		respProto.Code = &resp.Code
+	}
+	if resp.DB != "" {
+		respProto.DB = &resp.DB
+	}
+	if resp.Table != "" {
+		respProto.Table = &resp.Table
+	}
+	if resp.Column != "" {
+		respProto.Column = &resp.Column
+	}
+	if resp.OperationID != "" {
+		respProto.OperationID = &resp.OperationID
+	}
+	if resp.NdbCode != 0 {
+		ndbCode := int32(resp.NdbCode)
+		respProto.NdbCode = &ndbCode
+	}
+	return respProto
+}
+
+// grpcCode returns the gRPC code of an http status code
+func grpcCode(httpCode int) codes.Code {
+	switch httpCode {
+	case http.StatusOK:
+		return codes.OK
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusFailedDependency:
+		return codes.Aborted
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	case dal.StatusClientClosedRequest:
+		return codes.Canceled
+	}
+	if httpCode >= http.StatusInternalServerError {
+		return codes.Internal
+	}
+	return codes.FailedPrecondition
+}
+
+// errorCode returns the http status code of the error returned by a handler
+func errorCode(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	if grpcErr, ok := err.(*grpcError); ok {
+		return grpcErr.code
 	}
+	return http.StatusInternalServerError
 }
 
 func (s *GRPCServer) mustEmbedUnimplementedRonDBRestServerServer() {}
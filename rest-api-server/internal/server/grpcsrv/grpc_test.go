@@ -0,0 +1,142 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package grpcsrv
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+func TestErrorStatus(t *testing.T) {
+	db := "db"
+	table := "table"
+	tests := []struct {
+		httpCode int
+		err      error
+		code     codes.Code
+		reason   string
+		ndbCode  string
+	}{
+		{http.StatusBadRequest, fmt.Errorf("invalid request"), codes.InvalidArgument,
+			common.REASON_INVALID_REQUEST, ""},
+		{http.StatusUnauthorized, nil, codes.Unauthenticated, common.REASON_UNAUTHORIZED, ""},
+		{http.StatusNotFound, nil, codes.NotFound, common.REASON_NOT_FOUND, ""},
+		{http.StatusConflict, &dal.DalError{HttpCode: http.StatusConflict, NdbCode: 630},
+			codes.AlreadyExists, common.REASON_CONFLICT, "630"},
+		{http.StatusTooManyRequests, &dal.DalError{HttpCode: http.StatusTooManyRequests, NdbCode: 410},
+			codes.ResourceExhausted, common.REASON_TOO_MANY_REQUESTS, "410"},
+		{http.StatusServiceUnavailable, common.WithRequest(
+			&dal.DalError{HttpCode: http.StatusServiceUnavailable, NdbCode: 4009}, &db, &table, nil),
+			codes.Unavailable, common.REASON_UNAVAILABLE, "4009"},
+		{http.StatusGatewayTimeout, &dal.DalError{HttpCode: http.StatusGatewayTimeout, NdbCode: 266},
+			codes.DeadlineExceeded, common.REASON_TIMEOUT, "266"},
+		{dal.StatusClientClosedRequest, dal.ContextError(context.Canceled), codes.Canceled,
+			common.REASON_CANCELLED, ""},
+		{http.StatusInternalServerError, fmt.Errorf("failed"), codes.Internal,
+			common.REASON_INTERNAL, ""},
+	}
+
+	for _, test := range tests {
+		err := mkError(test.httpCode, test.err)
+		if errorCode(err) != test.httpCode {
+			t.Fatalf("Wrong http code. Expected: %d, Got: %d", test.httpCode, errorCode(err))
+		}
+
+		st, ok := status.FromError(err)
+		if !ok || st.Code() != test.code {
+			t.Fatalf("Wrong gRPC code for %d. Expected: %v, Got: %v", test.httpCode, test.code, st.Code())
+		}
+
+		details := st.Details()
+		if len(details) != 2 {
+			t.Fatalf("Expected error details for %d", test.httpCode)
+		}
+		resp, ok := details[0].(*api.ErrorResponseProto)
+		if !ok || resp.GetReason() != test.reason || resp.GetMessage() == "" {
+			t.Fatalf("Wrong error response for %d. Got: %v", test.httpCode, details[0])
+		}
+		if fmt.Sprintf("%d", resp.GetNdbCode()) != test.ndbCode && test.ndbCode != "" {
+			t.Fatalf("Wrong NDB code for %d. Got: %v", test.httpCode, details[0])
+		}
+		if test.httpCode == http.StatusServiceUnavailable && (resp.GetDB() != db || resp.GetTable() != table) {
+			t.Fatalf("Wrong request in the error response. Got: %v", details[0])
+		}
+
+		info, ok := details[1].(*errdetails.ErrorInfo)
+		if !ok || info.Reason != test.reason || info.Domain != ERROR_DOMAIN {
+			t.Fatalf("Wrong error info for %d. Got: %v", test.httpCode, details[1])
+		}
+		if info.Metadata["ndbCode"] != test.ndbCode {
+			t.Fatalf("Wrong NDB code for %d. Expected: %s, Got: %s", test.httpCode, test.ndbCode,
+				info.Metadata["ndbCode"])
+		}
+	}
+}
+
+func TestErrorResponseDetail(t *testing.T) {
+	failedDependency := int32(http.StatusFailedDependency)
+	conflict := int32(http.StatusConflict)
+	grpcErr := mkError(http.StatusConflict, nil).(*grpcError)
+	grpcErr.response = &api.BatchResponseProto{Responses: []*api.PKReadResponseProto{
+		{Code: &failedDependency}, {Code: &conflict}}}
+
+	st, _ := status.FromError(grpcErr)
+	details := st.Details()
+	if st.Code() != codes.AlreadyExists || len(details) != 3 {
+		t.Fatalf("Expected the batch response in the error details. Got: %v", details)
+	}
+	resp, ok := details[2].(*api.BatchResponseProto)
+	if !ok || len(resp.Responses) != 2 || resp.Responses[0].GetCode() != failedDependency ||
+		resp.Responses[1].GetCode() != conflict {
+		t.Fatalf("Wrong batch response in the error details. Got: %v", details[2])
+	}
+}
+
+func TestOperationName(t *testing.T) {
+	write := func(op string) *api.PKWriteRequestProto {
+		return &api.PKWriteRequestProto{Operation: &op}
+	}
+	tests := []struct {
+		method    string
+		req       interface{}
+		operation string
+	}{
+		{"/RonDBREST/PKRead", &api.PKReadRequestProto{}, config.PK_DB_OPERATION},
+		{"/RonDBREST/PKWrite", write(api.PK_INSERT), config.PK_INSERT_DB_OPERATION},
+		{"/RonDBREST/PKWrite", write(api.PK_UPDATE), config.PK_UPDATE_DB_OPERATION},
+		{"/RonDBREST/PKWrite", write(api.PK_UPSERT), config.PK_UPSERT_DB_OPERATION},
+		{"/RonDBREST/PKWrite", write("merge"), "PKWrite"},
+		{"/RonDBREST/TableScan", nil, config.TABLE_SCAN_DB_OPERATION},
+	}
+
+	for _, test := range tests {
+		if op := operationName(test.method, test.req); op != test.operation {
+			t.Fatalf("Wrong operation for %s. Expected: %s, Got: %s", test.method, test.operation, op)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package grpcsrv
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"hopsworks.ai/rdrs/internal/handlers"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+// interval at which the readiness is checked for the Watch calls
+const HEALTH_WATCH_INTERVAL = 5 * time.Second
+
+// HealthServer implements the standard gRPC health service using the
+// readiness checks of the REST server. The overall health, i.e., the empty
+// service name, and the RonDBREST service are reported
+type HealthServer struct {
+	allHandlers *handlers.AllHandlers
+	healthpb.UnimplementedHealthServer
+}
+
+var _ healthpb.HealthServer = (*HealthServer)(nil)
+
+var healthServer HealthServer
+
+func GetHealthServer() *HealthServer {
+	return &healthServer
+}
+
+func (s *HealthServer) RegisterAllHandlers(handlers *handlers.AllHandlers) {
+	s.allHandlers = handlers
+}
+
+func (s *HealthServer) Check(ctx context.Context,
+	req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if !knownService(req.GetService()) {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+	return &healthpb.HealthCheckResponse{Status: s.servingStatus(ctx)}, nil
+}
+
+// Watch sends the serving status when the stream is opened and whenever
+// the status changes afterwards
+func (s *HealthServer) Watch(req *healthpb.HealthCheckRequest,
+	stream healthpb.Health_WatchServer) error {
+	lastStatus := healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+	if knownService(req.GetService()) {
+		lastStatus = s.servingStatus(stream.Context())
+	}
+	if err := stream.Send(&healthpb.HealthCheckResponse{Status: lastStatus}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(HEALTH_WATCH_INTERVAL)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Stream has ended")
+		case <-ticker.C:
+			if lastStatus == healthpb.HealthCheckResponse_SERVICE_UNKNOWN {
+				continue
+			}
+			servingStatus := s.servingStatus(stream.Context())
+			if servingStatus == lastStatus {
+				continue
+			}
+			lastStatus = servingStatus
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: servingStatus}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *HealthServer) servingStatus(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	if s.allHandlers == nil || s.allHandlers.HealthChecker == nil {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	response := api.HealthResponse{}
+	code, err := s.allHandlers.HealthChecker.ReadinessHandler(ctx, &response)
+	if err != nil || code != http.StatusOK {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	return healthpb.HealthCheckResponse_SERVING
+}
+
+func knownService(service string) bool {
+	return service == "" || service == api.RonDBREST_ServiceDesc.ServiceName
+}
+
+// isHealthCheck returns true for the calls of the health service, which
+// are not traced or recorded in the metrics
+func isHealthCheck(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
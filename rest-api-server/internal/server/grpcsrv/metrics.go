@@ -0,0 +1,94 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package grpcsrv
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"google.golang.org/grpc"
+	"hopsworks.ai/rdrs/internal/config"
+	"hopsworks.ai/rdrs/internal/metrics"
+	"hopsworks.ai/rdrs/pkg/api"
+)
+
+// the gRPC methods use the same operation names as the REST endpoints
+var operations = map[string]string{
+	"PKRead":        config.PK_DB_OPERATION,
+	"PKDelete":      config.PK_DELETE_DB_OPERATION,
+	"Batch":         config.BATCH_OPERATION,
+	"BatchTx":       config.BATCH_TX_OPERATION,
+	"IndexScan":     config.INDEX_SCAN_DB_OPERATION,
+	"TableScan":     config.TABLE_SCAN_DB_OPERATION,
+	"TableSchema":   config.SCHEMA_OPERATION,
+	"Databases":     config.DATABASES_OPERATION,
+	"Tables":        config.TABLES_OPERATION,
+	"FeatureVector": config.FEATURE_VECTOR_OPERATION,
+	"Stat":          config.STAT_OPERATION,
+}
+
+// PKWrite calls are recorded as the insert, update or upsert operation of the
+// request
+var writeOperations = map[string]string{
+	api.PK_INSERT: config.PK_INSERT_DB_OPERATION,
+	api.PK_UPDATE: config.PK_UPDATE_DB_OPERATION,
+	api.PK_UPSERT: config.PK_UPSERT_DB_OPERATION,
+}
+
+func operationName(fullMethod string, req interface{}) string {
+	method := path.Base(fullMethod)
+	if writeReq, ok := req.(*api.PKWriteRequestProto); ok {
+		if op, ok := writeOperations[writeReq.GetOperation()]; ok {
+			return op
+		}
+	}
+	if op, ok := operations[method]; ok {
+		return op
+	}
+	return method
+}
+
+// UnaryMetricsInterceptor records the latency and the status of unary calls
+func UnaryMetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if isHealthCheck(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	metrics.ObserveRequest(operationName(info.FullMethod, req), metrics.GRPC_TRANSPORT, errorCode(err),
+		time.Since(start))
+	return resp, err
+}
+
+// StreamMetricsInterceptor records the latency and the status of streaming
+// calls. The latency includes the time spent sending the stream
+func StreamMetricsInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if isHealthCheck(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	start := time.Now()
+	err := handler(srv, ss)
+	metrics.ObserveRequest(operationName(info.FullMethod, nil), metrics.GRPC_TRANSPORT, errorCode(err),
+		time.Since(start))
+	return err
+}
@@ -0,0 +1,52 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package grpcsrv
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"hopsworks.ai/rdrs/internal/config"
+)
+
+// UnaryTimeoutInterceptor sets the configured default timeout on the calls
+// that do not have a deadline set by the client
+func UnaryTimeoutInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, cancel := withDefaultTimeout(ctx)
+	defer cancel()
+	return handler(ctx, req)
+}
+
+// StreamTimeoutInterceptor sets the configured default timeout on the
+// streaming calls that do not have a deadline set by the client
+func StreamTimeoutInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	ctx, cancel := withDefaultTimeout(ss.Context())
+	defer cancel()
+	return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+}
+
+func withDefaultTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeoutMS := config.Configuration().RestServer.RequestTimeoutMS
+	if _, ok := ctx.Deadline(); ok || timeoutMS == 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, time.Duration(timeoutMS)*time.Millisecond)
+}
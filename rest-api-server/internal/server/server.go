@@ -27,13 +27,17 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"hopsworks.ai/rdrs/internal/config"
 	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/dal/memory"
 	"hopsworks.ai/rdrs/internal/handlers"
 	"hopsworks.ai/rdrs/internal/log"
+	"hopsworks.ai/rdrs/internal/metrics"
 	"hopsworks.ai/rdrs/internal/security/apikey"
 	"hopsworks.ai/rdrs/internal/security/tlsutils"
 	"hopsworks.ai/rdrs/internal/server/grpcsrv"
+	"hopsworks.ai/rdrs/internal/tracing"
 	"hopsworks.ai/rdrs/pkg/api"
 	"hopsworks.ai/rdrs/version"
 	// _ "github.com/ianlancetaylor/cgosymbolizer" // enable this for stack trace of c layer
@@ -75,10 +79,8 @@ func (rc *RouterConext) SetupRouter(handlers *handlers.AllHandlers) error {
 
 	rc.registerHandlers(handlers)
 
-	// connect to RonDB
 	dal.InitializeBuffers()
-	err := dal.InitRonDBConnection(fmt.Sprintf("%s:%d", rc.DBIP, rc.DBPort), true)
-	if err != nil {
+	if err := rc.initDataAccess(); err != nil {
 		return err
 	}
 
@@ -92,17 +94,75 @@ func (rc *RouterConext) SetupRouter(handlers *handlers.AllHandlers) error {
 }
 
 func (rc *RouterConext) registerHandlers(handlers *handlers.AllHandlers) error {
+	// tracing, metrics and timeouts of all the routes registered below
+	rc.Engine.Use(tracing.GinMiddleware(config.METRICS_PATH, config.HEALTH_LIVE_PATH,
+		config.HEALTH_READY_PATH))
+	rc.Engine.Use(metrics.GinMiddleware(config.METRICS_PATH, config.HEALTH_LIVE_PATH,
+		config.HEALTH_READY_PATH))
+	rc.Engine.Use(requestTimeoutMiddleware())
+	rc.Engine.GET(config.METRICS_PATH, metrics.HttpHandler)
+
+	// health
+	if handlers.HealthChecker != nil {
+		rc.Engine.GET(config.HEALTH_LIVE_PATH, handlers.HealthChecker.LivenessHttpHandler)
+		rc.Engine.GET(config.HEALTH_READY_PATH, handlers.HealthChecker.ReadinessHttpHandler)
+	}
+
 	// register handlers
 	// pk
 	if handlers.PKReader != nil {
 		group := rc.Engine.Group(config.DB_OPS_EP_GROUP)
 		group.POST(config.PK_DB_OPERATION, handlers.PKReader.PkReadHttpHandler)
+		group.POST(config.UNIQUE_KEY_READ_DB_OPERATION, handlers.PKReader.UniqueKeyReadHttpHandler)
+	}
+
+	// pk write
+	if handlers.PKWriter != nil {
+		group := rc.Engine.Group(config.DB_OPS_EP_GROUP)
+		group.POST(config.PK_INSERT_DB_OPERATION, handlers.PKWriter.PkInsertHttpHandler)
+		group.POST(config.PK_UPDATE_DB_OPERATION, handlers.PKWriter.PkUpdateHttpHandler)
+		group.POST(config.PK_UPSERT_DB_OPERATION, handlers.PKWriter.PkUpsertHttpHandler)
+		group.POST(config.PK_DELETE_DB_OPERATION, handlers.PKWriter.PkDeleteHttpHandler)
+	}
+
+	// index scan
+	if handlers.IndexScanner != nil {
+		group := rc.Engine.Group(config.DB_OPS_EP_GROUP)
+		group.POST(config.INDEX_SCAN_DB_OPERATION, handlers.IndexScanner.IndexScanHttpHandler)
+	}
+
+	// table scan
+	if handlers.TableScanner != nil {
+		group := rc.Engine.Group(config.DB_OPS_EP_GROUP)
+		group.POST(config.TABLE_SCAN_DB_OPERATION, handlers.TableScanner.TableScanHttpHandler)
+	}
+
+	// table schema
+	if handlers.SchemaReader != nil {
+		group := rc.Engine.Group(config.DB_OPS_EP_GROUP)
+		group.GET(config.SCHEMA_OPERATION, handlers.SchemaReader.TableSchemaHttpHandler)
+	}
+
+	// database and table lists
+	if handlers.Lister != nil {
+		rc.Engine.GET("/"+version.API_VERSION+"/"+config.DATABASES_OPERATION,
+			handlers.Lister.DatabasesHttpHandler)
+		rc.Engine.GET("/"+version.API_VERSION+"/:"+config.DB_PP+"/"+config.TABLES_OPERATION,
+			handlers.Lister.TablesHttpHandler)
 	}
 
 	// batch
 	if handlers.Batcher != nil {
 		rc.Engine.POST("/"+version.API_VERSION+"/"+config.BATCH_OPERATION,
 			handlers.Batcher.BatchOpsHttpHandler)
+		rc.Engine.POST("/"+version.API_VERSION+"/"+config.BATCH_TX_OPERATION,
+			handlers.Batcher.BatchTxOpsHttpHandler)
+	}
+
+	// feature vector
+	if handlers.FeatureVectorReader != nil {
+		rc.Engine.POST("/"+version.API_VERSION+"/"+config.FEATURE_VECTOR_OPERATION,
+			handlers.FeatureVectorReader.FeatureVectorHttpHandler)
 	}
 
 	// stat
@@ -113,6 +173,7 @@ func (rc *RouterConext) registerHandlers(handlers *handlers.AllHandlers) error {
 
 	// GRPC
 	grpcsrv.GetGRPCServer().RegisterAllHandlers(handlers)
+	grpcsrv.GetHealthServer().RegisterAllHandlers(handlers)
 
 	rc.handlers = handlers
 	return nil
@@ -157,15 +218,54 @@ func (rc *RouterConext) StartRouter() error {
 		if err != nil {
 			log.Fatalf("GRPC server returned. Error: %v", err)
 		}
-		rc.GRPCServer = grpc.NewServer()
+		rc.GRPCServer = grpc.NewServer(
+			grpc.ChainUnaryInterceptor(grpcsrv.UnaryTracingInterceptor,
+				grpcsrv.UnaryMetricsInterceptor, grpcsrv.UnaryTimeoutInterceptor),
+			grpc.ChainStreamInterceptor(grpcsrv.StreamTracingInterceptor,
+				grpcsrv.StreamMetricsInterceptor, grpcsrv.StreamTimeoutInterceptor))
 		GRPCServer := grpcsrv.GetGRPCServer()
 		api.RegisterRonDBRESTServer(rc.GRPCServer, GRPCServer)
+		healthpb.RegisterHealthServer(rc.GRPCServer, grpcsrv.GetHealthServer())
 		rc.GRPCServer.Serve(lis)
 	}()
 
 	return nil
 }
 
+// initDataAccess connects to RonDB, or loads the memory backend
+func (rc *RouterConext) initDataAccess() error {
+	switch config.Configuration().DataAccess.Backend {
+	case "", config.BACKEND_RONDB:
+	case config.BACKEND_MEMORY:
+		memDB, err := memory.Load(config.Configuration().DataAccess.FixtureFile)
+		if err != nil {
+			return err
+		}
+		dal.SetDataAccess(memDB)
+		return nil
+	default:
+		return fmt.Errorf("Unknown data access backend '%s'", config.Configuration().DataAccess.Backend)
+	}
+
+	dal.SetDataAccess(dal.RonDB{})
+	dalErr := dal.InitRonDBConnection(fmt.Sprintf("%s:%d", rc.DBIP, rc.DBPort), true)
+	if dalErr != nil {
+		return dalErr
+	}
+	return nil
+}
+
+func shutdownDataAccess() {
+	if _, ok := dal.GetDataAccess().(dal.RonDB); !ok {
+		dal.SetDataAccess(dal.RonDB{})
+		return
+	}
+
+	if dalErr := dal.ShutdownConnection(); dalErr != nil {
+		log.Errorf("Failed to stop RonDB API. Error %v", dalErr)
+	}
+}
+
 func serverTLSConfig() (*tls.Config, error) {
 	tlsConfig := &tls.Config{
 		MinVersion:               tls.VersionTLS13,
@@ -197,16 +297,14 @@ func (rc *RouterConext) StopRouter() error {
 	rc.GRPCServer.Stop()
 
 	// Stop RonDB Connection
-	dalErr := dal.ShutdownConnection()
+	shutdownDataAccess()
 	dal.ReleaseAllBuffers()
 
-	if dalErr != nil {
-		log.Errorf("Failed to stop RonDB API. Error %v", dalErr)
-	}
-
 	// Clean API Key Cache
 	apikey.Reset()
 
+	metrics.Reset()
+
 	return nil
 }
 
@@ -0,0 +1,67 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"hopsworks.ai/rdrs/internal/common"
+	"hopsworks.ai/rdrs/internal/config"
+)
+
+// requestTimeoutMiddleware sets the deadline of the request context. The
+// timeout header, in milliseconds, overrides the configured default.
+// The native operations of the request are abandoned at the deadline
+func requestTimeoutMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeout, err := requestTimeout(c.GetHeader(config.REQUEST_TIMEOUT_HEADER))
+		if err != nil {
+			common.SetResponseBodyError(c, http.StatusBadRequest, err)
+			c.Abort()
+			return
+		}
+		if timeout == 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// requestTimeout returns 0 if the request does not time out
+func requestTimeout(header string) (time.Duration, error) {
+	if header == "" {
+		return time.Duration(config.Configuration().RestServer.RequestTimeoutMS) * time.Millisecond, nil
+	}
+
+	timeoutMS, err := strconv.ParseUint(header, 10, 32)
+	if err != nil || timeoutMS == 0 {
+		return 0, fmt.Errorf("Invalid %s header '%s'. Expecting a positive number of milliseconds",
+			config.REQUEST_TIMEOUT_HEADER, header)
+	}
+	return time.Duration(timeoutMS) * time.Millisecond, nil
+}
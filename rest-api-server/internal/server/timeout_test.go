@@ -0,0 +1,59 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"hopsworks.ai/rdrs/internal/config"
+)
+
+func TestRequestTimeout(t *testing.T) {
+	defaultTimeout := config.Configuration().RestServer.RequestTimeoutMS
+	defer func() { config.Configuration().RestServer.RequestTimeoutMS = defaultTimeout }()
+	config.Configuration().RestServer.RequestTimeoutMS = 2000
+
+	tests := []struct {
+		header  string
+		timeout time.Duration
+		isErr   bool
+	}{
+		{"", 2 * time.Second, false},
+		{"500", 500 * time.Millisecond, false},
+		{"10000", 10 * time.Second, false},
+		{"0", 0, true},
+		{"-1", 0, true},
+		{"1s", 0, true},
+	}
+
+	for _, tc := range tests {
+		timeout, err := requestTimeout(tc.header)
+		if (err != nil) != tc.isErr {
+			t.Fatalf("Header '%s'. Unexpected error: %v", tc.header, err)
+		}
+		if timeout != tc.timeout {
+			t.Fatalf("Header '%s'. Expected timeout %v, got %v", tc.header, tc.timeout, timeout)
+		}
+	}
+
+	config.Configuration().RestServer.RequestTimeoutMS = 0
+	if timeout, _ := requestTimeout(""); timeout != 0 {
+		t.Fatalf("Expected no timeout, got %v", timeout)
+	}
+}
@@ -0,0 +1,129 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package tracing sets up the OpenTelemetry SDK using the Tracing section of
+// the configuration. Incoming W3C trace context is continued, and the spans
+// are exported either to an OTLP/gRPC collector or to stdout
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	EXPORTER_DISABLED = "disabled"
+	EXPORTER_STDOUT   = "stdout"
+	EXPORTER_OTLP     = "otlp"
+
+	DEFAULT_SERVICE_NAME = "rdrs"
+	INSTRUMENTATION_NAME = "hopsworks.ai/rdrs"
+
+	SHUTDOWN_TIMEOUT = 5 * time.Second
+)
+
+type TracingConfig struct {
+	Exporter     string // disabled, stdout or otlp
+	OTLPEndpoint string // OTLP/gRPC collector endpoint, e.g., localhost:4317
+	ServiceName  string
+}
+
+var provider *sdktrace.TracerProvider
+
+// InitTracer registers the global tracer provider and the W3C trace context
+// propagator. Nothing is registered if tracing is disabled, so the spans
+// started by the handlers are no-ops
+func InitTracer(config TracingConfig) error {
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch config.Exporter {
+	case "", EXPORTER_DISABLED:
+		return nil
+	case EXPORTER_STDOUT:
+		exporter, err = stdouttrace.New()
+	case EXPORTER_OTLP:
+		if config.OTLPEndpoint == "" {
+			return errors.New("OTLP endpoint is not set")
+		}
+		exporter, err = otlptracegrpc.New(context.Background(),
+			otlptracegrpc.WithEndpoint(config.OTLPEndpoint), otlptracegrpc.WithInsecure())
+	default:
+		return fmt.Errorf("unknown tracing exporter: %s", config.Exporter)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create the %s exporter. Error: %w", config.Exporter, err)
+	}
+
+	serviceName := config.ServiceName
+	if serviceName == "" {
+		serviceName = DEFAULT_SERVICE_NAME
+	}
+	start(exporter, serviceName)
+	return nil
+}
+
+func start(exporter sdktrace.SpanExporter, serviceName string) {
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+// Enabled returns true if the spans are recorded
+func Enabled() bool {
+	return provider != nil
+}
+
+// Tracer returns the tracer used for all the spans of the server
+func Tracer() trace.Tracer {
+	return otel.Tracer(INSTRUMENTATION_NAME)
+}
+
+// SetError marks the span as failed
+func SetError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// Shutdown exports the queued spans
+func Shutdown() {
+	if provider == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), SHUTDOWN_TIMEOUT)
+	defer cancel()
+	provider.Shutdown(ctx)
+	provider = nil
+}
@@ -0,0 +1,135 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+const parentID = "00f067aa0ba902b7"
+
+// startCapture records the exported spans in memory
+func startCapture(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	exporter := tracetest.NewInMemoryExporter()
+	start(exporter, "test")
+	return exporter
+}
+
+// extract continues the trace of a traceparent header
+func extract(traceparent string) context.Context {
+	carrier := propagation.MapCarrier{"traceparent": traceparent}
+	return otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+}
+
+func TestConfig(t *testing.T) {
+	if err := InitTracer(TracingConfig{Exporter: EXPORTER_DISABLED}); err != nil || Enabled() {
+		t.Fatalf("Tracing must be disabled")
+	}
+	if err := InitTracer(TracingConfig{Exporter: EXPORTER_OTLP}); err == nil {
+		t.Fatalf("The OTLP exporter requires an endpoint")
+	}
+	if err := InitTracer(TracingConfig{Exporter: "zipkin"}); err == nil {
+		t.Fatalf("Unknown exporters must be rejected")
+	}
+}
+
+func TestPropagation(t *testing.T) {
+	exporter := startCapture(t)
+
+	ctx, server := Tracer().Start(extract("00-"+traceID+"-"+parentID+"-01"), "POST /pk-read",
+		trace.WithSpanKind(trace.SpanKindServer))
+	_, child := Tracer().Start(ctx, "pk_read", trace.WithSpanKind(trace.SpanKindClient))
+	child.SetAttributes(attribute.String("db.name", "db"), attribute.Int("http.status_code", 404))
+	SetError(child, fmt.Errorf("failed"))
+	child.End()
+	server.End()
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if carrier.Get("traceparent")[:35] != "00-"+traceID {
+		t.Fatalf("Trace id is not propagated. Got: %s", carrier.Get("traceparent"))
+	}
+
+	defer Shutdown()
+	provider.ForceFlush(context.Background())
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans. Got: %d", len(spans))
+	}
+
+	childSpan, serverSpan := spans[0], spans[1]
+	if serverSpan.SpanContext.TraceID().String() != traceID ||
+		serverSpan.Parent.SpanID().String() != parentID || !serverSpan.Parent.IsRemote() ||
+		serverSpan.SpanKind != trace.SpanKindServer {
+		t.Fatalf("Wrong server span %#v", serverSpan)
+	}
+	if childSpan.SpanContext.TraceID().String() != traceID ||
+		childSpan.Parent.SpanID() != serverSpan.SpanContext.SpanID() ||
+		childSpan.Status.Code != codes.Error || childSpan.Status.Description != "failed" {
+		t.Fatalf("Wrong child span %#v", childSpan)
+	}
+	if childSpan.Attributes[0] != attribute.String("db.name", "db") ||
+		childSpan.Attributes[1] != attribute.Int("http.status_code", 404) {
+		t.Fatalf("Wrong attributes %#v", childSpan.Attributes)
+	}
+	if name, ok := serverSpan.Resource.Set().Value(semconv.ServiceNameKey); !ok ||
+		name.AsString() != "test" {
+		t.Fatalf("Wrong service name")
+	}
+}
+
+func TestInvalidTraceParent(t *testing.T) {
+	startCapture(t)
+	defer Shutdown()
+
+	invalid := []string{
+		"",
+		"00-" + traceID + "-" + parentID,
+		"ff-" + traceID + "-" + parentID + "-01",
+		"00-" + "4BF92F3577B34DA6A3CE929D0E0E4736" + "-" + parentID + "-01",
+		"00-00000000000000000000000000000000-" + parentID + "-01",
+		"00-" + traceID + "-0000000000000000-01",
+		"00-" + traceID + "-" + parentID + "-zz",
+	}
+	for _, traceparent := range invalid {
+		_, span := Tracer().Start(extract(traceparent), "op")
+		ro := span.(sdktrace.ReadOnlySpan)
+		if !span.IsRecording() || ro.Parent().IsValid() {
+			t.Fatalf("A new trace must be started for traceparent '%s'", traceparent)
+		}
+	}
+
+	// not sampled by the caller
+	_, span := Tracer().Start(extract("00-"+traceID+"-"+parentID+"-00"), "op")
+	if span.IsRecording() {
+		t.Fatalf("Spans must not be recorded if the caller did not sample the trace")
+	}
+}
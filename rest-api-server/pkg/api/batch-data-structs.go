@@ -31,6 +31,31 @@ type BatchSubOp struct {
 	Body        *PKReadBody `json:"body"          binding:"required,min=1"`
 }
 
+// Transactional batch request. Sub operations can be
+// pk-read, pk-insert, pk-update, pk-upsert, and pk-delete
+type BatchTxOpRequest struct {
+	Operations *[]BatchTxSubOp `json:"operations" binding:"required,min=1,max=4096,dive"`
+}
+
+type BatchTxSubOp struct {
+	Method      *string           `json:"method"        binding:"required,oneof=POST"`
+	RelativeURL *string           `json:"relative-url"  binding:"required,min=1"`
+	Body        *BatchTxSubOpBody `json:"body"          binding:"required"`
+}
+
+type BatchTxSubOpBody struct {
+	Filters      *[]Filter      `json:"filters"         form:"filters"         binding:"required,min=1,max=4096,dive"`
+	ReadColumns  *[]ReadColumn  `json:"readColumns"     form:"read-columns"    binding:"omitempty,min=1,max=4096,unique"`
+	WriteColumns *[]WriteColumn `json:"writeColumns"    form:"write-columns"   binding:"omitempty,min=1,max=4096,dive"`
+	OperationID  *string        `json:"operationId"     form:"operation-id"    binding:"omitempty,min=1,max=64"`
+}
+
+// Only one of ReadParams and WriteParams is set
+type BatchTxSubOpParams struct {
+	ReadParams  *PKReadParams
+	WriteParams *PKWriteParams
+}
+
 // Response
 type BatchOpResponse interface {
 	Init()
@@ -0,0 +1,82 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"hopsworks.ai/rdrs/internal/dal/dalapi"
+)
+
+// DATE, DATETIME and TIMESTAMP columns. TIME columns do not
+// match these layouts and are returned as strings
+var timestampLayouts = []string{"2006-01-02 15:04:05.999999999", "2006-01-02"}
+
+// NewColumnValueProto converts the string representation of the column data,
+// as returned by the native layer, to a typed value based on the data type
+func NewColumnValueProto(value *string, dataType uint32) *ColumnValueProto {
+	if value == nil {
+		return &ColumnValueProto{Value: &ColumnValueProto_NullValue{NullValue: true}}
+	}
+
+	colVal := ColumnValueProto{Name: value}
+	switch dataType {
+	case dalapi.RDRS_INTEGER_DATATYPE:
+		if num, err := strconv.ParseInt(*value, 10, 64); err == nil {
+			colVal.Value = &ColumnValueProto_Int64Value{Int64Value: num}
+		}
+	case dalapi.RDRS_UNSIGNED_INTEGER_DATATYPE:
+		if num, err := strconv.ParseUint(*value, 10, 64); err == nil {
+			colVal.Value = &ColumnValueProto_Uint64Value{Uint64Value: num}
+		}
+	case dalapi.RDRS_FLOAT_DATATYPE:
+		if num, err := strconv.ParseFloat(*value, 64); err == nil {
+			colVal.Value = &ColumnValueProto_DoubleValue{DoubleValue: num}
+		}
+	case dalapi.RDRS_DECIMAL_DATATYPE:
+		// decimals are returned as strings to avoid loss of precision
+		colVal.Value = &ColumnValueProto_DecimalValue{DecimalValue: *value}
+	case dalapi.RDRS_BINARY_DATATYPE, dalapi.RDRS_BIT_DATATYPE:
+		if bytes, err := base64.StdEncoding.DecodeString(*value); err == nil {
+			colVal.Value = &ColumnValueProto_BytesValue{BytesValue: bytes}
+		}
+	case dalapi.RDRS_DATETIME_DATATYPE:
+		for _, layout := range timestampLayouts {
+			if t, err := time.Parse(layout, *value); err == nil {
+				colVal.Value = &ColumnValueProto_TimestampValue{TimestampValue: timestamppb.New(t)}
+				break
+			}
+		}
+	case dalapi.RDRS_STRING_DATATYPE:
+		// strings are JSON escaped by the native layer
+		var str string
+		if err := json.Unmarshal([]byte("\""+*value+"\""), &str); err == nil {
+			colVal.Value = &ColumnValueProto_StringValue{StringValue: str}
+		}
+	}
+
+	// data that can not be converted is returned as string
+	if colVal.Value == nil {
+		colVal.Value = &ColumnValueProto_StringValue{StringValue: *value}
+	}
+	return &colVal
+}
@@ -21,29 +21,14 @@ import (
 	"encoding/json"
 
 	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/metrics"
 )
 
 // Converters for PK Read Request
 func ConvertPKReadParams(req *PKReadParams, apiKey *string) *PKReadRequestProto {
 
 	pkReadRequestProto := PKReadRequestProto{}
-
-	var filtersProto []*FilterProto
-	if req.Filters != nil {
-		for _, fillter := range *req.Filters {
-			filterProto := FilterProto{}
-			filterProto.Column = fillter.Column
-
-			// remove quotes if any
-			if *fillter.Value != nil {
-				valueStr := string([]byte(*fillter.Value))
-				filterProto.Value = &valueStr
-			}
-
-			filtersProto = append(filtersProto, &filterProto)
-		}
-	}
-	pkReadRequestProto.Filters = filtersProto
+	pkReadRequestProto.Filters = convertFilters(req.Filters)
 
 	var readColumnsProto []*ReadColumnProto
 	if req.ReadColumns != nil {
@@ -92,8 +77,33 @@ func ConvertPKReadRequestProto(reqProto *PKReadRequestProto) (*PKReadParams, str
 		pkReadParams.ReadColumns = nil
 	}
 
+	pkReadParams.Filters = convertFiltersProto(reqProto.Filters)
+
+	return &pkReadParams, reqProto.GetAPIKey() /*may return empty string*/
+}
+
+func convertFilters(filters *[]Filter) []*FilterProto {
+	var filtersProto []*FilterProto
+	if filters != nil {
+		for _, fillter := range *filters {
+			filterProto := FilterProto{}
+			filterProto.Column = fillter.Column
+
+			// remove quotes if any
+			if *fillter.Value != nil {
+				valueStr := string([]byte(*fillter.Value))
+				filterProto.Value = &valueStr
+			}
+
+			filtersProto = append(filtersProto, &filterProto)
+		}
+	}
+	return filtersProto
+}
+
+func convertFiltersProto(filtersProto []*FilterProto) *[]Filter {
 	var filters []Filter
-	for _, filterProto := range reqProto.Filters {
+	for _, filterProto := range filtersProto {
 		if filterProto != nil {
 			filter := Filter{}
 
@@ -105,12 +115,10 @@ func ConvertPKReadRequestProto(reqProto *PKReadRequestProto) (*PKReadParams, str
 		}
 	}
 	if len(filters) > 0 {
-		pkReadParams.Filters = &filters
+		return &filters
 	} else {
-		pkReadParams.Filters = nil
+		return nil
 	}
-
-	return &pkReadParams, reqProto.GetAPIKey() /*may return empty string*/
 }
 
 // Converters for PK Read Response
@@ -118,6 +126,7 @@ func ConvertPKReadResponseProto(respProto *PKReadResponseProto) *PKReadResponseG
 	resp := PKReadResponseGRPC{}
 
 	data := make(map[string]*string)
+	values := make(map[string]*ColumnValueProto)
 	if respProto.Data != nil {
 		for colName, colVal := range respProto.Data {
 			if colVal != nil {
@@ -125,12 +134,15 @@ func ConvertPKReadResponseProto(respProto *PKReadResponseProto) *PKReadResponseG
 			} else {
 				data[colName] = nil
 			}
+			values[colName] = colVal
 		}
 	}
 	if len(data) > 0 {
 		resp.Data = &data
+		resp.Values = &values
 	} else {
 		resp.Data = nil
+		resp.Values = nil
 	}
 
 	resp.OperationID = respProto.OperationID
@@ -140,7 +152,11 @@ func ConvertPKReadResponseProto(respProto *PKReadResponseProto) *PKReadResponseG
 func ConvertPKReadResponse(resp *PKReadResponseGRPC) *PKReadResponseProto {
 	respProto := PKReadResponseProto{}
 	respProto.Data = make(map[string]*ColumnValueProto)
-	if resp.Data != nil {
+	if resp.Values != nil {
+		for colName, colVal := range *resp.Values {
+			respProto.Data[colName] = colVal
+		}
+	} else if resp.Data != nil {
 		for colName, colVal := range *resp.Data {
 			if colVal != nil {
 				respProto.Data[colName] = &ColumnValueProto{Name: colVal}
@@ -154,6 +170,119 @@ func ConvertPKReadResponse(resp *PKReadResponseGRPC) *PKReadResponseProto {
 	return &respProto
 }
 
+// Converters for PK Write Request
+func ConvertPKWriteParams(req *PKWriteParams, apiKey *string) *PKWriteRequestProto {
+	pkWriteRequestProto := PKWriteRequestProto{}
+	pkWriteRequestProto.Filters = convertFilters(req.Filters)
+
+	var writeColumnsProto []*WriteColumnProto
+	if req.WriteColumns != nil {
+		for _, writeColumn := range *req.WriteColumns {
+			writeColumnProto := WriteColumnProto{}
+			writeColumnProto.Column = writeColumn.Column
+
+			// NULL values are not set
+			if writeColumn.Value != nil && string(*writeColumn.Value) != "null" {
+				valueStr := string([]byte(*writeColumn.Value))
+				writeColumnProto.Value = &valueStr
+			}
+
+			writeColumnsProto = append(writeColumnsProto, &writeColumnProto)
+		}
+	}
+	pkWriteRequestProto.WriteColumns = writeColumnsProto
+
+	pkWriteRequestProto.DB = req.DB
+	pkWriteRequestProto.Table = req.Table
+	pkWriteRequestProto.Operation = req.Operation
+	pkWriteRequestProto.OperationID = req.OperationID
+	pkWriteRequestProto.APIKey = apiKey
+
+	return &pkWriteRequestProto
+}
+
+func ConvertPKWriteRequestProto(reqProto *PKWriteRequestProto) (*PKWriteParams, string) {
+	pkWriteParams := PKWriteParams{}
+
+	pkWriteParams.DB = reqProto.DB
+	pkWriteParams.Table = reqProto.Table
+	pkWriteParams.Operation = reqProto.Operation
+	pkWriteParams.OperationID = reqProto.OperationID
+	pkWriteParams.Filters = convertFiltersProto(reqProto.Filters)
+
+	var writeColumns []WriteColumn
+	for _, writeColumnProto := range reqProto.GetWriteColumns() {
+		if writeColumnProto != nil {
+			writeColumn := WriteColumn{}
+
+			writeColumn.Column = writeColumnProto.Column
+			if writeColumnProto.Value != nil {
+				rawMsg := json.RawMessage([]byte(*writeColumnProto.Value))
+				writeColumn.Value = &rawMsg
+			}
+
+			writeColumns = append(writeColumns, writeColumn)
+		}
+	}
+	if len(writeColumns) > 0 {
+		pkWriteParams.WriteColumns = &writeColumns
+	} else {
+		pkWriteParams.WriteColumns = nil
+	}
+
+	return &pkWriteParams, reqProto.GetAPIKey() /*may return empty string*/
+}
+
+// Converters for PK Write Response
+func ConvertPKWriteResponseProto(respProto *PKWriteResponseProto) *PKWriteResponseGRPC {
+	resp := PKWriteResponseGRPC{}
+	resp.OperationID = respProto.OperationID
+	return &resp
+}
+
+func ConvertPKWriteResponse(resp *PKWriteResponseGRPC) *PKWriteResponseProto {
+	respProto := PKWriteResponseProto{}
+	respProto.OperationID = resp.OperationID
+	return &respProto
+}
+
+// Converters for PK Delete Request
+func ConvertPKDeleteParams(req *PKWriteParams, apiKey *string) *PKDeleteRequestProto {
+	pkDeleteRequestProto := PKDeleteRequestProto{}
+	pkDeleteRequestProto.Filters = convertFilters(req.Filters)
+	pkDeleteRequestProto.DB = req.DB
+	pkDeleteRequestProto.Table = req.Table
+	pkDeleteRequestProto.OperationID = req.OperationID
+	pkDeleteRequestProto.APIKey = apiKey
+	return &pkDeleteRequestProto
+}
+
+func ConvertPKDeleteRequestProto(reqProto *PKDeleteRequestProto) (*PKWriteParams, string) {
+	pkWriteParams := PKWriteParams{}
+
+	operation := PK_DELETE
+	pkWriteParams.DB = reqProto.DB
+	pkWriteParams.Table = reqProto.Table
+	pkWriteParams.Operation = &operation
+	pkWriteParams.OperationID = reqProto.OperationID
+	pkWriteParams.Filters = convertFiltersProto(reqProto.Filters)
+
+	return &pkWriteParams, reqProto.GetAPIKey() /*may return empty string*/
+}
+
+// Converters for PK Delete Response
+func ConvertPKDeleteResponseProto(respProto *PKDeleteResponseProto) *PKWriteResponseGRPC {
+	resp := PKWriteResponseGRPC{}
+	resp.OperationID = respProto.OperationID
+	return &resp
+}
+
+func ConvertPKDeleteResponse(resp *PKWriteResponseGRPC) *PKDeleteResponseProto {
+	respProto := PKDeleteResponseProto{}
+	respProto.OperationID = resp.OperationID
+	return &respProto
+}
+
 func ConvertBatchRequestProto(reqProto *BatchRequestProto) (*[]*PKReadParams, string) {
 	operations := make([]*PKReadParams, len(reqProto.Operations))
 	for i, operation := range reqProto.Operations {
@@ -176,6 +305,40 @@ func ConvertBatchOpRequest(readParams []*PKReadParams, apiKey *string) *BatchReq
 	return &batchRequestProto
 }
 
+func ConvertBatchTxRequestProto(reqProto *BatchTxRequestProto) (*[]*BatchTxSubOpParams, string) {
+	operations := make([]*BatchTxSubOpParams, len(reqProto.Operations))
+	for i, operation := range reqProto.Operations {
+		operations[i] = &BatchTxSubOpParams{}
+		if operation.Read != nil {
+			operations[i].ReadParams, _ = ConvertPKReadRequestProto(operation.Read)
+		}
+		if operation.Write != nil {
+			operations[i].WriteParams, _ = ConvertPKWriteRequestProto(operation.Write)
+		}
+	}
+	return &operations, reqProto.GetAPIKey()
+}
+
+func ConvertBatchTxOpRequest(operations []*BatchTxSubOpParams, apiKey *string) *BatchTxRequestProto {
+	operationsProto := make([]*BatchTxSubOpProto, len(operations))
+
+	for i, operation := range operations {
+		operationsProto[i] = &BatchTxSubOpProto{}
+		if operation.ReadParams != nil {
+			operationsProto[i].Read = ConvertPKReadParams(operation.ReadParams, nil) // no need to set api key here
+		}
+		if operation.WriteParams != nil {
+			operationsProto[i].Write = ConvertPKWriteParams(operation.WriteParams, nil)
+		}
+	}
+
+	var batchTxRequestProto BatchTxRequestProto
+	batchTxRequestProto.APIKey = apiKey
+	batchTxRequestProto.Operations = operationsProto
+
+	return &batchTxRequestProto
+}
+
 func ConvertBatchResponseProto(responsesProto *BatchResponseProto) *BatchResponseGRPC {
 	pkResponsesWCode := make([]*PKReadResponseWithCodeGRPC, len(responsesProto.Responses))
 	for i, respProto := range responsesProto.Responses {
@@ -199,6 +362,179 @@ func ConvertBatchOpResponse(responses *BatchResponseGRPC) *BatchResponseProto {
 	return &batchResponse
 }
 
+// Converters for Scan Requests
+func ConvertIndexScanParams(req *IndexScanParams, apiKey *string) *IndexScanRequestProto {
+	reqProto := IndexScanRequestProto{}
+	reqProto.APIKey = apiKey
+	reqProto.DB = req.DB
+	reqProto.Table = req.Table
+	reqProto.Index = req.Index
+	reqProto.LowerBound = convertIndexBound(req.LowerBound)
+	reqProto.UpperBound = convertIndexBound(req.UpperBound)
+	reqProto.Descending = req.Descending
+	reqProto.Limit = req.Limit
+	reqProto.ReadColumns = convertReadColumns(req.ReadColumns)
+	reqProto.OperationID = req.OperationID
+	return &reqProto
+}
+
+func ConvertIndexScanRequestProto(reqProto *IndexScanRequestProto) (*IndexScanParams, string) {
+	params := IndexScanParams{}
+	params.DB = reqProto.DB
+	params.Table = reqProto.Table
+	params.Index = reqProto.Index
+	params.LowerBound = convertIndexBoundProto(reqProto.LowerBound)
+	params.UpperBound = convertIndexBoundProto(reqProto.UpperBound)
+	params.Descending = reqProto.Descending
+	params.Limit = reqProto.Limit
+	params.ReadColumns = convertReadColumnsProto(reqProto.ReadColumns)
+	params.OperationID = reqProto.OperationID
+	return &params, reqProto.GetAPIKey()
+}
+
+func ConvertTableScanParams(req *TableScanParams, apiKey *string) *TableScanRequestProto {
+	reqProto := TableScanRequestProto{}
+	reqProto.APIKey = apiKey
+	reqProto.DB = req.DB
+	reqProto.Table = req.Table
+	reqProto.Filter = convertFilterExpr(req.Filter)
+	reqProto.Limit = req.Limit
+	reqProto.ContinuationToken = req.ContinuationToken
+	reqProto.ReadColumns = convertReadColumns(req.ReadColumns)
+	reqProto.OperationID = req.OperationID
+	return &reqProto
+}
+
+func ConvertTableScanRequestProto(reqProto *TableScanRequestProto) (*TableScanParams, string) {
+	params := TableScanParams{}
+	params.DB = reqProto.DB
+	params.Table = reqProto.Table
+	params.Filter = convertFilterExprProto(reqProto.Filter)
+	params.Limit = reqProto.Limit
+	params.ContinuationToken = reqProto.ContinuationToken
+	params.ReadColumns = convertReadColumnsProto(reqProto.ReadColumns)
+	params.OperationID = reqProto.OperationID
+	return &params, reqProto.GetAPIKey()
+}
+
+func convertIndexBound(bound *IndexBound) *IndexBoundProto {
+	if bound == nil {
+		return nil
+	}
+	return &IndexBoundProto{Columns: convertFilters(bound.Columns), Inclusive: bound.Inclusive}
+}
+
+func convertIndexBoundProto(boundProto *IndexBoundProto) *IndexBound {
+	if boundProto == nil {
+		return nil
+	}
+	return &IndexBound{Columns: convertFiltersProto(boundProto.Columns), Inclusive: boundProto.Inclusive}
+}
+
+func convertFilterExpr(expr *FilterExpr) *FilterExprProto {
+	if expr == nil {
+		return nil
+	}
+
+	exprProto := FilterExprProto{}
+	exprProto.Op = expr.Op
+	exprProto.Column = expr.Column
+	exprProto.Value = rawMsgToString(expr.Value)
+	exprProto.Lower = rawMsgToString(expr.Lower)
+	exprProto.Upper = rawMsgToString(expr.Upper)
+	if expr.Operands != nil {
+		for i := range *expr.Operands {
+			exprProto.Operands = append(exprProto.Operands, convertFilterExpr(&(*expr.Operands)[i]))
+		}
+	}
+	return &exprProto
+}
+
+func convertFilterExprProto(exprProto *FilterExprProto) *FilterExpr {
+	if exprProto == nil {
+		return nil
+	}
+
+	expr := FilterExpr{}
+	expr.Op = exprProto.Op
+	expr.Column = exprProto.Column
+	expr.Value = stringToRawMsg(exprProto.Value)
+	expr.Lower = stringToRawMsg(exprProto.Lower)
+	expr.Upper = stringToRawMsg(exprProto.Upper)
+	if len(exprProto.Operands) > 0 {
+		operands := make([]FilterExpr, 0, len(exprProto.Operands))
+		for _, operandProto := range exprProto.Operands {
+			if operandProto != nil {
+				operands = append(operands, *convertFilterExprProto(operandProto))
+			}
+		}
+		expr.Operands = &operands
+	}
+	return &expr
+}
+
+func rawMsgToString(msg *json.RawMessage) *string {
+	if msg == nil {
+		return nil
+	}
+	str := string(*msg)
+	return &str
+}
+
+func stringToRawMsg(str *string) *json.RawMessage {
+	if str == nil {
+		return nil
+	}
+	msg := json.RawMessage([]byte(*str))
+	return &msg
+}
+
+func convertReadColumns(readColumns *[]ReadColumn) []*ReadColumnProto {
+	var readColumnsProto []*ReadColumnProto
+	if readColumns != nil {
+		for _, readColumn := range *readColumns {
+			readColumnsProto = append(readColumnsProto,
+				&ReadColumnProto{Column: readColumn.Column, DataReturnType: readColumn.DataReturnType})
+		}
+	}
+	return readColumnsProto
+}
+
+func convertReadColumnsProto(readColumnsProto []*ReadColumnProto) *[]ReadColumn {
+	var readColumns []ReadColumn
+	for _, readColumnProto := range readColumnsProto {
+		if readColumnProto != nil {
+			readColumns = append(readColumns,
+				ReadColumn{Column: readColumnProto.Column, DataReturnType: readColumnProto.DataReturnType})
+		}
+	}
+	if len(readColumns) > 0 {
+		return &readColumns
+	} else {
+		return nil
+	}
+}
+
+// Converters for Scan Response
+// ConvertScanResponseProto merges the messages of a streamed scan
+func ConvertScanResponseProto(respProtos []*ScanResponseProto) *ScanResponseGRPC {
+	resp := ScanResponseGRPC{}
+	rows := []map[string]*ColumnValueProto{}
+	for _, respProto := range respProtos {
+		if respProto.OperationID != nil {
+			resp.OperationID = respProto.OperationID
+		}
+		if respProto.ContinuationToken != nil {
+			resp.ContinuationToken = respProto.ContinuationToken
+		}
+		for _, row := range respProto.Rows {
+			rows = append(rows, row.Data)
+		}
+	}
+	resp.Rows = &rows
+	return &resp
+}
+
 func ConvertStatRequest(req *StatRequest) *StatRequestProto {
 	return &StatRequestProto{}
 }
@@ -220,12 +556,65 @@ func ConvertStatResponse(resp *StatResponse) *StatResponseProto {
 	rondbStatsProto.NdbObjectsDeletionCount = &resp.RonDBStats.NdbObjectsDeletionCount
 	rondbStatsProto.NdbObjectsTotalCount = &resp.RonDBStats.NdbObjectsTotalCount
 	rondbStatsProto.NdbObjectsFreeCount = &resp.RonDBStats.NdbObjectsFreeCount
+	rondbStatsProto.NdbOpRetries = &resp.RonDBStats.NdbOpRetries
+	rondbStatsProto.NdbOpRetriesExhausted = &resp.RonDBStats.NdbOpRetriesExhausted
 
 	respProto.RonDBStats = &rondbStatsProto
 	respProto.MemoryStats = &memStatsProto
+
+	respProto.DBStats = make([]*DBStatsProto, len(resp.DBStats))
+	for i := range resp.DBStats {
+		dbStats := &resp.DBStats[i]
+		dbStatsProto := DBStatsProto{DB: &dbStats.DB,
+			ReadStats: convertReadStats(&dbStats.ReadStats)}
+		dbStatsProto.Tables = make([]*TableStatsProto, len(dbStats.Tables))
+		for j := range dbStats.Tables {
+			tableStats := &dbStats.Tables[j]
+			dbStatsProto.Tables[j] = &TableStatsProto{Table: &tableStats.Table,
+				ReadStats: convertReadStats(&tableStats.ReadStats)}
+		}
+		respProto.DBStats[i] = &dbStatsProto
+	}
+
+	respProto.ConnectionStats = make([]*ConnectionStatsProto, len(resp.ConnectionStats))
+	for i := range resp.ConnectionStats {
+		connStats := &resp.ConnectionStats[i]
+		nodeID := int32(connStats.NodeID)
+		respProto.ConnectionStats[i] = &ConnectionStatsProto{
+			NodeID:              &nodeID,
+			NdbObjectsCount:     &connStats.NdbObjectsCount,
+			NdbObjectsFreeCount: &connStats.NdbObjectsFreeCount,
+			NdbObjectsInUse:     &connStats.NdbObjectsInUse,
+			Requests:            &connStats.Requests,
+		}
+	}
 	return &respProto
 }
 
+func convertReadStats(stats *metrics.ReadStats) *ReadStatsProto {
+	return &ReadStatsProto{
+		Reads:         &stats.Reads,
+		Found:         &stats.Found,
+		NotFound:      &stats.NotFound,
+		Errors:        &stats.Errors,
+		BytesReturned: &stats.BytesReturned,
+		P50LatencyUs:  &stats.P50LatencyUs,
+		P99LatencyUs:  &stats.P99LatencyUs,
+	}
+}
+
+func convertReadStatsProto(statsProto *ReadStatsProto) metrics.ReadStats {
+	return metrics.ReadStats{
+		Reads:         statsProto.GetReads(),
+		Found:         statsProto.GetFound(),
+		NotFound:      statsProto.GetNotFound(),
+		Errors:        statsProto.GetErrors(),
+		BytesReturned: statsProto.GetBytesReturned(),
+		P50LatencyUs:  statsProto.GetP50LatencyUs(),
+		P99LatencyUs:  statsProto.GetP99LatencyUs(),
+	}
+}
+
 func ConvertStatResponseProto(resp *StatResponseProto) *StatResponse {
 	statResponse := StatResponse{}
 	memoryStats := dal.MemoryStats{}
@@ -240,8 +629,178 @@ func ConvertStatResponseProto(resp *StatResponseProto) *StatResponse {
 	ronDBStats.NdbObjectsDeletionCount = *resp.RonDBStats.NdbObjectsDeletionCount
 	ronDBStats.NdbObjectsTotalCount = *resp.RonDBStats.NdbObjectsTotalCount
 	ronDBStats.NdbObjectsFreeCount = *resp.RonDBStats.NdbObjectsFreeCount
+	ronDBStats.NdbOpRetries = resp.RonDBStats.GetNdbOpRetries()
+	ronDBStats.NdbOpRetriesExhausted = resp.RonDBStats.GetNdbOpRetriesExhausted()
 
 	statResponse.MemoryStats = memoryStats
 	statResponse.RonDBStats = ronDBStats
+
+	statResponse.DBStats = make([]metrics.DBStats, len(resp.DBStats))
+	for i, dbStatsProto := range resp.DBStats {
+		dbStats := metrics.DBStats{DB: dbStatsProto.GetDB(),
+			ReadStats: convertReadStatsProto(dbStatsProto.GetReadStats())}
+		dbStats.Tables = make([]metrics.TableStats, len(dbStatsProto.Tables))
+		for j, tableStatsProto := range dbStatsProto.Tables {
+			dbStats.Tables[j] = metrics.TableStats{Table: tableStatsProto.GetTable(),
+				ReadStats: convertReadStatsProto(tableStatsProto.GetReadStats())}
+		}
+		statResponse.DBStats[i] = dbStats
+	}
+
+	statResponse.ConnectionStats = make([]dal.ConnectionStats, len(resp.ConnectionStats))
+	for i, connStatsProto := range resp.ConnectionStats {
+		statResponse.ConnectionStats[i] = dal.ConnectionStats{
+			NodeID:              int(connStatsProto.GetNodeID()),
+			NdbObjectsCount:     connStatsProto.GetNdbObjectsCount(),
+			NdbObjectsFreeCount: connStatsProto.GetNdbObjectsFreeCount(),
+			NdbObjectsInUse:     connStatsProto.GetNdbObjectsInUse(),
+			Requests:            connStatsProto.GetRequests(),
+		}
+	}
 	return &statResponse
 }
+
+func ConvertTableSchemaParams(req *TableSchemaParams, apiKey *string) *TableSchemaRequestProto {
+	return &TableSchemaRequestProto{APIKey: apiKey, DB: req.DB, Table: req.Table}
+}
+
+func ConvertTableSchemaRequestProto(reqProto *TableSchemaRequestProto) (*TableSchemaParams, string) {
+	return &TableSchemaParams{DB: reqProto.DB, Table: reqProto.Table}, reqProto.GetAPIKey()
+}
+
+func ConvertTableSchemaResponse(resp *TableSchemaResponse) *TableSchemaResponseProto {
+	respProto := TableSchemaResponseProto{DB: &resp.DB, Table: &resp.Table}
+
+	respProto.Columns = make([]*ColumnSchemaProto, len(resp.Columns))
+	for i := range resp.Columns {
+		col := &resp.Columns[i]
+		length, precision, scale := int32(col.Length), int32(col.Precision), int32(col.Scale)
+		colProto := ColumnSchemaProto{
+			Name:       &col.Name,
+			NdbType:    &col.NdbType,
+			MySQLType:  &col.MySQLType,
+			Length:     &length,
+			Precision:  &precision,
+			Scale:      &scale,
+			Nullable:   &col.Nullable,
+			PrimaryKey: &col.PrimaryKey,
+		}
+		if col.Charset != "" {
+			colProto.Charset = &col.Charset
+		}
+		respProto.Columns[i] = &colProto
+	}
+
+	respProto.Indexes = make([]*IndexSchemaProto, len(resp.Indexes))
+	for i := range resp.Indexes {
+		index := &resp.Indexes[i]
+		respProto.Indexes[i] = &IndexSchemaProto{Name: &index.Name, Type: &index.Type,
+			Columns: index.Columns}
+	}
+	return &respProto
+}
+
+func ConvertTableSchemaResponseProto(respProto *TableSchemaResponseProto) *TableSchemaResponse {
+	resp := TableSchemaResponse{DB: respProto.GetDB(), Table: respProto.GetTable()}
+
+	resp.Columns = make([]dal.ColumnSchema, len(respProto.Columns))
+	for i, colProto := range respProto.Columns {
+		resp.Columns[i] = dal.ColumnSchema{
+			Name:       colProto.GetName(),
+			NdbType:    colProto.GetNdbType(),
+			MySQLType:  colProto.GetMySQLType(),
+			Length:     int(colProto.GetLength()),
+			Precision:  int(colProto.GetPrecision()),
+			Scale:      int(colProto.GetScale()),
+			Charset:    colProto.GetCharset(),
+			Nullable:   colProto.GetNullable(),
+			PrimaryKey: colProto.GetPrimaryKey(),
+		}
+	}
+
+	resp.Indexes = make([]dal.IndexSchema, len(respProto.Indexes))
+	for i, indexProto := range respProto.Indexes {
+		resp.Indexes[i] = dal.IndexSchema{Name: indexProto.GetName(), Type: indexProto.GetType(),
+			Columns: indexProto.GetColumns()}
+	}
+	return &resp
+}
+
+func ConvertDatabasesRequest(apiKey *string) *DatabasesRequestProto {
+	return &DatabasesRequestProto{APIKey: apiKey}
+}
+
+func ConvertDatabasesResponse(resp *DatabasesResponse) *DatabasesResponseProto {
+	return &DatabasesResponseProto{Databases: resp.Databases}
+}
+
+func ConvertDatabasesResponseProto(respProto *DatabasesResponseProto) *DatabasesResponse {
+	return &DatabasesResponse{Databases: append([]string{}, respProto.GetDatabases()...)}
+}
+
+func ConvertTablesParams(req *TablesParams, apiKey *string) *TablesRequestProto {
+	return &TablesRequestProto{APIKey: apiKey, DB: req.DB}
+}
+
+func ConvertTablesRequestProto(reqProto *TablesRequestProto) (*TablesParams, string) {
+	return &TablesParams{DB: reqProto.DB}, reqProto.GetAPIKey()
+}
+
+func ConvertTablesResponse(resp *TablesResponse) *TablesResponseProto {
+	return &TablesResponseProto{DB: &resp.DB, Tables: resp.Tables}
+}
+
+func ConvertTablesResponseProto(respProto *TablesResponseProto) *TablesResponse {
+	return &TablesResponse{DB: respProto.GetDB(),
+		Tables: append([]string{}, respProto.GetTables()...)}
+}
+
+// Converters for Feature Vector Requests
+func ConvertFeatureVectorParams(req *FeatureVectorParams, apiKey *string) *FeatureVectorRequestProto {
+	reqProto := FeatureVectorRequestProto{}
+	reqProto.APIKey = apiKey
+	reqProto.Project = req.Project
+	if req.FeatureGroups != nil {
+		for _, featureGroup := range *req.FeatureGroups {
+			featureGroupProto := FeatureGroupProto{Name: featureGroup.Name, Version: featureGroup.Version}
+			if featureGroup.Features != nil {
+				featureGroupProto.Features = *featureGroup.Features
+			}
+			reqProto.FeatureGroups = append(reqProto.FeatureGroups, &featureGroupProto)
+		}
+	}
+	reqProto.Entry = convertFilters(req.Entry)
+	return &reqProto
+}
+
+func ConvertFeatureVectorRequestProto(reqProto *FeatureVectorRequestProto) (*FeatureVectorParams, string) {
+	featureGroups := make([]FeatureGroup, len(reqProto.FeatureGroups))
+	for i, featureGroupProto := range reqProto.FeatureGroups {
+		features := append([]string{}, featureGroupProto.GetFeatures()...)
+		featureGroups[i] = FeatureGroup{Name: featureGroupProto.Name,
+			Version: featureGroupProto.Version, Features: &features}
+	}
+
+	req := FeatureVectorParams{}
+	req.Project = reqProto.Project
+	req.FeatureGroups = &featureGroups
+	req.Entry = convertFiltersProto(reqProto.Entry)
+	return &req, reqProto.GetAPIKey()
+}
+
+func ConvertFeatureVectorResponse(resp *FeatureVectorResponseGRPC) *FeatureVectorResponseProto {
+	respProto := FeatureVectorResponseProto{}
+	if resp.Features != nil {
+		respProto.Features = *resp.Features
+	}
+	if resp.Nulls != nil {
+		respProto.Nulls = *resp.Nulls
+	}
+	return &respProto
+}
+
+func ConvertFeatureVectorResponseProto(respProto *FeatureVectorResponseProto) *FeatureVectorResponseGRPC {
+	features := append([]*ColumnValueProto{}, respProto.GetFeatures()...)
+	nulls := append([]bool{}, respProto.GetNulls()...)
+	return &FeatureVectorResponseGRPC{Features: &features, Nulls: &nulls}
+}
@@ -0,0 +1,159 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Request. The feature groups are the tables <name>_<version> of the
+// project database. All the feature groups are read with the same entry
+type FeatureVectorParams struct {
+	Project       *string         `json:"project"          form:"project"          binding:"required,min=1,max=64"`
+	FeatureGroups *[]FeatureGroup `json:"featureGroups"    form:"feature-groups"   binding:"required,min=1,max=4096,dive"`
+	Entry         *[]Filter       `json:"entry"            form:"entry"            binding:"required,min=1,max=4096,dive"`
+}
+
+type FeatureGroup struct {
+	Name     *string   `json:"name"        form:"name"        binding:"required,min=1,max=60"`
+	Version  *uint32   `json:"version"     form:"version"     binding:"required"`
+	Features *[]string `json:"features"    form:"features"    binding:"required,min=1,max=4096,unique"`
+}
+
+// Table returns the table of the feature group
+func (f *FeatureGroup) Table() string {
+	return fmt.Sprintf("%s_%d", *f.Name, *f.Version)
+}
+
+// Response. The features are ordered as in the request. Nulls marks the
+// features that are null, either because the value is NULL or because
+// the feature group has no row for the entry
+type FeatureVectorResponse interface {
+	Init()
+	// GetBatchResponse returns the response of the batch that reads the feature groups
+	GetBatchResponse() BatchOpResponse
+	// SetFeatures flattens the rows of the batch response into the feature
+	// vector. It returns the status of the first read that failed
+	SetFeatures(featureGroups []FeatureGroup) (int, error)
+}
+
+var _ FeatureVectorResponse = (*FeatureVectorResponseJSON)(nil)
+var _ FeatureVectorResponse = (*FeatureVectorResponseGRPC)(nil)
+
+type FeatureVectorResponseJSON struct {
+	Features *[]*json.RawMessage `json:"features"    form:"features"    binding:"required"`
+	Nulls    *[]bool             `json:"nulls"       form:"nulls"       binding:"required"`
+	batch    *BatchResponseJSON
+}
+
+type FeatureVectorResponseGRPC struct {
+	Features *[]*ColumnValueProto `json:"features"    form:"features"    binding:"required"`
+	Nulls    *[]bool              `json:"nulls"       form:"nulls"       binding:"required"`
+	batch    *BatchResponseGRPC
+}
+
+func (f *FeatureVectorResponseJSON) Init() {
+	features := []*json.RawMessage{}
+	f.Features = &features
+	nulls := []bool{}
+	f.Nulls = &nulls
+	f.batch = &BatchResponseJSON{}
+	f.batch.Init()
+}
+
+func (f *FeatureVectorResponseJSON) GetBatchResponse() BatchOpResponse {
+	return f.batch
+}
+
+func (f *FeatureVectorResponseJSON) SetFeatures(featureGroups []FeatureGroup) (int, error) {
+	if len(*f.batch.Result) != len(featureGroups) {
+		return http.StatusInternalServerError, fmt.Errorf("Wrong number of feature group reads")
+	}
+
+	for i, subResp := range *f.batch.Result {
+		status, err := featureGroupStatus(subResp.Code, &featureGroups[i])
+		if err != nil {
+			return status, err
+		}
+
+		for _, feature := range *featureGroups[i].Features {
+			var value *json.RawMessage
+			if status == http.StatusOK {
+				value = (*subResp.Body.Data)[feature]
+			}
+			*f.Features = append(*f.Features, value)
+			*f.Nulls = append(*f.Nulls, value == nil)
+		}
+	}
+	return http.StatusOK, nil
+}
+
+func (f *FeatureVectorResponseGRPC) Init() {
+	features := []*ColumnValueProto{}
+	f.Features = &features
+	nulls := []bool{}
+	f.Nulls = &nulls
+	f.batch = &BatchResponseGRPC{}
+	f.batch.Init()
+}
+
+func (f *FeatureVectorResponseGRPC) GetBatchResponse() BatchOpResponse {
+	return f.batch
+}
+
+func (f *FeatureVectorResponseGRPC) SetFeatures(featureGroups []FeatureGroup) (int, error) {
+	if len(*f.batch.Result) != len(featureGroups) {
+		return http.StatusInternalServerError, fmt.Errorf("Wrong number of feature group reads")
+	}
+
+	for i, subResp := range *f.batch.Result {
+		status, err := featureGroupStatus(subResp.Code, &featureGroups[i])
+		if err != nil {
+			return status, err
+		}
+
+		for _, feature := range *featureGroups[i].Features {
+			var value *ColumnValueProto
+			if status == http.StatusOK {
+				value = (*subResp.Body.Values)[feature]
+			}
+			null := value == nil || value.GetNullValue()
+			if value == nil {
+				value = NewColumnValueProto(nil, 0)
+			}
+			*f.Features = append(*f.Features, value)
+			*f.Nulls = append(*f.Nulls, null)
+		}
+	}
+	return http.StatusOK, nil
+}
+
+// featureGroupStatus returns the status of the read of a feature group.
+// Entries that are not found in the feature group are not an error
+func featureGroupStatus(code *int32, featureGroup *FeatureGroup) (int, error) {
+	if code == nil {
+		return http.StatusInternalServerError,
+			fmt.Errorf("No status for feature group %s", featureGroup.Table())
+	}
+	if *code != http.StatusOK && *code != http.StatusNotFound {
+		return int(*code), fmt.Errorf("Failed to read feature group %s", featureGroup.Table())
+	}
+	return int(*code), nil
+}
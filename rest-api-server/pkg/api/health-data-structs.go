@@ -0,0 +1,52 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+const (
+	HEALTH_STATUS_UP   = "UP"
+	HEALTH_STATUS_DOWN = "DOWN"
+)
+
+// names of the readiness checks
+const (
+	RONDB_CONNECTION_CHECK = "rondb_connection"
+	DATA_NODES_CHECK       = "data_nodes"
+	API_KEY_TABLES_CHECK   = "api_key_tables"
+)
+
+type HealthResponse struct {
+	Status string        `json:"status"`
+	Checks []HealthCheck `json:"checks,omitempty"`
+}
+
+type HealthCheck struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// AddCheck adds the result of a check. The response is DOWN if any of
+// the checks is DOWN
+func (h *HealthResponse) AddCheck(name string, up bool, message string) {
+	check := HealthCheck{Name: name, Status: HEALTH_STATUS_UP, Message: message}
+	if !up {
+		check.Status = HEALTH_STATUS_DOWN
+		h.Status = HEALTH_STATUS_DOWN
+	}
+	h.Checks = append(h.Checks, check)
+}
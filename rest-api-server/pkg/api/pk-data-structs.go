@@ -16,20 +16,18 @@
  */
 package api
 
-/*
-#include "./../../../data-access-rondb/src/rdrs-const.h"
-#include "./../../../data-access-rondb/src/rdrs-dal.h"
-*/
-import "C"
 import (
 	"encoding/json"
 	"fmt"
+
+	"hopsworks.ai/rdrs/internal/dal/dalapi"
 )
 
 // Request
 type PKReadParams struct {
 	DB          *string       `json:"db" `
 	Table       *string       `json:"table"`
+	Index       *string       `json:"index"` // unique index for unique key reads. nil for pk reads
 	Filters     *[]Filter     `json:"filters"`
 	ReadColumns *[]ReadColumn `json:"readColumns"`
 	OperationID *string       `json:"operationId"`
@@ -47,6 +45,15 @@ type PKReadBody struct {
 	OperationID *string       `json:"operationId"    form:"operation-id"    binding:"omitempty,min=1,max=64"`
 }
 
+// UniqueKeyReadBody is the body of unique key reads.
+// The filters contain the unique key columns
+type UniqueKeyReadBody struct {
+	Index       *string       `json:"index"           form:"index"           binding:"required,min=1,max=64"`
+	Filters     *[]Filter     `json:"filters"         form:"filters"         binding:"required,min=1,max=4096,dive"`
+	ReadColumns *[]ReadColumn `json:"readColumns"    form:"read-columns"    binding:"omitempty,min=1,max=4096,unique"`
+	OperationID *string       `json:"operationId"    form:"operation-id"    binding:"omitempty,min=1,max=64"`
+}
+
 type Filter struct {
 	Column *string          `json:"column"   form:"column"   binding:"required,min=1,max=64"`
 	Value  *json.RawMessage `json:"value"    form:"value"    binding:"required"`
@@ -54,8 +61,9 @@ type Filter struct {
 
 const (
 	DRT_DEFAULT = "default"
-	DRT_BASE64  = "base64" // not implemented yet
-	DRT_HEX     = "hex"    // not implemented yet
+	DRT_BASE64  = "base64" // binary and string columns
+	DRT_HEX     = "hex"    // binary and string columns
+	DRT_STRING  = "string" // numbers are returned as strings
 )
 
 type ReadColumn struct {
@@ -63,9 +71,10 @@ type ReadColumn struct {
 
 	// You can change the return type for the column data
 	// int/floats/decimal are returned as JSON Number type (default),
-	// varchar/char are returned as strings (default) and varbinary as base64 (default)
-	// Right now only default return type is supported
-	DataReturnType *string `json:"dataReturnType"    form:"column"    binding:"Enum=default,min=1,max=64"`
+	// varchar/char are returned as strings (default) and varbinary as base64 (default).
+	// Binary and string columns can also be returned as base64 or hex encoded strings,
+	// and numbers can be returned as strings
+	DataReturnType *string `json:"dataReturnType"    form:"column"    binding:"omitempty,oneof=default base64 hex string"`
 
 	// more parameter can be added later.
 }
@@ -90,11 +99,16 @@ type PKReadResponseJSON struct {
 type PKReadResponseGRPC struct {
 	OperationID *string             `json:"operationId"    form:"operation-id"    binding:"omitempty,min=1,max=64"`
 	Data        *map[string]*string `json:"data"           form:"data"            binding:"omitempty"`
+
+	// typed column values
+	Values *map[string]*ColumnValueProto `json:"-"`
 }
 
 func (r *PKReadResponseGRPC) Init() {
 	m := make(map[string]*string)
 	(*r).Data = &m
+	v := make(map[string]*ColumnValueProto)
+	(*r).Values = &v
 }
 
 func (r *PKReadResponseGRPC) SetOperationID(opID *string) {
@@ -107,6 +121,7 @@ func (r *PKReadResponseGRPC) SetColumnData(column, value *string, valueType uint
 	} else {
 		(*(*r).Data)[*column] = value
 	}
+	(*(*r).Values)[*column] = NewColumnValueProto(value, valueType)
 }
 
 func (r *PKReadResponseJSON) Init() {
@@ -119,18 +134,25 @@ func (r *PKReadResponseJSON) SetOperationID(opID *string) {
 }
 
 func (r *PKReadResponseJSON) SetColumnData(column, value *string, dataType uint32) {
+	(*(*r).Data)[*column] = jsonColumnValue(value, dataType)
+}
+
+// jsonColumnValue returns the column data as JSON. Numbers are returned
+// as JSON numbers and everything else as JSON strings
+func jsonColumnValue(value *string, dataType uint32) *json.RawMessage {
 	if value == nil {
-		(*(*r).Data)[*column] = nil
-	} else {
-		if dataType == C.RDRS_INTEGER_DATATYPE || dataType == C.RDRS_FLOAT_DATATYPE {
-			valueBytes := json.RawMessage(*value)
-			(*(*r).Data)[*column] = &valueBytes
-		} else {
-			quotedString := fmt.Sprintf("\"%s\"", *value)
-			valueBytes := json.RawMessage(quotedString)
-			(*(*r).Data)[*column] = &valueBytes
-		}
+		return nil
 	}
+
+	if dataType == dalapi.RDRS_INTEGER_DATATYPE || dataType == dalapi.RDRS_UNSIGNED_INTEGER_DATATYPE ||
+		dataType == dalapi.RDRS_FLOAT_DATATYPE || dataType == dalapi.RDRS_DECIMAL_DATATYPE {
+		valueBytes := json.RawMessage(*value)
+		return &valueBytes
+	}
+
+	quotedString := fmt.Sprintf("\"%s\"", *value)
+	valueBytes := json.RawMessage(quotedString)
+	return &valueBytes
 }
 
 var _ PKReadResponse = (*PKReadResponseGRPC)(nil)
@@ -0,0 +1,84 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+package api
+
+import "encoding/json"
+
+// Write operations
+const (
+	PK_INSERT = "insert"
+	PK_UPDATE = "update"
+	PK_UPSERT = "upsert"
+	PK_DELETE = "delete"
+)
+
+// Request
+type PKWriteParams struct {
+	DB           *string        `json:"db" `
+	Table        *string        `json:"table"`
+	Operation    *string        `json:"operation"`
+	Filters      *[]Filter      `json:"filters"`
+	WriteColumns *[]WriteColumn `json:"writeColumns"`
+	OperationID  *string        `json:"operationId"`
+}
+
+type PKWriteBody struct {
+	Filters      *[]Filter      `json:"filters"         form:"filters"         binding:"required,min=1,max=4096,dive"`
+	WriteColumns *[]WriteColumn `json:"writeColumns"    form:"write-columns"   binding:"omitempty,min=1,max=4096,dive"`
+	OperationID  *string        `json:"operationId"     form:"operation-id"    binding:"omitempty,min=1,max=64"`
+}
+
+type PKDeleteBody struct {
+	Filters     *[]Filter `json:"filters"         form:"filters"         binding:"required,min=1,max=4096,dive"`
+	OperationID *string   `json:"operationId"     form:"operation-id"    binding:"omitempty,min=1,max=64"`
+}
+
+type WriteColumn struct {
+	Column *string `json:"column"   form:"column"   binding:"required,min=1,max=64"`
+
+	// JSON null or missing value sets the column to NULL
+	Value *json.RawMessage `json:"value"    form:"value"`
+}
+
+// Response
+type PKWriteResponse interface {
+	Init()
+	SetOperationID(opID *string)
+}
+
+type PKWriteResponseJSON struct {
+	OperationID *string `json:"operationId"    form:"operation-id"    binding:"omitempty,min=1,max=64"`
+}
+
+type PKWriteResponseGRPC struct {
+	OperationID *string `json:"operationId"    form:"operation-id"    binding:"omitempty,min=1,max=64"`
+}
+
+func (r *PKWriteResponseJSON) Init() {}
+
+func (r *PKWriteResponseJSON) SetOperationID(opID *string) {
+	r.OperationID = opID
+}
+
+func (r *PKWriteResponseGRPC) Init() {}
+
+func (r *PKWriteResponseGRPC) SetOperationID(opID *string) {
+	r.OperationID = opID
+}
+
+var _ PKWriteResponse = (*PKWriteResponseJSON)(nil)
+var _ PKWriteResponse = (*PKWriteResponseGRPC)(nil)
@@ -25,6 +25,7 @@ package api
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -239,7 +240,21 @@ type ColumnValueProto struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// String representation of the value. Kept for backward compatibility
 	Name *string `protobuf:"bytes,1,opt,name=Name" json:"Name,omitempty"`
+	// Typed value based on the column data type. DATE, DATETIME and TIMESTAMP
+	// columns are returned as timestamps in UTC, and TIME columns as strings
+	//
+	// Types that are assignable to Value:
+	//	*ColumnValueProto_NullValue
+	//	*ColumnValueProto_Int64Value
+	//	*ColumnValueProto_Uint64Value
+	//	*ColumnValueProto_DoubleValue
+	//	*ColumnValueProto_StringValue
+	//	*ColumnValueProto_BytesValue
+	//	*ColumnValueProto_TimestampValue
+	//	*ColumnValueProto_DecimalValue
+	Value isColumnValueProto_Value `protobuf_oneof:"Value"`
 }
 
 func (x *ColumnValueProto) Reset() {
@@ -281,6 +296,121 @@ func (x *ColumnValueProto) GetName() string {
 	return ""
 }
 
+func (m *ColumnValueProto) GetValue() isColumnValueProto_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *ColumnValueProto) GetNullValue() bool {
+	if x, ok := x.GetValue().(*ColumnValueProto_NullValue); ok {
+		return x.NullValue
+	}
+	return false
+}
+
+func (x *ColumnValueProto) GetInt64Value() int64 {
+	if x, ok := x.GetValue().(*ColumnValueProto_Int64Value); ok {
+		return x.Int64Value
+	}
+	return 0
+}
+
+func (x *ColumnValueProto) GetUint64Value() uint64 {
+	if x, ok := x.GetValue().(*ColumnValueProto_Uint64Value); ok {
+		return x.Uint64Value
+	}
+	return 0
+}
+
+func (x *ColumnValueProto) GetDoubleValue() float64 {
+	if x, ok := x.GetValue().(*ColumnValueProto_DoubleValue); ok {
+		return x.DoubleValue
+	}
+	return 0
+}
+
+func (x *ColumnValueProto) GetStringValue() string {
+	if x, ok := x.GetValue().(*ColumnValueProto_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *ColumnValueProto) GetBytesValue() []byte {
+	if x, ok := x.GetValue().(*ColumnValueProto_BytesValue); ok {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (x *ColumnValueProto) GetTimestampValue() *timestamppb.Timestamp {
+	if x, ok := x.GetValue().(*ColumnValueProto_TimestampValue); ok {
+		return x.TimestampValue
+	}
+	return nil
+}
+
+func (x *ColumnValueProto) GetDecimalValue() string {
+	if x, ok := x.GetValue().(*ColumnValueProto_DecimalValue); ok {
+		return x.DecimalValue
+	}
+	return ""
+}
+
+type isColumnValueProto_Value interface {
+	isColumnValueProto_Value()
+}
+
+type ColumnValueProto_NullValue struct {
+	NullValue bool `protobuf:"varint,2,opt,name=NullValue,oneof"`
+}
+
+type ColumnValueProto_Int64Value struct {
+	Int64Value int64 `protobuf:"varint,3,opt,name=Int64Value,oneof"`
+}
+
+type ColumnValueProto_Uint64Value struct {
+	Uint64Value uint64 `protobuf:"varint,4,opt,name=Uint64Value,oneof"`
+}
+
+type ColumnValueProto_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,5,opt,name=DoubleValue,oneof"`
+}
+
+type ColumnValueProto_StringValue struct {
+	StringValue string `protobuf:"bytes,6,opt,name=StringValue,oneof"`
+}
+
+type ColumnValueProto_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,7,opt,name=BytesValue,oneof"`
+}
+
+type ColumnValueProto_TimestampValue struct {
+	TimestampValue *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=TimestampValue,oneof"`
+}
+
+type ColumnValueProto_DecimalValue struct {
+	DecimalValue string `protobuf:"bytes,9,opt,name=DecimalValue,oneof"`
+}
+
+func (*ColumnValueProto_NullValue) isColumnValueProto_Value() {}
+
+func (*ColumnValueProto_Int64Value) isColumnValueProto_Value() {}
+
+func (*ColumnValueProto_Uint64Value) isColumnValueProto_Value() {}
+
+func (*ColumnValueProto_DoubleValue) isColumnValueProto_Value() {}
+
+func (*ColumnValueProto_StringValue) isColumnValueProto_Value() {}
+
+func (*ColumnValueProto_BytesValue) isColumnValueProto_Value() {}
+
+func (*ColumnValueProto_TimestampValue) isColumnValueProto_Value() {}
+
+func (*ColumnValueProto_DecimalValue) isColumnValueProto_Value() {}
+
 type PKReadResponseProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -344,18 +474,18 @@ func (x *PKReadResponseProto) GetData() map[string]*ColumnValueProto {
 	return nil
 }
 
-//__________________  Batch Operation ________________________
-type BatchRequestProto struct {
+//__________________  PK Write Operation _________________
+type WriteColumnProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	APIKey     *string               `protobuf:"bytes,1,opt,name=APIKey" json:"APIKey,omitempty"`
-	Operations []*PKReadRequestProto `protobuf:"bytes,2,rep,name=operations" json:"operations,omitempty"`
+	Column *string `protobuf:"bytes,1,req,name=Column" json:"Column,omitempty"`
+	Value  *string `protobuf:"bytes,2,opt,name=Value" json:"Value,omitempty"` // NULL if not set
 }
 
-func (x *BatchRequestProto) Reset() {
-	*x = BatchRequestProto{}
+func (x *WriteColumnProto) Reset() {
+	*x = WriteColumnProto{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_rdrs_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -363,13 +493,13 @@ func (x *BatchRequestProto) Reset() {
 	}
 }
 
-func (x *BatchRequestProto) String() string {
+func (x *WriteColumnProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchRequestProto) ProtoMessage() {}
+func (*WriteColumnProto) ProtoMessage() {}
 
-func (x *BatchRequestProto) ProtoReflect() protoreflect.Message {
+func (x *WriteColumnProto) ProtoReflect() protoreflect.Message {
 	mi := &file_api_rdrs_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -381,35 +511,41 @@ func (x *BatchRequestProto) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchRequestProto.ProtoReflect.Descriptor instead.
-func (*BatchRequestProto) Descriptor() ([]byte, []int) {
+// Deprecated: Use WriteColumnProto.ProtoReflect.Descriptor instead.
+func (*WriteColumnProto) Descriptor() ([]byte, []int) {
 	return file_api_rdrs_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *BatchRequestProto) GetAPIKey() string {
-	if x != nil && x.APIKey != nil {
-		return *x.APIKey
+func (x *WriteColumnProto) GetColumn() string {
+	if x != nil && x.Column != nil {
+		return *x.Column
 	}
 	return ""
 }
 
-func (x *BatchRequestProto) GetOperations() []*PKReadRequestProto {
-	if x != nil {
-		return x.Operations
+func (x *WriteColumnProto) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
 	}
-	return nil
+	return ""
 }
 
-type BatchResponseProto struct {
+type PKWriteRequestProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Responses []*PKReadResponseProto `protobuf:"bytes,1,rep,name=responses" json:"responses,omitempty"`
+	APIKey       *string             `protobuf:"bytes,1,opt,name=APIKey" json:"APIKey,omitempty"`
+	DB           *string             `protobuf:"bytes,2,req,name=DB" json:"DB,omitempty"`
+	Table        *string             `protobuf:"bytes,3,req,name=Table" json:"Table,omitempty"`
+	Operation    *string             `protobuf:"bytes,4,req,name=Operation" json:"Operation,omitempty"` // insert, update or upsert
+	Filters      []*FilterProto      `protobuf:"bytes,5,rep,name=Filters" json:"Filters,omitempty"`
+	WriteColumns []*WriteColumnProto `protobuf:"bytes,6,rep,name=WriteColumns" json:"WriteColumns,omitempty"`
+	OperationID  *string             `protobuf:"bytes,7,opt,name=OperationID" json:"OperationID,omitempty"`
 }
 
-func (x *BatchResponseProto) Reset() {
-	*x = BatchResponseProto{}
+func (x *PKWriteRequestProto) Reset() {
+	*x = PKWriteRequestProto{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_rdrs_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -417,13 +553,13 @@ func (x *BatchResponseProto) Reset() {
 	}
 }
 
-func (x *BatchResponseProto) String() string {
+func (x *PKWriteRequestProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchResponseProto) ProtoMessage() {}
+func (*PKWriteRequestProto) ProtoMessage() {}
 
-func (x *BatchResponseProto) ProtoReflect() protoreflect.Message {
+func (x *PKWriteRequestProto) ProtoReflect() protoreflect.Message {
 	mi := &file_api_rdrs_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -435,31 +571,71 @@ func (x *BatchResponseProto) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchResponseProto.ProtoReflect.Descriptor instead.
-func (*BatchResponseProto) Descriptor() ([]byte, []int) {
+// Deprecated: Use PKWriteRequestProto.ProtoReflect.Descriptor instead.
+func (*PKWriteRequestProto) Descriptor() ([]byte, []int) {
 	return file_api_rdrs_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *BatchResponseProto) GetResponses() []*PKReadResponseProto {
+func (x *PKWriteRequestProto) GetAPIKey() string {
+	if x != nil && x.APIKey != nil {
+		return *x.APIKey
+	}
+	return ""
+}
+
+func (x *PKWriteRequestProto) GetDB() string {
+	if x != nil && x.DB != nil {
+		return *x.DB
+	}
+	return ""
+}
+
+func (x *PKWriteRequestProto) GetTable() string {
+	if x != nil && x.Table != nil {
+		return *x.Table
+	}
+	return ""
+}
+
+func (x *PKWriteRequestProto) GetOperation() string {
+	if x != nil && x.Operation != nil {
+		return *x.Operation
+	}
+	return ""
+}
+
+func (x *PKWriteRequestProto) GetFilters() []*FilterProto {
 	if x != nil {
-		return x.Responses
+		return x.Filters
 	}
 	return nil
 }
 
-type MemoryStatsProto struct {
+func (x *PKWriteRequestProto) GetWriteColumns() []*WriteColumnProto {
+	if x != nil {
+		return x.WriteColumns
+	}
+	return nil
+}
+
+func (x *PKWriteRequestProto) GetOperationID() string {
+	if x != nil && x.OperationID != nil {
+		return *x.OperationID
+	}
+	return ""
+}
+
+type PKWriteResponseProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AllocationsCount   *int64 `protobuf:"varint,1,req,name=AllocationsCount" json:"AllocationsCount,omitempty"`
-	DeallocationsCount *int64 `protobuf:"varint,2,req,name=DeallocationsCount" json:"DeallocationsCount,omitempty"`
-	BuffersCount       *int64 `protobuf:"varint,3,req,name=BuffersCount" json:"BuffersCount,omitempty"`
-	FreeBuffers        *int64 `protobuf:"varint,4,req,name=FreeBuffers" json:"FreeBuffers,omitempty"`
+	OperationID *string `protobuf:"bytes,1,opt,name=OperationID" json:"OperationID,omitempty"`
+	Code        *int32  `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
 }
 
-func (x *MemoryStatsProto) Reset() {
-	*x = MemoryStatsProto{}
+func (x *PKWriteResponseProto) Reset() {
+	*x = PKWriteResponseProto{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_rdrs_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -467,13 +643,13 @@ func (x *MemoryStatsProto) Reset() {
 	}
 }
 
-func (x *MemoryStatsProto) String() string {
+func (x *PKWriteResponseProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemoryStatsProto) ProtoMessage() {}
+func (*PKWriteResponseProto) ProtoMessage() {}
 
-func (x *MemoryStatsProto) ProtoReflect() protoreflect.Message {
+func (x *PKWriteResponseProto) ProtoReflect() protoreflect.Message {
 	mi := &file_api_rdrs_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -485,52 +661,40 @@ func (x *MemoryStatsProto) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemoryStatsProto.ProtoReflect.Descriptor instead.
-func (*MemoryStatsProto) Descriptor() ([]byte, []int) {
+// Deprecated: Use PKWriteResponseProto.ProtoReflect.Descriptor instead.
+func (*PKWriteResponseProto) Descriptor() ([]byte, []int) {
 	return file_api_rdrs_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MemoryStatsProto) GetAllocationsCount() int64 {
-	if x != nil && x.AllocationsCount != nil {
-		return *x.AllocationsCount
-	}
-	return 0
-}
-
-func (x *MemoryStatsProto) GetDeallocationsCount() int64 {
-	if x != nil && x.DeallocationsCount != nil {
-		return *x.DeallocationsCount
-	}
-	return 0
-}
-
-func (x *MemoryStatsProto) GetBuffersCount() int64 {
-	if x != nil && x.BuffersCount != nil {
-		return *x.BuffersCount
+func (x *PKWriteResponseProto) GetOperationID() string {
+	if x != nil && x.OperationID != nil {
+		return *x.OperationID
 	}
-	return 0
+	return ""
 }
 
-func (x *MemoryStatsProto) GetFreeBuffers() int64 {
-	if x != nil && x.FreeBuffers != nil {
-		return *x.FreeBuffers
+func (x *PKWriteResponseProto) GetCode() int32 {
+	if x != nil && x.Code != nil {
+		return *x.Code
 	}
 	return 0
 }
 
-type RonDBStatsProto struct {
+//__________________  PK Delete Operation ________________
+type PKDeleteRequestProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NdbObjectsCreationCount *int64 `protobuf:"varint,1,req,name=NdbObjectsCreationCount" json:"NdbObjectsCreationCount,omitempty"`
-	NdbObjectsDeletionCount *int64 `protobuf:"varint,2,req,name=NdbObjectsDeletionCount" json:"NdbObjectsDeletionCount,omitempty"`
-	NdbObjectsTotalCount    *int64 `protobuf:"varint,3,req,name=NdbObjectsTotalCount" json:"NdbObjectsTotalCount,omitempty"`
-	NdbObjectsFreeCount     *int64 `protobuf:"varint,4,req,name=NdbObjectsFreeCount" json:"NdbObjectsFreeCount,omitempty"`
+	APIKey      *string        `protobuf:"bytes,1,opt,name=APIKey" json:"APIKey,omitempty"`
+	DB          *string        `protobuf:"bytes,2,req,name=DB" json:"DB,omitempty"`
+	Table       *string        `protobuf:"bytes,3,req,name=Table" json:"Table,omitempty"`
+	Filters     []*FilterProto `protobuf:"bytes,4,rep,name=Filters" json:"Filters,omitempty"`
+	OperationID *string        `protobuf:"bytes,5,opt,name=OperationID" json:"OperationID,omitempty"`
 }
 
-func (x *RonDBStatsProto) Reset() {
-	*x = RonDBStatsProto{}
+func (x *PKDeleteRequestProto) Reset() {
+	*x = PKDeleteRequestProto{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_rdrs_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -538,13 +702,13 @@ func (x *RonDBStatsProto) Reset() {
 	}
 }
 
-func (x *RonDBStatsProto) String() string {
+func (x *PKDeleteRequestProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RonDBStatsProto) ProtoMessage() {}
+func (*PKDeleteRequestProto) ProtoMessage() {}
 
-func (x *RonDBStatsProto) ProtoReflect() protoreflect.Message {
+func (x *PKDeleteRequestProto) ProtoReflect() protoreflect.Message {
 	mi := &file_api_rdrs_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -556,47 +720,57 @@ func (x *RonDBStatsProto) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RonDBStatsProto.ProtoReflect.Descriptor instead.
-func (*RonDBStatsProto) Descriptor() ([]byte, []int) {
+// Deprecated: Use PKDeleteRequestProto.ProtoReflect.Descriptor instead.
+func (*PKDeleteRequestProto) Descriptor() ([]byte, []int) {
 	return file_api_rdrs_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RonDBStatsProto) GetNdbObjectsCreationCount() int64 {
-	if x != nil && x.NdbObjectsCreationCount != nil {
-		return *x.NdbObjectsCreationCount
+func (x *PKDeleteRequestProto) GetAPIKey() string {
+	if x != nil && x.APIKey != nil {
+		return *x.APIKey
 	}
-	return 0
+	return ""
 }
 
-func (x *RonDBStatsProto) GetNdbObjectsDeletionCount() int64 {
-	if x != nil && x.NdbObjectsDeletionCount != nil {
-		return *x.NdbObjectsDeletionCount
+func (x *PKDeleteRequestProto) GetDB() string {
+	if x != nil && x.DB != nil {
+		return *x.DB
 	}
-	return 0
+	return ""
 }
 
-func (x *RonDBStatsProto) GetNdbObjectsTotalCount() int64 {
-	if x != nil && x.NdbObjectsTotalCount != nil {
-		return *x.NdbObjectsTotalCount
+func (x *PKDeleteRequestProto) GetTable() string {
+	if x != nil && x.Table != nil {
+		return *x.Table
 	}
-	return 0
+	return ""
 }
 
-func (x *RonDBStatsProto) GetNdbObjectsFreeCount() int64 {
-	if x != nil && x.NdbObjectsFreeCount != nil {
-		return *x.NdbObjectsFreeCount
+func (x *PKDeleteRequestProto) GetFilters() []*FilterProto {
+	if x != nil {
+		return x.Filters
 	}
-	return 0
+	return nil
 }
 
-type StatRequestProto struct {
+func (x *PKDeleteRequestProto) GetOperationID() string {
+	if x != nil && x.OperationID != nil {
+		return *x.OperationID
+	}
+	return ""
+}
+
+type PKDeleteResponseProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	OperationID *string `protobuf:"bytes,1,opt,name=OperationID" json:"OperationID,omitempty"`
+	Code        *int32  `protobuf:"varint,2,opt,name=code" json:"code,omitempty"`
 }
 
-func (x *StatRequestProto) Reset() {
-	*x = StatRequestProto{}
+func (x *PKDeleteResponseProto) Reset() {
+	*x = PKDeleteResponseProto{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_rdrs_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -604,13 +778,13 @@ func (x *StatRequestProto) Reset() {
 	}
 }
 
-func (x *StatRequestProto) String() string {
+func (x *PKDeleteResponseProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatRequestProto) ProtoMessage() {}
+func (*PKDeleteResponseProto) ProtoMessage() {}
 
-func (x *StatRequestProto) ProtoReflect() protoreflect.Message {
+func (x *PKDeleteResponseProto) ProtoReflect() protoreflect.Message {
 	mi := &file_api_rdrs_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -622,22 +796,37 @@ func (x *StatRequestProto) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatRequestProto.ProtoReflect.Descriptor instead.
-func (*StatRequestProto) Descriptor() ([]byte, []int) {
+// Deprecated: Use PKDeleteResponseProto.ProtoReflect.Descriptor instead.
+func (*PKDeleteResponseProto) Descriptor() ([]byte, []int) {
 	return file_api_rdrs_proto_rawDescGZIP(), []int{9}
 }
 
-type StatResponseProto struct {
+func (x *PKDeleteResponseProto) GetOperationID() string {
+	if x != nil && x.OperationID != nil {
+		return *x.OperationID
+	}
+	return ""
+}
+
+func (x *PKDeleteResponseProto) GetCode() int32 {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return 0
+}
+
+//__________________  Batch Operation ________________________
+type BatchRequestProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MemoryStats *MemoryStatsProto `protobuf:"bytes,1,req,name=MemoryStats" json:"MemoryStats,omitempty"`
-	RonDBStats  *RonDBStatsProto  `protobuf:"bytes,2,req,name=RonDBStats" json:"RonDBStats,omitempty"`
+	APIKey     *string               `protobuf:"bytes,1,opt,name=APIKey" json:"APIKey,omitempty"`
+	Operations []*PKReadRequestProto `protobuf:"bytes,2,rep,name=operations" json:"operations,omitempty"`
 }
 
-func (x *StatResponseProto) Reset() {
-	*x = StatResponseProto{}
+func (x *BatchRequestProto) Reset() {
+	*x = BatchRequestProto{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_rdrs_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,13 +834,13 @@ func (x *StatResponseProto) Reset() {
 	}
 }
 
-func (x *StatResponseProto) String() string {
+func (x *BatchRequestProto) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatResponseProto) ProtoMessage() {}
+func (*BatchRequestProto) ProtoMessage() {}
 
-func (x *StatResponseProto) ProtoReflect() protoreflect.Message {
+func (x *BatchRequestProto) ProtoReflect() protoreflect.Message {
 	mi := &file_api_rdrs_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,124 +852,2451 @@ func (x *StatResponseProto) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatResponseProto.ProtoReflect.Descriptor instead.
-func (*StatResponseProto) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchRequestProto.ProtoReflect.Descriptor instead.
+func (*BatchRequestProto) Descriptor() ([]byte, []int) {
 	return file_api_rdrs_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *StatResponseProto) GetMemoryStats() *MemoryStatsProto {
-	if x != nil {
+func (x *BatchRequestProto) GetAPIKey() string {
+	if x != nil && x.APIKey != nil {
+		return *x.APIKey
+	}
+	return ""
+}
+
+func (x *BatchRequestProto) GetOperations() []*PKReadRequestProto {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type BatchResponseProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Responses []*PKReadResponseProto `protobuf:"bytes,1,rep,name=responses" json:"responses,omitempty"`
+}
+
+func (x *BatchResponseProto) Reset() {
+	*x = BatchResponseProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResponseProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponseProto) ProtoMessage() {}
+
+func (x *BatchResponseProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponseProto.ProtoReflect.Descriptor instead.
+func (*BatchResponseProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchResponseProto) GetResponses() []*PKReadResponseProto {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+// Only one of Read and Write is set. Deletes are write operations
+// with the "delete" operation type
+type BatchTxSubOpProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Read  *PKReadRequestProto  `protobuf:"bytes,1,opt,name=Read" json:"Read,omitempty"`
+	Write *PKWriteRequestProto `protobuf:"bytes,2,opt,name=Write" json:"Write,omitempty"`
+}
+
+func (x *BatchTxSubOpProto) Reset() {
+	*x = BatchTxSubOpProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchTxSubOpProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTxSubOpProto) ProtoMessage() {}
+
+func (x *BatchTxSubOpProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTxSubOpProto.ProtoReflect.Descriptor instead.
+func (*BatchTxSubOpProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchTxSubOpProto) GetRead() *PKReadRequestProto {
+	if x != nil {
+		return x.Read
+	}
+	return nil
+}
+
+func (x *BatchTxSubOpProto) GetWrite() *PKWriteRequestProto {
+	if x != nil {
+		return x.Write
+	}
+	return nil
+}
+
+type BatchTxRequestProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	APIKey     *string              `protobuf:"bytes,1,opt,name=APIKey" json:"APIKey,omitempty"`
+	Operations []*BatchTxSubOpProto `protobuf:"bytes,2,rep,name=operations" json:"operations,omitempty"`
+}
+
+func (x *BatchTxRequestProto) Reset() {
+	*x = BatchTxRequestProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchTxRequestProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTxRequestProto) ProtoMessage() {}
+
+func (x *BatchTxRequestProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTxRequestProto.ProtoReflect.Descriptor instead.
+func (*BatchTxRequestProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchTxRequestProto) GetAPIKey() string {
+	if x != nil && x.APIKey != nil {
+		return *x.APIKey
+	}
+	return ""
+}
+
+func (x *BatchTxRequestProto) GetOperations() []*BatchTxSubOpProto {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+//__________________  Scan Operations _______________________
+type IndexBoundProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Columns   []*FilterProto `protobuf:"bytes,1,rep,name=Columns" json:"Columns,omitempty"`
+	Inclusive *bool          `protobuf:"varint,2,opt,name=Inclusive" json:"Inclusive,omitempty"` // inclusive if not set
+}
+
+func (x *IndexBoundProto) Reset() {
+	*x = IndexBoundProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexBoundProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexBoundProto) ProtoMessage() {}
+
+func (x *IndexBoundProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexBoundProto.ProtoReflect.Descriptor instead.
+func (*IndexBoundProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *IndexBoundProto) GetColumns() []*FilterProto {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *IndexBoundProto) GetInclusive() bool {
+	if x != nil && x.Inclusive != nil {
+		return *x.Inclusive
+	}
+	return false
+}
+
+type IndexScanRequestProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	APIKey      *string            `protobuf:"bytes,1,opt,name=APIKey" json:"APIKey,omitempty"`
+	DB          *string            `protobuf:"bytes,2,req,name=DB" json:"DB,omitempty"`
+	Table       *string            `protobuf:"bytes,3,req,name=Table" json:"Table,omitempty"`
+	Index       *string            `protobuf:"bytes,4,req,name=Index" json:"Index,omitempty"`
+	LowerBound  *IndexBoundProto   `protobuf:"bytes,5,opt,name=LowerBound" json:"LowerBound,omitempty"`
+	UpperBound  *IndexBoundProto   `protobuf:"bytes,6,opt,name=UpperBound" json:"UpperBound,omitempty"`
+	Descending  *bool              `protobuf:"varint,7,opt,name=Descending" json:"Descending,omitempty"`
+	Limit       *uint32            `protobuf:"varint,8,opt,name=Limit" json:"Limit,omitempty"`
+	ReadColumns []*ReadColumnProto `protobuf:"bytes,9,rep,name=ReadColumns" json:"ReadColumns,omitempty"`
+	OperationID *string            `protobuf:"bytes,10,opt,name=OperationID" json:"OperationID,omitempty"`
+}
+
+func (x *IndexScanRequestProto) Reset() {
+	*x = IndexScanRequestProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexScanRequestProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexScanRequestProto) ProtoMessage() {}
+
+func (x *IndexScanRequestProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexScanRequestProto.ProtoReflect.Descriptor instead.
+func (*IndexScanRequestProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *IndexScanRequestProto) GetAPIKey() string {
+	if x != nil && x.APIKey != nil {
+		return *x.APIKey
+	}
+	return ""
+}
+
+func (x *IndexScanRequestProto) GetDB() string {
+	if x != nil && x.DB != nil {
+		return *x.DB
+	}
+	return ""
+}
+
+func (x *IndexScanRequestProto) GetTable() string {
+	if x != nil && x.Table != nil {
+		return *x.Table
+	}
+	return ""
+}
+
+func (x *IndexScanRequestProto) GetIndex() string {
+	if x != nil && x.Index != nil {
+		return *x.Index
+	}
+	return ""
+}
+
+func (x *IndexScanRequestProto) GetLowerBound() *IndexBoundProto {
+	if x != nil {
+		return x.LowerBound
+	}
+	return nil
+}
+
+func (x *IndexScanRequestProto) GetUpperBound() *IndexBoundProto {
+	if x != nil {
+		return x.UpperBound
+	}
+	return nil
+}
+
+func (x *IndexScanRequestProto) GetDescending() bool {
+	if x != nil && x.Descending != nil {
+		return *x.Descending
+	}
+	return false
+}
+
+func (x *IndexScanRequestProto) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *IndexScanRequestProto) GetReadColumns() []*ReadColumnProto {
+	if x != nil {
+		return x.ReadColumns
+	}
+	return nil
+}
+
+func (x *IndexScanRequestProto) GetOperationID() string {
+	if x != nil && x.OperationID != nil {
+		return *x.OperationID
+	}
+	return ""
+}
+
+// Values are JSON encoded, the same way as the filter values
+type FilterExprProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op       *string            `protobuf:"bytes,1,req,name=Op" json:"Op,omitempty"`
+	Column   *string            `protobuf:"bytes,2,opt,name=Column" json:"Column,omitempty"`
+	Value    *string            `protobuf:"bytes,3,opt,name=Value" json:"Value,omitempty"`
+	Lower    *string            `protobuf:"bytes,4,opt,name=Lower" json:"Lower,omitempty"`
+	Upper    *string            `protobuf:"bytes,5,opt,name=Upper" json:"Upper,omitempty"`
+	Operands []*FilterExprProto `protobuf:"bytes,6,rep,name=Operands" json:"Operands,omitempty"`
+}
+
+func (x *FilterExprProto) Reset() {
+	*x = FilterExprProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterExprProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterExprProto) ProtoMessage() {}
+
+func (x *FilterExprProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterExprProto.ProtoReflect.Descriptor instead.
+func (*FilterExprProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FilterExprProto) GetOp() string {
+	if x != nil && x.Op != nil {
+		return *x.Op
+	}
+	return ""
+}
+
+func (x *FilterExprProto) GetColumn() string {
+	if x != nil && x.Column != nil {
+		return *x.Column
+	}
+	return ""
+}
+
+func (x *FilterExprProto) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *FilterExprProto) GetLower() string {
+	if x != nil && x.Lower != nil {
+		return *x.Lower
+	}
+	return ""
+}
+
+func (x *FilterExprProto) GetUpper() string {
+	if x != nil && x.Upper != nil {
+		return *x.Upper
+	}
+	return ""
+}
+
+func (x *FilterExprProto) GetOperands() []*FilterExprProto {
+	if x != nil {
+		return x.Operands
+	}
+	return nil
+}
+
+type TableScanRequestProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	APIKey            *string            `protobuf:"bytes,1,opt,name=APIKey" json:"APIKey,omitempty"`
+	DB                *string            `protobuf:"bytes,2,req,name=DB" json:"DB,omitempty"`
+	Table             *string            `protobuf:"bytes,3,req,name=Table" json:"Table,omitempty"`
+	Filter            *FilterExprProto   `protobuf:"bytes,4,opt,name=Filter" json:"Filter,omitempty"`
+	Limit             *uint32            `protobuf:"varint,5,opt,name=Limit" json:"Limit,omitempty"` // all rows if not set
+	ContinuationToken *string            `protobuf:"bytes,6,opt,name=ContinuationToken" json:"ContinuationToken,omitempty"`
+	ReadColumns       []*ReadColumnProto `protobuf:"bytes,7,rep,name=ReadColumns" json:"ReadColumns,omitempty"`
+	OperationID       *string            `protobuf:"bytes,8,opt,name=OperationID" json:"OperationID,omitempty"`
+}
+
+func (x *TableScanRequestProto) Reset() {
+	*x = TableScanRequestProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableScanRequestProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableScanRequestProto) ProtoMessage() {}
+
+func (x *TableScanRequestProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableScanRequestProto.ProtoReflect.Descriptor instead.
+func (*TableScanRequestProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TableScanRequestProto) GetAPIKey() string {
+	if x != nil && x.APIKey != nil {
+		return *x.APIKey
+	}
+	return ""
+}
+
+func (x *TableScanRequestProto) GetDB() string {
+	if x != nil && x.DB != nil {
+		return *x.DB
+	}
+	return ""
+}
+
+func (x *TableScanRequestProto) GetTable() string {
+	if x != nil && x.Table != nil {
+		return *x.Table
+	}
+	return ""
+}
+
+func (x *TableScanRequestProto) GetFilter() *FilterExprProto {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *TableScanRequestProto) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *TableScanRequestProto) GetContinuationToken() string {
+	if x != nil && x.ContinuationToken != nil {
+		return *x.ContinuationToken
+	}
+	return ""
+}
+
+func (x *TableScanRequestProto) GetReadColumns() []*ReadColumnProto {
+	if x != nil {
+		return x.ReadColumns
+	}
+	return nil
+}
+
+func (x *TableScanRequestProto) GetOperationID() string {
+	if x != nil && x.OperationID != nil {
+		return *x.OperationID
+	}
+	return ""
+}
+
+type ScanRowProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data map[string]*ColumnValueProto `protobuf:"bytes,1,rep,name=Data" json:"Data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (x *ScanRowProto) Reset() {
+	*x = ScanRowProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanRowProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanRowProto) ProtoMessage() {}
+
+func (x *ScanRowProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanRowProto.ProtoReflect.Descriptor instead.
+func (*ScanRowProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ScanRowProto) GetData() map[string]*ColumnValueProto {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Scans are streamed in messages of up to 1000 rows. The operation ID is
+// set in the first message and the continuation token in the last one
+type ScanResponseProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationID       *string         `protobuf:"bytes,1,opt,name=OperationID" json:"OperationID,omitempty"`
+	Rows              []*ScanRowProto `protobuf:"bytes,2,rep,name=Rows" json:"Rows,omitempty"`
+	ContinuationToken *string         `protobuf:"bytes,3,opt,name=ContinuationToken" json:"ContinuationToken,omitempty"`
+}
+
+func (x *ScanResponseProto) Reset() {
+	*x = ScanResponseProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanResponseProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanResponseProto) ProtoMessage() {}
+
+func (x *ScanResponseProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanResponseProto.ProtoReflect.Descriptor instead.
+func (*ScanResponseProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ScanResponseProto) GetOperationID() string {
+	if x != nil && x.OperationID != nil {
+		return *x.OperationID
+	}
+	return ""
+}
+
+func (x *ScanResponseProto) GetRows() []*ScanRowProto {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ScanResponseProto) GetContinuationToken() string {
+	if x != nil && x.ContinuationToken != nil {
+		return *x.ContinuationToken
+	}
+	return ""
+}
+
+type MemoryStatsProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllocationsCount   *int64 `protobuf:"varint,1,req,name=AllocationsCount" json:"AllocationsCount,omitempty"`
+	DeallocationsCount *int64 `protobuf:"varint,2,req,name=DeallocationsCount" json:"DeallocationsCount,omitempty"`
+	BuffersCount       *int64 `protobuf:"varint,3,req,name=BuffersCount" json:"BuffersCount,omitempty"`
+	FreeBuffers        *int64 `protobuf:"varint,4,req,name=FreeBuffers" json:"FreeBuffers,omitempty"`
+}
+
+func (x *MemoryStatsProto) Reset() {
+	*x = MemoryStatsProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryStatsProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryStatsProto) ProtoMessage() {}
+
+func (x *MemoryStatsProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryStatsProto.ProtoReflect.Descriptor instead.
+func (*MemoryStatsProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MemoryStatsProto) GetAllocationsCount() int64 {
+	if x != nil && x.AllocationsCount != nil {
+		return *x.AllocationsCount
+	}
+	return 0
+}
+
+func (x *MemoryStatsProto) GetDeallocationsCount() int64 {
+	if x != nil && x.DeallocationsCount != nil {
+		return *x.DeallocationsCount
+	}
+	return 0
+}
+
+func (x *MemoryStatsProto) GetBuffersCount() int64 {
+	if x != nil && x.BuffersCount != nil {
+		return *x.BuffersCount
+	}
+	return 0
+}
+
+func (x *MemoryStatsProto) GetFreeBuffers() int64 {
+	if x != nil && x.FreeBuffers != nil {
+		return *x.FreeBuffers
+	}
+	return 0
+}
+
+type RonDBStatsProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NdbObjectsCreationCount *int64 `protobuf:"varint,1,req,name=NdbObjectsCreationCount" json:"NdbObjectsCreationCount,omitempty"`
+	NdbObjectsDeletionCount *int64 `protobuf:"varint,2,req,name=NdbObjectsDeletionCount" json:"NdbObjectsDeletionCount,omitempty"`
+	NdbObjectsTotalCount    *int64 `protobuf:"varint,3,req,name=NdbObjectsTotalCount" json:"NdbObjectsTotalCount,omitempty"`
+	NdbObjectsFreeCount     *int64 `protobuf:"varint,4,req,name=NdbObjectsFreeCount" json:"NdbObjectsFreeCount,omitempty"`
+	NdbOpRetries            *int64 `protobuf:"varint,5,req,name=NdbOpRetries" json:"NdbOpRetries,omitempty"`
+	NdbOpRetriesExhausted   *int64 `protobuf:"varint,6,req,name=NdbOpRetriesExhausted" json:"NdbOpRetriesExhausted,omitempty"`
+}
+
+func (x *RonDBStatsProto) Reset() {
+	*x = RonDBStatsProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RonDBStatsProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RonDBStatsProto) ProtoMessage() {}
+
+func (x *RonDBStatsProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RonDBStatsProto.ProtoReflect.Descriptor instead.
+func (*RonDBStatsProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RonDBStatsProto) GetNdbObjectsCreationCount() int64 {
+	if x != nil && x.NdbObjectsCreationCount != nil {
+		return *x.NdbObjectsCreationCount
+	}
+	return 0
+}
+
+func (x *RonDBStatsProto) GetNdbObjectsDeletionCount() int64 {
+	if x != nil && x.NdbObjectsDeletionCount != nil {
+		return *x.NdbObjectsDeletionCount
+	}
+	return 0
+}
+
+func (x *RonDBStatsProto) GetNdbObjectsTotalCount() int64 {
+	if x != nil && x.NdbObjectsTotalCount != nil {
+		return *x.NdbObjectsTotalCount
+	}
+	return 0
+}
+
+func (x *RonDBStatsProto) GetNdbObjectsFreeCount() int64 {
+	if x != nil && x.NdbObjectsFreeCount != nil {
+		return *x.NdbObjectsFreeCount
+	}
+	return 0
+}
+
+func (x *RonDBStatsProto) GetNdbOpRetries() int64 {
+	if x != nil && x.NdbOpRetries != nil {
+		return *x.NdbOpRetries
+	}
+	return 0
+}
+
+func (x *RonDBStatsProto) GetNdbOpRetriesExhausted() int64 {
+	if x != nil && x.NdbOpRetriesExhausted != nil {
+		return *x.NdbOpRetriesExhausted
+	}
+	return 0
+}
+
+type ReadStatsProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reads         *int64 `protobuf:"varint,1,req,name=Reads" json:"Reads,omitempty"`
+	Found         *int64 `protobuf:"varint,2,req,name=Found" json:"Found,omitempty"`
+	NotFound      *int64 `protobuf:"varint,3,req,name=NotFound" json:"NotFound,omitempty"`
+	Errors        *int64 `protobuf:"varint,4,req,name=Errors" json:"Errors,omitempty"`
+	BytesReturned *int64 `protobuf:"varint,5,req,name=BytesReturned" json:"BytesReturned,omitempty"`
+	P50LatencyUs  *int64 `protobuf:"varint,6,req,name=P50LatencyUs" json:"P50LatencyUs,omitempty"`
+	P99LatencyUs  *int64 `protobuf:"varint,7,req,name=P99LatencyUs" json:"P99LatencyUs,omitempty"`
+}
+
+func (x *ReadStatsProto) Reset() {
+	*x = ReadStatsProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadStatsProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadStatsProto) ProtoMessage() {}
+
+func (x *ReadStatsProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadStatsProto.ProtoReflect.Descriptor instead.
+func (*ReadStatsProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReadStatsProto) GetReads() int64 {
+	if x != nil && x.Reads != nil {
+		return *x.Reads
+	}
+	return 0
+}
+
+func (x *ReadStatsProto) GetFound() int64 {
+	if x != nil && x.Found != nil {
+		return *x.Found
+	}
+	return 0
+}
+
+func (x *ReadStatsProto) GetNotFound() int64 {
+	if x != nil && x.NotFound != nil {
+		return *x.NotFound
+	}
+	return 0
+}
+
+func (x *ReadStatsProto) GetErrors() int64 {
+	if x != nil && x.Errors != nil {
+		return *x.Errors
+	}
+	return 0
+}
+
+func (x *ReadStatsProto) GetBytesReturned() int64 {
+	if x != nil && x.BytesReturned != nil {
+		return *x.BytesReturned
+	}
+	return 0
+}
+
+func (x *ReadStatsProto) GetP50LatencyUs() int64 {
+	if x != nil && x.P50LatencyUs != nil {
+		return *x.P50LatencyUs
+	}
+	return 0
+}
+
+func (x *ReadStatsProto) GetP99LatencyUs() int64 {
+	if x != nil && x.P99LatencyUs != nil {
+		return *x.P99LatencyUs
+	}
+	return 0
+}
+
+type TableStatsProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Table     *string         `protobuf:"bytes,1,req,name=Table" json:"Table,omitempty"`
+	ReadStats *ReadStatsProto `protobuf:"bytes,2,req,name=ReadStats" json:"ReadStats,omitempty"`
+}
+
+func (x *TableStatsProto) Reset() {
+	*x = TableStatsProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableStatsProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableStatsProto) ProtoMessage() {}
+
+func (x *TableStatsProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableStatsProto.ProtoReflect.Descriptor instead.
+func (*TableStatsProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TableStatsProto) GetTable() string {
+	if x != nil && x.Table != nil {
+		return *x.Table
+	}
+	return ""
+}
+
+func (x *TableStatsProto) GetReadStats() *ReadStatsProto {
+	if x != nil {
+		return x.ReadStats
+	}
+	return nil
+}
+
+type DBStatsProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DB        *string            `protobuf:"bytes,1,req,name=DB" json:"DB,omitempty"`
+	ReadStats *ReadStatsProto    `protobuf:"bytes,2,req,name=ReadStats" json:"ReadStats,omitempty"`
+	Tables    []*TableStatsProto `protobuf:"bytes,3,rep,name=Tables" json:"Tables,omitempty"`
+}
+
+func (x *DBStatsProto) Reset() {
+	*x = DBStatsProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DBStatsProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBStatsProto) ProtoMessage() {}
+
+func (x *DBStatsProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBStatsProto.ProtoReflect.Descriptor instead.
+func (*DBStatsProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DBStatsProto) GetDB() string {
+	if x != nil && x.DB != nil {
+		return *x.DB
+	}
+	return ""
+}
+
+func (x *DBStatsProto) GetReadStats() *ReadStatsProto {
+	if x != nil {
+		return x.ReadStats
+	}
+	return nil
+}
+
+func (x *DBStatsProto) GetTables() []*TableStatsProto {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type ConnectionStatsProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID              *int32 `protobuf:"varint,1,req,name=NodeID" json:"NodeID,omitempty"`
+	NdbObjectsCount     *int64 `protobuf:"varint,2,req,name=NdbObjectsCount" json:"NdbObjectsCount,omitempty"`
+	NdbObjectsFreeCount *int64 `protobuf:"varint,3,req,name=NdbObjectsFreeCount" json:"NdbObjectsFreeCount,omitempty"`
+	NdbObjectsInUse     *int64 `protobuf:"varint,4,req,name=NdbObjectsInUse" json:"NdbObjectsInUse,omitempty"`
+	Requests            *int64 `protobuf:"varint,5,req,name=Requests" json:"Requests,omitempty"`
+}
+
+func (x *ConnectionStatsProto) Reset() {
+	*x = ConnectionStatsProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionStatsProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionStatsProto) ProtoMessage() {}
+
+func (x *ConnectionStatsProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionStatsProto.ProtoReflect.Descriptor instead.
+func (*ConnectionStatsProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ConnectionStatsProto) GetNodeID() int32 {
+	if x != nil && x.NodeID != nil {
+		return *x.NodeID
+	}
+	return 0
+}
+
+func (x *ConnectionStatsProto) GetNdbObjectsCount() int64 {
+	if x != nil && x.NdbObjectsCount != nil {
+		return *x.NdbObjectsCount
+	}
+	return 0
+}
+
+func (x *ConnectionStatsProto) GetNdbObjectsFreeCount() int64 {
+	if x != nil && x.NdbObjectsFreeCount != nil {
+		return *x.NdbObjectsFreeCount
+	}
+	return 0
+}
+
+func (x *ConnectionStatsProto) GetNdbObjectsInUse() int64 {
+	if x != nil && x.NdbObjectsInUse != nil {
+		return *x.NdbObjectsInUse
+	}
+	return 0
+}
+
+func (x *ConnectionStatsProto) GetRequests() int64 {
+	if x != nil && x.Requests != nil {
+		return *x.Requests
+	}
+	return 0
+}
+
+type StatRequestProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatRequestProto) Reset() {
+	*x = StatRequestProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatRequestProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatRequestProto) ProtoMessage() {}
+
+func (x *StatRequestProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatRequestProto.ProtoReflect.Descriptor instead.
+func (*StatRequestProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{26}
+}
+
+type StatResponseProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemoryStats     *MemoryStatsProto       `protobuf:"bytes,1,req,name=MemoryStats" json:"MemoryStats,omitempty"`
+	RonDBStats      *RonDBStatsProto        `protobuf:"bytes,2,req,name=RonDBStats" json:"RonDBStats,omitempty"`
+	DBStats         []*DBStatsProto         `protobuf:"bytes,3,rep,name=DBStats" json:"DBStats,omitempty"`
+	ConnectionStats []*ConnectionStatsProto `protobuf:"bytes,4,rep,name=ConnectionStats" json:"ConnectionStats,omitempty"`
+}
+
+func (x *StatResponseProto) Reset() {
+	*x = StatResponseProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatResponseProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatResponseProto) ProtoMessage() {}
+
+func (x *StatResponseProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatResponseProto.ProtoReflect.Descriptor instead.
+func (*StatResponseProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StatResponseProto) GetMemoryStats() *MemoryStatsProto {
+	if x != nil {
 		return x.MemoryStats
 	}
 	return nil
 }
 
-func (x *StatResponseProto) GetRonDBStats() *RonDBStatsProto {
+func (x *StatResponseProto) GetRonDBStats() *RonDBStatsProto {
+	if x != nil {
+		return x.RonDBStats
+	}
+	return nil
+}
+
+func (x *StatResponseProto) GetDBStats() []*DBStatsProto {
+	if x != nil {
+		return x.DBStats
+	}
+	return nil
+}
+
+func (x *StatResponseProto) GetConnectionStats() []*ConnectionStatsProto {
+	if x != nil {
+		return x.ConnectionStats
+	}
+	return nil
+}
+
+type ColumnSchemaProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       *string `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	NdbType    *string `protobuf:"bytes,2,req,name=NdbType" json:"NdbType,omitempty"`
+	MySQLType  *string `protobuf:"bytes,3,req,name=MySQLType" json:"MySQLType,omitempty"`
+	Length     *int32  `protobuf:"varint,4,req,name=Length" json:"Length,omitempty"`
+	Precision  *int32  `protobuf:"varint,5,req,name=Precision" json:"Precision,omitempty"`
+	Scale      *int32  `protobuf:"varint,6,req,name=Scale" json:"Scale,omitempty"`
+	Charset    *string `protobuf:"bytes,7,opt,name=Charset" json:"Charset,omitempty"`
+	Nullable   *bool   `protobuf:"varint,8,req,name=Nullable" json:"Nullable,omitempty"`
+	PrimaryKey *bool   `protobuf:"varint,9,req,name=PrimaryKey" json:"PrimaryKey,omitempty"`
+}
+
+func (x *ColumnSchemaProto) Reset() {
+	*x = ColumnSchemaProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColumnSchemaProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnSchemaProto) ProtoMessage() {}
+
+func (x *ColumnSchemaProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnSchemaProto.ProtoReflect.Descriptor instead.
+func (*ColumnSchemaProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ColumnSchemaProto) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ColumnSchemaProto) GetNdbType() string {
+	if x != nil && x.NdbType != nil {
+		return *x.NdbType
+	}
+	return ""
+}
+
+func (x *ColumnSchemaProto) GetMySQLType() string {
+	if x != nil && x.MySQLType != nil {
+		return *x.MySQLType
+	}
+	return ""
+}
+
+func (x *ColumnSchemaProto) GetLength() int32 {
+	if x != nil && x.Length != nil {
+		return *x.Length
+	}
+	return 0
+}
+
+func (x *ColumnSchemaProto) GetPrecision() int32 {
+	if x != nil && x.Precision != nil {
+		return *x.Precision
+	}
+	return 0
+}
+
+func (x *ColumnSchemaProto) GetScale() int32 {
+	if x != nil && x.Scale != nil {
+		return *x.Scale
+	}
+	return 0
+}
+
+func (x *ColumnSchemaProto) GetCharset() string {
+	if x != nil && x.Charset != nil {
+		return *x.Charset
+	}
+	return ""
+}
+
+func (x *ColumnSchemaProto) GetNullable() bool {
+	if x != nil && x.Nullable != nil {
+		return *x.Nullable
+	}
+	return false
+}
+
+func (x *ColumnSchemaProto) GetPrimaryKey() bool {
+	if x != nil && x.PrimaryKey != nil {
+		return *x.PrimaryKey
+	}
+	return false
+}
+
+type IndexSchemaProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	// ordered or unique
+	Type    *string  `protobuf:"bytes,2,req,name=Type" json:"Type,omitempty"`
+	Columns []string `protobuf:"bytes,3,rep,name=Columns" json:"Columns,omitempty"`
+}
+
+func (x *IndexSchemaProto) Reset() {
+	*x = IndexSchemaProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexSchemaProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexSchemaProto) ProtoMessage() {}
+
+func (x *IndexSchemaProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexSchemaProto.ProtoReflect.Descriptor instead.
+func (*IndexSchemaProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *IndexSchemaProto) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *IndexSchemaProto) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *IndexSchemaProto) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type TableSchemaRequestProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	APIKey *string `protobuf:"bytes,1,opt,name=APIKey" json:"APIKey,omitempty"`
+	DB     *string `protobuf:"bytes,2,req,name=DB" json:"DB,omitempty"`
+	Table  *string `protobuf:"bytes,3,req,name=Table" json:"Table,omitempty"`
+}
+
+func (x *TableSchemaRequestProto) Reset() {
+	*x = TableSchemaRequestProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableSchemaRequestProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableSchemaRequestProto) ProtoMessage() {}
+
+func (x *TableSchemaRequestProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableSchemaRequestProto.ProtoReflect.Descriptor instead.
+func (*TableSchemaRequestProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TableSchemaRequestProto) GetAPIKey() string {
+	if x != nil && x.APIKey != nil {
+		return *x.APIKey
+	}
+	return ""
+}
+
+func (x *TableSchemaRequestProto) GetDB() string {
+	if x != nil && x.DB != nil {
+		return *x.DB
+	}
+	return ""
+}
+
+func (x *TableSchemaRequestProto) GetTable() string {
+	if x != nil && x.Table != nil {
+		return *x.Table
+	}
+	return ""
+}
+
+type TableSchemaResponseProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DB      *string              `protobuf:"bytes,1,req,name=DB" json:"DB,omitempty"`
+	Table   *string              `protobuf:"bytes,2,req,name=Table" json:"Table,omitempty"`
+	Columns []*ColumnSchemaProto `protobuf:"bytes,3,rep,name=Columns" json:"Columns,omitempty"`
+	Indexes []*IndexSchemaProto  `protobuf:"bytes,4,rep,name=Indexes" json:"Indexes,omitempty"`
+}
+
+func (x *TableSchemaResponseProto) Reset() {
+	*x = TableSchemaResponseProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableSchemaResponseProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableSchemaResponseProto) ProtoMessage() {}
+
+func (x *TableSchemaResponseProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableSchemaResponseProto.ProtoReflect.Descriptor instead.
+func (*TableSchemaResponseProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TableSchemaResponseProto) GetDB() string {
+	if x != nil && x.DB != nil {
+		return *x.DB
+	}
+	return ""
+}
+
+func (x *TableSchemaResponseProto) GetTable() string {
+	if x != nil && x.Table != nil {
+		return *x.Table
+	}
+	return ""
+}
+
+func (x *TableSchemaResponseProto) GetColumns() []*ColumnSchemaProto {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *TableSchemaResponseProto) GetIndexes() []*IndexSchemaProto {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+type DatabasesRequestProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	APIKey *string `protobuf:"bytes,1,opt,name=APIKey" json:"APIKey,omitempty"`
+}
+
+func (x *DatabasesRequestProto) Reset() {
+	*x = DatabasesRequestProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabasesRequestProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabasesRequestProto) ProtoMessage() {}
+
+func (x *DatabasesRequestProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabasesRequestProto.ProtoReflect.Descriptor instead.
+func (*DatabasesRequestProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DatabasesRequestProto) GetAPIKey() string {
+	if x != nil && x.APIKey != nil {
+		return *x.APIKey
+	}
+	return ""
+}
+
+type DatabasesResponseProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Databases []string `protobuf:"bytes,1,rep,name=Databases" json:"Databases,omitempty"`
+}
+
+func (x *DatabasesResponseProto) Reset() {
+	*x = DatabasesResponseProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabasesResponseProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabasesResponseProto) ProtoMessage() {}
+
+func (x *DatabasesResponseProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabasesResponseProto.ProtoReflect.Descriptor instead.
+func (*DatabasesResponseProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DatabasesResponseProto) GetDatabases() []string {
+	if x != nil {
+		return x.Databases
+	}
+	return nil
+}
+
+type TablesRequestProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	APIKey *string `protobuf:"bytes,1,opt,name=APIKey" json:"APIKey,omitempty"`
+	DB     *string `protobuf:"bytes,2,req,name=DB" json:"DB,omitempty"`
+}
+
+func (x *TablesRequestProto) Reset() {
+	*x = TablesRequestProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TablesRequestProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TablesRequestProto) ProtoMessage() {}
+
+func (x *TablesRequestProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TablesRequestProto.ProtoReflect.Descriptor instead.
+func (*TablesRequestProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TablesRequestProto) GetAPIKey() string {
+	if x != nil && x.APIKey != nil {
+		return *x.APIKey
+	}
+	return ""
+}
+
+func (x *TablesRequestProto) GetDB() string {
+	if x != nil && x.DB != nil {
+		return *x.DB
+	}
+	return ""
+}
+
+type TablesResponseProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DB     *string  `protobuf:"bytes,1,req,name=DB" json:"DB,omitempty"`
+	Tables []string `protobuf:"bytes,2,rep,name=Tables" json:"Tables,omitempty"`
+}
+
+func (x *TablesResponseProto) Reset() {
+	*x = TablesResponseProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TablesResponseProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TablesResponseProto) ProtoMessage() {}
+
+func (x *TablesResponseProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TablesResponseProto.ProtoReflect.Descriptor instead.
+func (*TablesResponseProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TablesResponseProto) GetDB() string {
+	if x != nil && x.DB != nil {
+		return *x.DB
+	}
+	return ""
+}
+
+func (x *TablesResponseProto) GetTables() []string {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type FeatureGroupProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Version  *uint32  `protobuf:"varint,2,req,name=Version" json:"Version,omitempty"`
+	Features []string `protobuf:"bytes,3,rep,name=Features" json:"Features,omitempty"`
+}
+
+func (x *FeatureGroupProto) Reset() {
+	*x = FeatureGroupProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeatureGroupProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureGroupProto) ProtoMessage() {}
+
+func (x *FeatureGroupProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureGroupProto.ProtoReflect.Descriptor instead.
+func (*FeatureGroupProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FeatureGroupProto) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *FeatureGroupProto) GetVersion() uint32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *FeatureGroupProto) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// The feature groups are the tables <Name>_<Version> of the project database
+type FeatureVectorRequestProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	APIKey        *string              `protobuf:"bytes,1,opt,name=APIKey" json:"APIKey,omitempty"`
+	Project       *string              `protobuf:"bytes,2,req,name=Project" json:"Project,omitempty"`
+	FeatureGroups []*FeatureGroupProto `protobuf:"bytes,3,rep,name=FeatureGroups" json:"FeatureGroups,omitempty"`
+	Entry         []*FilterProto       `protobuf:"bytes,4,rep,name=Entry" json:"Entry,omitempty"`
+}
+
+func (x *FeatureVectorRequestProto) Reset() {
+	*x = FeatureVectorRequestProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeatureVectorRequestProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureVectorRequestProto) ProtoMessage() {}
+
+func (x *FeatureVectorRequestProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureVectorRequestProto.ProtoReflect.Descriptor instead.
+func (*FeatureVectorRequestProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FeatureVectorRequestProto) GetAPIKey() string {
+	if x != nil && x.APIKey != nil {
+		return *x.APIKey
+	}
+	return ""
+}
+
+func (x *FeatureVectorRequestProto) GetProject() string {
+	if x != nil && x.Project != nil {
+		return *x.Project
+	}
+	return ""
+}
+
+func (x *FeatureVectorRequestProto) GetFeatureGroups() []*FeatureGroupProto {
 	if x != nil {
-		return x.RonDBStats
+		return x.FeatureGroups
+	}
+	return nil
+}
+
+func (x *FeatureVectorRequestProto) GetEntry() []*FilterProto {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// The features are ordered as in the request. Features of feature groups
+// without a row for the entry are null
+type FeatureVectorResponseProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Features []*ColumnValueProto `protobuf:"bytes,1,rep,name=Features" json:"Features,omitempty"`
+	Nulls    []bool              `protobuf:"varint,2,rep,name=Nulls" json:"Nulls,omitempty"`
+}
+
+func (x *FeatureVectorResponseProto) Reset() {
+	*x = FeatureVectorResponseProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeatureVectorResponseProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureVectorResponseProto) ProtoMessage() {}
+
+func (x *FeatureVectorResponseProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureVectorResponseProto.ProtoReflect.Descriptor instead.
+func (*FeatureVectorResponseProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FeatureVectorResponseProto) GetFeatures() []*ColumnValueProto {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *FeatureVectorResponseProto) GetNulls() []bool {
+	if x != nil {
+		return x.Nulls
 	}
 	return nil
 }
 
+// ErrorResponseProto is sent in the details of the gRPC errors
+type ErrorResponseProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code        *string `protobuf:"bytes,1,opt,name=Code" json:"Code,omitempty"`
+	Reason      *string `protobuf:"bytes,2,req,name=Reason" json:"Reason,omitempty"`
+	Message     *string `protobuf:"bytes,3,req,name=Message" json:"Message,omitempty"`
+	DB          *string `protobuf:"bytes,4,opt,name=DB" json:"DB,omitempty"`
+	Table       *string `protobuf:"bytes,5,opt,name=Table" json:"Table,omitempty"`
+	Column      *string `protobuf:"bytes,6,opt,name=Column" json:"Column,omitempty"`
+	OperationID *string `protobuf:"bytes,7,opt,name=OperationID" json:"OperationID,omitempty"`
+	NdbCode     *int32  `protobuf:"varint,8,opt,name=NdbCode" json:"NdbCode,omitempty"`
+}
+
+func (x *ErrorResponseProto) Reset() {
+	*x = ErrorResponseProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_rdrs_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorResponseProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorResponseProto) ProtoMessage() {}
+
+func (x *ErrorResponseProto) ProtoReflect() protoreflect.Message {
+	mi := &file_api_rdrs_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorResponseProto.ProtoReflect.Descriptor instead.
+func (*ErrorResponseProto) Descriptor() ([]byte, []int) {
+	return file_api_rdrs_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ErrorResponseProto) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *ErrorResponseProto) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ErrorResponseProto) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *ErrorResponseProto) GetDB() string {
+	if x != nil && x.DB != nil {
+		return *x.DB
+	}
+	return ""
+}
+
+func (x *ErrorResponseProto) GetTable() string {
+	if x != nil && x.Table != nil {
+		return *x.Table
+	}
+	return ""
+}
+
+func (x *ErrorResponseProto) GetColumn() string {
+	if x != nil && x.Column != nil {
+		return *x.Column
+	}
+	return ""
+}
+
+func (x *ErrorResponseProto) GetOperationID() string {
+	if x != nil && x.OperationID != nil {
+		return *x.OperationID
+	}
+	return ""
+}
+
+func (x *ErrorResponseProto) GetNdbCode() int32 {
+	if x != nil && x.NdbCode != nil {
+		return *x.NdbCode
+	}
+	return 0
+}
+
 var File_api_rdrs_proto protoreflect.FileDescriptor
 
 var file_api_rdrs_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x64, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x3b, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x16, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52,
-	0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x51, 0x0a,
-	0x0f, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x3b, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09,
-	0x52, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x22, 0xd0, 0x01, 0x0a, 0x12, 0x50, 0x4b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12,
-	0x0e, 0x0a, 0x02, 0x44, 0x42, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x02, 0x44, 0x42, 0x12,
-	0x14, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a,
-	0x0b, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x22, 0x26, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x13,
-	0x50, 0x4b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x50, 0x4b, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x61,
-	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x4a, 0x0a,
-	0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x60, 0x0a, 0x11, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16,
+	0x52, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x51,
+	0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0xd0, 0x01, 0x0a, 0x12, 0x50, 0x4b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x44, 0x42, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x02, 0x44, 0x42,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52,
+	0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x32,
+	0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x22, 0xeb, 0x02, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x09, 0x4e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x09, 0x4e, 0x75, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a,
+	0x0a, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x0a, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x22, 0x0a, 0x0b, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0b, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x0b, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0a, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x0a, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x44, 0x0a,
+	0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x00, 0x52, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x13, 0x50, 0x4b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x50, 0x4b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x1a, 0x4a, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x40, 0x0a, 0x10, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0xf2, 0x01, 0x0a, 0x13, 0x50, 0x4b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x44, 0x42, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x02,
+	0x44, 0x42, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x02, 0x28, 0x09, 0x52, 0x09, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x35,
+	0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x4c, 0x0a, 0x14, 0x50, 0x4b, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x14, 0x50, 0x4b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16,
 	0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x50, 0x4b, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52,
-	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x48, 0x0a, 0x12, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50, 0x4b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x02, 0x28, 0x03, 0x52, 0x10, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x61, 0x6c, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x02,
-	0x28, 0x03, 0x52, 0x12, 0x44, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x02, 0x28, 0x03, 0x52, 0x0c, 0x42, 0x75,
-	0x66, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72,
-	0x65, 0x65, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x02, 0x28, 0x03, 0x52,
-	0x0b, 0x46, 0x72, 0x65, 0x65, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x22, 0xeb, 0x01, 0x0a,
-	0x0f, 0x52, 0x6f, 0x6e, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x38, 0x0a, 0x17, 0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x02, 0x28,
-	0x03, 0x52, 0x17, 0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x4e, 0x64,
-	0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x03, 0x52, 0x17, 0x4e, 0x64, 0x62,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x02,
-	0x28, 0x03, 0x52, 0x14, 0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x4e, 0x64, 0x62, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x72, 0x65, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x02, 0x28, 0x03, 0x52, 0x13, 0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x46, 0x72, 0x65, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x74,
-	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7a,
-	0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x33, 0x0a, 0x0b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0b, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x52, 0x6f, 0x6e, 0x44,
-	0x42, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x52,
-	0x6f, 0x6e, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a,
-	0x52, 0x6f, 0x6e, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x73, 0x32, 0xa1, 0x01, 0x0a, 0x09, 0x52,
-	0x6f, 0x6e, 0x44, 0x42, 0x52, 0x45, 0x53, 0x54, 0x12, 0x33, 0x0a, 0x06, 0x50, 0x4b, 0x52, 0x65,
-	0x61, 0x64, 0x12, 0x13, 0x2e, 0x50, 0x4b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x2e, 0x50, 0x4b, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x30, 0x0a,
-	0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x2d, 0x0a, 0x04, 0x53, 0x74, 0x61, 0x74, 0x12, 0x11, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x0b,
-	0x5a, 0x09, 0x2e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x44, 0x42, 0x18, 0x02, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x02, 0x44, 0x42, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x07,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x07, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x4d, 0x0a, 0x15, 0x50, 0x4b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x60, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x12, 0x33, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x50, 0x4b, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x48, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x32, 0x0a,
+	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x50, 0x4b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x22, 0x68, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x53, 0x75, 0x62, 0x4f,
+	0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x27, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x50, 0x4b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x2a, 0x0a, 0x05, 0x57, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x50, 0x4b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x57, 0x72, 0x69, 0x74, 0x65, 0x22, 0x61, 0x0a, 0x13, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x0a, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x53, 0x75, 0x62, 0x4f, 0x70, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x57,
+	0x0a, 0x0f, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x26, 0x0a, 0x07, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x52, 0x07, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6e, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x6e,
+	0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x22, 0xdb, 0x02, 0x0a, 0x15, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x44, 0x42, 0x18,
+	0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x02, 0x44, 0x42, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x30, 0x0a, 0x0a, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x42, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x4c, 0x6f, 0x77,
+	0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x0a, 0x55, 0x70, 0x70, 0x65, 0x72,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x55,
+	0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x65, 0x73,
+	0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x44,
+	0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x32, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xa9, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x45, 0x78, 0x70, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x4f, 0x70, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x55, 0x70, 0x70, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x55, 0x70,
+	0x70, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x78,
+	0x70, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64,
+	0x73, 0x22, 0x99, 0x02, 0x0a, 0x15, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x44, 0x42, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52,
+	0x02, 0x44, 0x42, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x45, 0x78, 0x70, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x43, 0x6f, 0x6e,
+	0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0b,
+	0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x87, 0x01,
+	0x0a, 0x0c, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x2b,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x53,
+	0x63, 0x61, 0x6e, 0x52, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x4a, 0x0a, 0x09, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x86, 0x01, 0x0a, 0x11, 0x53, 0x63, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x0a,
+	0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x21, 0x0a, 0x04, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x53, 0x63, 0x61, 0x6e, 0x52, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04, 0x52, 0x6f,
+	0x77, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x43,
+	0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0xb4, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x02, 0x28, 0x03, 0x52,
+	0x10, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x03, 0x52, 0x12, 0x44,
+	0x65, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x02, 0x28, 0x03, 0x52, 0x0c, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72, 0x65, 0x65, 0x42, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x02, 0x28, 0x03, 0x52, 0x0b, 0x46, 0x72, 0x65, 0x65,
+	0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x22, 0xc5, 0x02, 0x0a, 0x0f, 0x52, 0x6f, 0x6e, 0x44,
+	0x42, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x38, 0x0a, 0x17, 0x4e,
+	0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x02, 0x28, 0x03, 0x52, 0x17, 0x4e, 0x64,
+	0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x02, 0x28, 0x03, 0x52, 0x17, 0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x32, 0x0a, 0x14, 0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x02, 0x28, 0x03, 0x52, 0x14, 0x4e,
+	0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x46, 0x72, 0x65, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x02, 0x28, 0x03,
+	0x52, 0x13, 0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x72, 0x65, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x64, 0x62, 0x4f, 0x70, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x02, 0x28, 0x03, 0x52, 0x0c, 0x4e, 0x64, 0x62,
+	0x4f, 0x70, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x4e, 0x64, 0x62,
+	0x4f, 0x70, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x02, 0x28, 0x03, 0x52, 0x15, 0x4e, 0x64, 0x62, 0x4f, 0x70, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x22,
+	0xde, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x03, 0x52, 0x05, 0x52, 0x65, 0x61, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6f, 0x75, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x02, 0x28, 0x03, 0x52, 0x05, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x02, 0x28, 0x03,
+	0x52, 0x08, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x02, 0x28, 0x03, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x02, 0x28, 0x03, 0x52, 0x0d, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x35, 0x30, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x55, 0x73, 0x18, 0x06, 0x20, 0x02, 0x28, 0x03, 0x52, 0x0c,
+	0x50, 0x35, 0x30, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x55, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x50, 0x39, 0x39, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x55, 0x73, 0x18, 0x07, 0x20, 0x02,
+	0x28, 0x03, 0x52, 0x0c, 0x50, 0x39, 0x39, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x55, 0x73,
+	0x22, 0x56, 0x0a, 0x0f, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x52, 0x65, 0x61,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x52,
+	0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x77, 0x0a, 0x0c, 0x44, 0x42, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x44, 0x42, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x02, 0x44, 0x42, 0x12, 0x2d, 0x0a, 0x09, 0x52, 0x65, 0x61, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x09, 0x52, 0x65,
+	0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x22, 0xd0, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x03, 0x52, 0x0f, 0x4e, 0x64, 0x62,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13,
+	0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x72, 0x65, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x02, 0x28, 0x03, 0x52, 0x13, 0x4e, 0x64, 0x62, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x72, 0x65, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28,
+	0x0a, 0x0f, 0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x49, 0x6e, 0x55, 0x73,
+	0x65, 0x18, 0x04, 0x20, 0x02, 0x28, 0x03, 0x52, 0x0f, 0x4e, 0x64, 0x62, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x02, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x33,
+	0x0a, 0x0b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x52, 0x6f, 0x6e, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x52, 0x6f, 0x6e, 0x44, 0x42, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0a, 0x52, 0x6f, 0x6e, 0x44, 0x42,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x07, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3f,
+	0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x0f,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22,
+	0x81, 0x02, 0x0a, 0x11, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x64, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x4e, 0x64, 0x62, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x79, 0x53, 0x51, 0x4c, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x79, 0x53, 0x51, 0x4c, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x02, 0x28,
+	0x05, 0x52, 0x06, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x02, 0x28, 0x05, 0x52, 0x09, 0x50, 0x72,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x61, 0x6c, 0x65,
+	0x18, 0x06, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x43, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x43, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x75, 0x6c, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x02, 0x28, 0x08, 0x52, 0x08, 0x4e, 0x75, 0x6c, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65,
+	0x79, 0x18, 0x09, 0x20, 0x02, 0x28, 0x08, 0x52, 0x0a, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x4b, 0x65, 0x79, 0x22, 0x54, 0x0a, 0x10, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x57, 0x0a, 0x17, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02,
+	0x44, 0x42, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x02, 0x44, 0x42, 0x12, 0x14, 0x0a, 0x05,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x18, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0e, 0x0a, 0x02, 0x44, 0x42, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x02, 0x44, 0x42, 0x12,
+	0x14, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x07, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x07, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73,
+	0x22, 0x2f, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65,
+	0x79, 0x22, 0x36, 0x0a, 0x16, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x12, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x44, 0x42, 0x18, 0x02, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x02, 0x44, 0x42, 0x22, 0x3d, 0x0a, 0x13, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x44, 0x42, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x02, 0x44, 0x42, 0x12, 0x16,
+	0x0a, 0x06, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x11, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0d,
+	0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x19, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x38, 0x0a, 0x0d, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x46,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x52, 0x0d, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x22, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x22, 0x61, 0x0a, 0x1a, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x2d, 0x0a, 0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x4e, 0x75, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x08, 0x52,
+	0x05, 0x4e, 0x75, 0x6c, 0x6c, 0x73, 0x22, 0xd4, 0x01, 0x0a, 0x12, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x0a,
+	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x44, 0x42, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x44, 0x42, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x64, 0x62, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x4e, 0x64, 0x62, 0x43, 0x6f, 0x64, 0x65, 0x32, 0xc1, 0x05,
+	0x0a, 0x09, 0x52, 0x6f, 0x6e, 0x44, 0x42, 0x52, 0x45, 0x53, 0x54, 0x12, 0x33, 0x0a, 0x06, 0x50,
+	0x4b, 0x52, 0x65, 0x61, 0x64, 0x12, 0x13, 0x2e, 0x50, 0x4b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x2e, 0x50, 0x4b, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x36, 0x0a, 0x07, 0x50, 0x4b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x50, 0x4b,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x15, 0x2e, 0x50, 0x4b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x39, 0x0a, 0x08, 0x50, 0x4b, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x50, 0x4b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x2e, 0x50, 0x4b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x30, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x13, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x34, 0x0a, 0x07, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78,
+	0x12, 0x14, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x39, 0x0a, 0x09, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x16, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x12, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x09, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x63, 0x61, 0x6e, 0x12, 0x16, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x2e, 0x53, 0x63,
+	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x30,
+	0x01, 0x12, 0x2d, 0x0a, 0x04, 0x53, 0x74, 0x61, 0x74, 0x12, 0x11, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x42, 0x0a, 0x0b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
+	0x18, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x2e, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x3c, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x16, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x33, 0x0a, 0x06, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x14, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x48, 0x0a, 0x0d, 0x46, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x42, 0x0b, 0x5a, 0x09, 0x2e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69,
 }
 
 var (
@@ -795,41 +3311,117 @@ func file_api_rdrs_proto_rawDescGZIP() []byte {
 	return file_api_rdrs_proto_rawDescData
 }
 
-var file_api_rdrs_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_rdrs_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_api_rdrs_proto_goTypes = []interface{}{
-	(*FilterProto)(nil),         // 0: FilterProto
-	(*ReadColumnProto)(nil),     // 1: ReadColumnProto
-	(*PKReadRequestProto)(nil),  // 2: PKReadRequestProto
-	(*ColumnValueProto)(nil),    // 3: ColumnValueProto
-	(*PKReadResponseProto)(nil), // 4: PKReadResponseProto
-	(*BatchRequestProto)(nil),   // 5: BatchRequestProto
-	(*BatchResponseProto)(nil),  // 6: BatchResponseProto
-	(*MemoryStatsProto)(nil),    // 7: MemoryStatsProto
-	(*RonDBStatsProto)(nil),     // 8: RonDBStatsProto
-	(*StatRequestProto)(nil),    // 9: StatRequestProto
-	(*StatResponseProto)(nil),   // 10: StatResponseProto
-	nil,                         // 11: PKReadResponseProto.DataEntry
+	(*FilterProto)(nil),                // 0: FilterProto
+	(*ReadColumnProto)(nil),            // 1: ReadColumnProto
+	(*PKReadRequestProto)(nil),         // 2: PKReadRequestProto
+	(*ColumnValueProto)(nil),           // 3: ColumnValueProto
+	(*PKReadResponseProto)(nil),        // 4: PKReadResponseProto
+	(*WriteColumnProto)(nil),           // 5: WriteColumnProto
+	(*PKWriteRequestProto)(nil),        // 6: PKWriteRequestProto
+	(*PKWriteResponseProto)(nil),       // 7: PKWriteResponseProto
+	(*PKDeleteRequestProto)(nil),       // 8: PKDeleteRequestProto
+	(*PKDeleteResponseProto)(nil),      // 9: PKDeleteResponseProto
+	(*BatchRequestProto)(nil),          // 10: BatchRequestProto
+	(*BatchResponseProto)(nil),         // 11: BatchResponseProto
+	(*BatchTxSubOpProto)(nil),          // 12: BatchTxSubOpProto
+	(*BatchTxRequestProto)(nil),        // 13: BatchTxRequestProto
+	(*IndexBoundProto)(nil),            // 14: IndexBoundProto
+	(*IndexScanRequestProto)(nil),      // 15: IndexScanRequestProto
+	(*FilterExprProto)(nil),            // 16: FilterExprProto
+	(*TableScanRequestProto)(nil),      // 17: TableScanRequestProto
+	(*ScanRowProto)(nil),               // 18: ScanRowProto
+	(*ScanResponseProto)(nil),          // 19: ScanResponseProto
+	(*MemoryStatsProto)(nil),           // 20: MemoryStatsProto
+	(*RonDBStatsProto)(nil),            // 21: RonDBStatsProto
+	(*ReadStatsProto)(nil),             // 22: ReadStatsProto
+	(*TableStatsProto)(nil),            // 23: TableStatsProto
+	(*DBStatsProto)(nil),               // 24: DBStatsProto
+	(*ConnectionStatsProto)(nil),       // 25: ConnectionStatsProto
+	(*StatRequestProto)(nil),           // 26: StatRequestProto
+	(*StatResponseProto)(nil),          // 27: StatResponseProto
+	(*ColumnSchemaProto)(nil),          // 28: ColumnSchemaProto
+	(*IndexSchemaProto)(nil),           // 29: IndexSchemaProto
+	(*TableSchemaRequestProto)(nil),    // 30: TableSchemaRequestProto
+	(*TableSchemaResponseProto)(nil),   // 31: TableSchemaResponseProto
+	(*DatabasesRequestProto)(nil),      // 32: DatabasesRequestProto
+	(*DatabasesResponseProto)(nil),     // 33: DatabasesResponseProto
+	(*TablesRequestProto)(nil),         // 34: TablesRequestProto
+	(*TablesResponseProto)(nil),        // 35: TablesResponseProto
+	(*FeatureGroupProto)(nil),          // 36: FeatureGroupProto
+	(*FeatureVectorRequestProto)(nil),  // 37: FeatureVectorRequestProto
+	(*FeatureVectorResponseProto)(nil), // 38: FeatureVectorResponseProto
+	(*ErrorResponseProto)(nil),         // 39: ErrorResponseProto
+	nil,                                // 40: PKReadResponseProto.DataEntry
+	nil,                                // 41: ScanRowProto.DataEntry
+	(*timestamppb.Timestamp)(nil),      // 42: google.protobuf.Timestamp
 }
 var file_api_rdrs_proto_depIdxs = []int32{
 	0,  // 0: PKReadRequestProto.Filters:type_name -> FilterProto
 	1,  // 1: PKReadRequestProto.ReadColumns:type_name -> ReadColumnProto
-	11, // 2: PKReadResponseProto.Data:type_name -> PKReadResponseProto.DataEntry
-	2,  // 3: BatchRequestProto.operations:type_name -> PKReadRequestProto
-	4,  // 4: BatchResponseProto.responses:type_name -> PKReadResponseProto
-	7,  // 5: StatResponseProto.MemoryStats:type_name -> MemoryStatsProto
-	8,  // 6: StatResponseProto.RonDBStats:type_name -> RonDBStatsProto
-	3,  // 7: PKReadResponseProto.DataEntry.value:type_name -> ColumnValueProto
-	2,  // 8: RonDBREST.PKRead:input_type -> PKReadRequestProto
-	5,  // 9: RonDBREST.Batch:input_type -> BatchRequestProto
-	9,  // 10: RonDBREST.Stat:input_type -> StatRequestProto
-	4,  // 11: RonDBREST.PKRead:output_type -> PKReadResponseProto
-	6,  // 12: RonDBREST.Batch:output_type -> BatchResponseProto
-	10, // 13: RonDBREST.Stat:output_type -> StatResponseProto
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	42, // 2: ColumnValueProto.TimestampValue:type_name -> google.protobuf.Timestamp
+	40, // 3: PKReadResponseProto.Data:type_name -> PKReadResponseProto.DataEntry
+	0,  // 4: PKWriteRequestProto.Filters:type_name -> FilterProto
+	5,  // 5: PKWriteRequestProto.WriteColumns:type_name -> WriteColumnProto
+	0,  // 6: PKDeleteRequestProto.Filters:type_name -> FilterProto
+	2,  // 7: BatchRequestProto.operations:type_name -> PKReadRequestProto
+	4,  // 8: BatchResponseProto.responses:type_name -> PKReadResponseProto
+	2,  // 9: BatchTxSubOpProto.Read:type_name -> PKReadRequestProto
+	6,  // 10: BatchTxSubOpProto.Write:type_name -> PKWriteRequestProto
+	12, // 11: BatchTxRequestProto.operations:type_name -> BatchTxSubOpProto
+	0,  // 12: IndexBoundProto.Columns:type_name -> FilterProto
+	14, // 13: IndexScanRequestProto.LowerBound:type_name -> IndexBoundProto
+	14, // 14: IndexScanRequestProto.UpperBound:type_name -> IndexBoundProto
+	1,  // 15: IndexScanRequestProto.ReadColumns:type_name -> ReadColumnProto
+	16, // 16: FilterExprProto.Operands:type_name -> FilterExprProto
+	16, // 17: TableScanRequestProto.Filter:type_name -> FilterExprProto
+	1,  // 18: TableScanRequestProto.ReadColumns:type_name -> ReadColumnProto
+	41, // 19: ScanRowProto.Data:type_name -> ScanRowProto.DataEntry
+	18, // 20: ScanResponseProto.Rows:type_name -> ScanRowProto
+	22, // 21: TableStatsProto.ReadStats:type_name -> ReadStatsProto
+	22, // 22: DBStatsProto.ReadStats:type_name -> ReadStatsProto
+	23, // 23: DBStatsProto.Tables:type_name -> TableStatsProto
+	20, // 24: StatResponseProto.MemoryStats:type_name -> MemoryStatsProto
+	21, // 25: StatResponseProto.RonDBStats:type_name -> RonDBStatsProto
+	24, // 26: StatResponseProto.DBStats:type_name -> DBStatsProto
+	25, // 27: StatResponseProto.ConnectionStats:type_name -> ConnectionStatsProto
+	28, // 28: TableSchemaResponseProto.Columns:type_name -> ColumnSchemaProto
+	29, // 29: TableSchemaResponseProto.Indexes:type_name -> IndexSchemaProto
+	36, // 30: FeatureVectorRequestProto.FeatureGroups:type_name -> FeatureGroupProto
+	0,  // 31: FeatureVectorRequestProto.Entry:type_name -> FilterProto
+	3,  // 32: FeatureVectorResponseProto.Features:type_name -> ColumnValueProto
+	3,  // 33: PKReadResponseProto.DataEntry.value:type_name -> ColumnValueProto
+	3,  // 34: ScanRowProto.DataEntry.value:type_name -> ColumnValueProto
+	2,  // 35: RonDBREST.PKRead:input_type -> PKReadRequestProto
+	6,  // 36: RonDBREST.PKWrite:input_type -> PKWriteRequestProto
+	8,  // 37: RonDBREST.PKDelete:input_type -> PKDeleteRequestProto
+	10, // 38: RonDBREST.Batch:input_type -> BatchRequestProto
+	13, // 39: RonDBREST.BatchTx:input_type -> BatchTxRequestProto
+	15, // 40: RonDBREST.IndexScan:input_type -> IndexScanRequestProto
+	17, // 41: RonDBREST.TableScan:input_type -> TableScanRequestProto
+	26, // 42: RonDBREST.Stat:input_type -> StatRequestProto
+	30, // 43: RonDBREST.TableSchema:input_type -> TableSchemaRequestProto
+	32, // 44: RonDBREST.Databases:input_type -> DatabasesRequestProto
+	34, // 45: RonDBREST.Tables:input_type -> TablesRequestProto
+	37, // 46: RonDBREST.FeatureVector:input_type -> FeatureVectorRequestProto
+	4,  // 47: RonDBREST.PKRead:output_type -> PKReadResponseProto
+	7,  // 48: RonDBREST.PKWrite:output_type -> PKWriteResponseProto
+	9,  // 49: RonDBREST.PKDelete:output_type -> PKDeleteResponseProto
+	11, // 50: RonDBREST.Batch:output_type -> BatchResponseProto
+	11, // 51: RonDBREST.BatchTx:output_type -> BatchResponseProto
+	19, // 52: RonDBREST.IndexScan:output_type -> ScanResponseProto
+	19, // 53: RonDBREST.TableScan:output_type -> ScanResponseProto
+	27, // 54: RonDBREST.Stat:output_type -> StatResponseProto
+	31, // 55: RonDBREST.TableSchema:output_type -> TableSchemaResponseProto
+	33, // 56: RonDBREST.Databases:output_type -> DatabasesResponseProto
+	35, // 57: RonDBREST.Tables:output_type -> TablesResponseProto
+	38, // 58: RonDBREST.FeatureVector:output_type -> FeatureVectorResponseProto
+	47, // [47:59] is the sub-list for method output_type
+	35, // [35:47] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_api_rdrs_proto_init() }
@@ -850,8 +3442,80 @@ func file_api_rdrs_proto_init() {
 				return nil
 			}
 		}
-		file_api_rdrs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadColumnProto); i {
+		file_api_rdrs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadColumnProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKReadRequestProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColumnValueProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKReadResponseProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteColumnProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKWriteRequestProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKWriteResponseProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -862,8 +3526,8 @@ func file_api_rdrs_proto_init() {
 				return nil
 			}
 		}
-		file_api_rdrs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PKReadRequestProto); i {
+		file_api_rdrs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKDeleteRequestProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -874,8 +3538,8 @@ func file_api_rdrs_proto_init() {
 				return nil
 			}
 		}
-		file_api_rdrs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColumnValueProto); i {
+		file_api_rdrs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PKDeleteResponseProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -886,8 +3550,8 @@ func file_api_rdrs_proto_init() {
 				return nil
 			}
 		}
-		file_api_rdrs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PKReadResponseProto); i {
+		file_api_rdrs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRequestProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -898,8 +3562,8 @@ func file_api_rdrs_proto_init() {
 				return nil
 			}
 		}
-		file_api_rdrs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchRequestProto); i {
+		file_api_rdrs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResponseProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -910,8 +3574,8 @@ func file_api_rdrs_proto_init() {
 				return nil
 			}
 		}
-		file_api_rdrs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchResponseProto); i {
+		file_api_rdrs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchTxSubOpProto); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -922,7 +3586,91 @@ func file_api_rdrs_proto_init() {
 				return nil
 			}
 		}
-		file_api_rdrs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_api_rdrs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchTxRequestProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexBoundProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexScanRequestProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterExprProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableScanRequestProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanRowProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanResponseProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MemoryStatsProto); i {
 			case 0:
 				return &v.state
@@ -934,7 +3682,7 @@ func file_api_rdrs_proto_init() {
 				return nil
 			}
 		}
-		file_api_rdrs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_api_rdrs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RonDBStatsProto); i {
 			case 0:
 				return &v.state
@@ -946,7 +3694,55 @@ func file_api_rdrs_proto_init() {
 				return nil
 			}
 		}
-		file_api_rdrs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_api_rdrs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadStatsProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableStatsProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DBStatsProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionStatsProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatRequestProto); i {
 			case 0:
 				return &v.state
@@ -958,7 +3754,7 @@ func file_api_rdrs_proto_init() {
 				return nil
 			}
 		}
-		file_api_rdrs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_api_rdrs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatResponseProto); i {
 			case 0:
 				return &v.state
@@ -970,6 +3766,160 @@ func file_api_rdrs_proto_init() {
 				return nil
 			}
 		}
+		file_api_rdrs_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColumnSchemaProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexSchemaProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableSchemaRequestProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TableSchemaResponseProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabasesRequestProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabasesResponseProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TablesRequestProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TablesResponseProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeatureGroupProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeatureVectorRequestProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeatureVectorResponseProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_rdrs_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorResponseProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_api_rdrs_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*ColumnValueProto_NullValue)(nil),
+		(*ColumnValueProto_Int64Value)(nil),
+		(*ColumnValueProto_Uint64Value)(nil),
+		(*ColumnValueProto_DoubleValue)(nil),
+		(*ColumnValueProto_StringValue)(nil),
+		(*ColumnValueProto_BytesValue)(nil),
+		(*ColumnValueProto_TimestampValue)(nil),
+		(*ColumnValueProto_DecimalValue)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -977,7 +3927,7 @@ func file_api_rdrs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_rdrs_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
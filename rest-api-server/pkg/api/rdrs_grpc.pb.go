@@ -23,8 +23,17 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type RonDBRESTClient interface {
 	PKRead(ctx context.Context, in *PKReadRequestProto, opts ...grpc.CallOption) (*PKReadResponseProto, error)
+	PKWrite(ctx context.Context, in *PKWriteRequestProto, opts ...grpc.CallOption) (*PKWriteResponseProto, error)
+	PKDelete(ctx context.Context, in *PKDeleteRequestProto, opts ...grpc.CallOption) (*PKDeleteResponseProto, error)
 	Batch(ctx context.Context, in *BatchRequestProto, opts ...grpc.CallOption) (*BatchResponseProto, error)
+	BatchTx(ctx context.Context, in *BatchTxRequestProto, opts ...grpc.CallOption) (*BatchResponseProto, error)
+	IndexScan(ctx context.Context, in *IndexScanRequestProto, opts ...grpc.CallOption) (RonDBREST_IndexScanClient, error)
+	TableScan(ctx context.Context, in *TableScanRequestProto, opts ...grpc.CallOption) (RonDBREST_TableScanClient, error)
 	Stat(ctx context.Context, in *StatRequestProto, opts ...grpc.CallOption) (*StatResponseProto, error)
+	TableSchema(ctx context.Context, in *TableSchemaRequestProto, opts ...grpc.CallOption) (*TableSchemaResponseProto, error)
+	Databases(ctx context.Context, in *DatabasesRequestProto, opts ...grpc.CallOption) (*DatabasesResponseProto, error)
+	Tables(ctx context.Context, in *TablesRequestProto, opts ...grpc.CallOption) (*TablesResponseProto, error)
+	FeatureVector(ctx context.Context, in *FeatureVectorRequestProto, opts ...grpc.CallOption) (*FeatureVectorResponseProto, error)
 }
 
 type ronDBRESTClient struct {
@@ -44,6 +53,24 @@ func (c *ronDBRESTClient) PKRead(ctx context.Context, in *PKReadRequestProto, op
 	return out, nil
 }
 
+func (c *ronDBRESTClient) PKWrite(ctx context.Context, in *PKWriteRequestProto, opts ...grpc.CallOption) (*PKWriteResponseProto, error) {
+	out := new(PKWriteResponseProto)
+	err := c.cc.Invoke(ctx, "/RonDBREST/PKWrite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ronDBRESTClient) PKDelete(ctx context.Context, in *PKDeleteRequestProto, opts ...grpc.CallOption) (*PKDeleteResponseProto, error) {
+	out := new(PKDeleteResponseProto)
+	err := c.cc.Invoke(ctx, "/RonDBREST/PKDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ronDBRESTClient) Batch(ctx context.Context, in *BatchRequestProto, opts ...grpc.CallOption) (*BatchResponseProto, error) {
 	out := new(BatchResponseProto)
 	err := c.cc.Invoke(ctx, "/RonDBREST/Batch", in, out, opts...)
@@ -53,6 +80,79 @@ func (c *ronDBRESTClient) Batch(ctx context.Context, in *BatchRequestProto, opts
 	return out, nil
 }
 
+func (c *ronDBRESTClient) BatchTx(ctx context.Context, in *BatchTxRequestProto, opts ...grpc.CallOption) (*BatchResponseProto, error) {
+	out := new(BatchResponseProto)
+	err := c.cc.Invoke(ctx, "/RonDBREST/BatchTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ronDBRESTClient) IndexScan(ctx context.Context, in *IndexScanRequestProto, opts ...grpc.CallOption) (RonDBREST_IndexScanClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RonDBREST_ServiceDesc.Streams[0], "/RonDBREST/IndexScan", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ronDBRESTIndexScanClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RonDBREST_IndexScanClient interface {
+	Recv() (*ScanResponseProto, error)
+	grpc.ClientStream
+}
+
+type ronDBRESTIndexScanClient struct {
+	grpc.ClientStream
+}
+
+func (x *ronDBRESTIndexScanClient) Recv() (*ScanResponseProto, error) {
+	m := new(ScanResponseProto)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ronDBRESTClient) TableScan(ctx context.Context, in *TableScanRequestProto, opts ...grpc.CallOption) (RonDBREST_TableScanClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RonDBREST_ServiceDesc.Streams[1], "/RonDBREST/TableScan", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ronDBRESTTableScanClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RonDBREST_TableScanClient interface {
+	Recv() (*ScanResponseProto, error)
+	grpc.ClientStream
+}
+
+type ronDBRESTTableScanClient struct {
+	grpc.ClientStream
+}
+
+func (x *ronDBRESTTableScanClient) Recv() (*ScanResponseProto, error) {
+	m := new(ScanResponseProto)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *ronDBRESTClient) Stat(ctx context.Context, in *StatRequestProto, opts ...grpc.CallOption) (*StatResponseProto, error) {
 	out := new(StatResponseProto)
 	err := c.cc.Invoke(ctx, "/RonDBREST/Stat", in, out, opts...)
@@ -62,13 +162,58 @@ func (c *ronDBRESTClient) Stat(ctx context.Context, in *StatRequestProto, opts .
 	return out, nil
 }
 
+func (c *ronDBRESTClient) TableSchema(ctx context.Context, in *TableSchemaRequestProto, opts ...grpc.CallOption) (*TableSchemaResponseProto, error) {
+	out := new(TableSchemaResponseProto)
+	err := c.cc.Invoke(ctx, "/RonDBREST/TableSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ronDBRESTClient) Databases(ctx context.Context, in *DatabasesRequestProto, opts ...grpc.CallOption) (*DatabasesResponseProto, error) {
+	out := new(DatabasesResponseProto)
+	err := c.cc.Invoke(ctx, "/RonDBREST/Databases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ronDBRESTClient) Tables(ctx context.Context, in *TablesRequestProto, opts ...grpc.CallOption) (*TablesResponseProto, error) {
+	out := new(TablesResponseProto)
+	err := c.cc.Invoke(ctx, "/RonDBREST/Tables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ronDBRESTClient) FeatureVector(ctx context.Context, in *FeatureVectorRequestProto, opts ...grpc.CallOption) (*FeatureVectorResponseProto, error) {
+	out := new(FeatureVectorResponseProto)
+	err := c.cc.Invoke(ctx, "/RonDBREST/FeatureVector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RonDBRESTServer is the server API for RonDBREST service.
 // All implementations must embed UnimplementedRonDBRESTServer
 // for forward compatibility
 type RonDBRESTServer interface {
 	PKRead(context.Context, *PKReadRequestProto) (*PKReadResponseProto, error)
+	PKWrite(context.Context, *PKWriteRequestProto) (*PKWriteResponseProto, error)
+	PKDelete(context.Context, *PKDeleteRequestProto) (*PKDeleteResponseProto, error)
 	Batch(context.Context, *BatchRequestProto) (*BatchResponseProto, error)
+	BatchTx(context.Context, *BatchTxRequestProto) (*BatchResponseProto, error)
+	IndexScan(*IndexScanRequestProto, RonDBREST_IndexScanServer) error
+	TableScan(*TableScanRequestProto, RonDBREST_TableScanServer) error
 	Stat(context.Context, *StatRequestProto) (*StatResponseProto, error)
+	TableSchema(context.Context, *TableSchemaRequestProto) (*TableSchemaResponseProto, error)
+	Databases(context.Context, *DatabasesRequestProto) (*DatabasesResponseProto, error)
+	Tables(context.Context, *TablesRequestProto) (*TablesResponseProto, error)
+	FeatureVector(context.Context, *FeatureVectorRequestProto) (*FeatureVectorResponseProto, error)
 	mustEmbedUnimplementedRonDBRESTServer()
 }
 
@@ -79,12 +224,39 @@ type UnimplementedRonDBRESTServer struct {
 func (UnimplementedRonDBRESTServer) PKRead(context.Context, *PKReadRequestProto) (*PKReadResponseProto, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PKRead not implemented")
 }
+func (UnimplementedRonDBRESTServer) PKWrite(context.Context, *PKWriteRequestProto) (*PKWriteResponseProto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PKWrite not implemented")
+}
+func (UnimplementedRonDBRESTServer) PKDelete(context.Context, *PKDeleteRequestProto) (*PKDeleteResponseProto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PKDelete not implemented")
+}
 func (UnimplementedRonDBRESTServer) Batch(context.Context, *BatchRequestProto) (*BatchResponseProto, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
 }
+func (UnimplementedRonDBRESTServer) BatchTx(context.Context, *BatchTxRequestProto) (*BatchResponseProto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTx not implemented")
+}
+func (UnimplementedRonDBRESTServer) IndexScan(*IndexScanRequestProto, RonDBREST_IndexScanServer) error {
+	return status.Errorf(codes.Unimplemented, "method IndexScan not implemented")
+}
+func (UnimplementedRonDBRESTServer) TableScan(*TableScanRequestProto, RonDBREST_TableScanServer) error {
+	return status.Errorf(codes.Unimplemented, "method TableScan not implemented")
+}
 func (UnimplementedRonDBRESTServer) Stat(context.Context, *StatRequestProto) (*StatResponseProto, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stat not implemented")
 }
+func (UnimplementedRonDBRESTServer) TableSchema(context.Context, *TableSchemaRequestProto) (*TableSchemaResponseProto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TableSchema not implemented")
+}
+func (UnimplementedRonDBRESTServer) Databases(context.Context, *DatabasesRequestProto) (*DatabasesResponseProto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Databases not implemented")
+}
+func (UnimplementedRonDBRESTServer) Tables(context.Context, *TablesRequestProto) (*TablesResponseProto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tables not implemented")
+}
+func (UnimplementedRonDBRESTServer) FeatureVector(context.Context, *FeatureVectorRequestProto) (*FeatureVectorResponseProto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeatureVector not implemented")
+}
 func (UnimplementedRonDBRESTServer) mustEmbedUnimplementedRonDBRESTServer() {}
 
 // UnsafeRonDBRESTServer may be embedded to opt out of forward compatibility for this service.
@@ -116,6 +288,42 @@ func _RonDBREST_PKRead_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RonDBREST_PKWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PKWriteRequestProto)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RonDBRESTServer).PKWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RonDBREST/PKWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RonDBRESTServer).PKWrite(ctx, req.(*PKWriteRequestProto))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RonDBREST_PKDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PKDeleteRequestProto)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RonDBRESTServer).PKDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RonDBREST/PKDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RonDBRESTServer).PKDelete(ctx, req.(*PKDeleteRequestProto))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RonDBREST_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchRequestProto)
 	if err := dec(in); err != nil {
@@ -134,6 +342,66 @@ func _RonDBREST_Batch_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RonDBREST_BatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTxRequestProto)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RonDBRESTServer).BatchTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RonDBREST/BatchTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RonDBRESTServer).BatchTx(ctx, req.(*BatchTxRequestProto))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RonDBREST_IndexScan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IndexScanRequestProto)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RonDBRESTServer).IndexScan(m, &ronDBRESTIndexScanServer{stream})
+}
+
+type RonDBREST_IndexScanServer interface {
+	Send(*ScanResponseProto) error
+	grpc.ServerStream
+}
+
+type ronDBRESTIndexScanServer struct {
+	grpc.ServerStream
+}
+
+func (x *ronDBRESTIndexScanServer) Send(m *ScanResponseProto) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RonDBREST_TableScan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TableScanRequestProto)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RonDBRESTServer).TableScan(m, &ronDBRESTTableScanServer{stream})
+}
+
+type RonDBREST_TableScanServer interface {
+	Send(*ScanResponseProto) error
+	grpc.ServerStream
+}
+
+type ronDBRESTTableScanServer struct {
+	grpc.ServerStream
+}
+
+func (x *ronDBRESTTableScanServer) Send(m *ScanResponseProto) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _RonDBREST_Stat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StatRequestProto)
 	if err := dec(in); err != nil {
@@ -152,6 +420,78 @@ func _RonDBREST_Stat_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RonDBREST_TableSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TableSchemaRequestProto)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RonDBRESTServer).TableSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RonDBREST/TableSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RonDBRESTServer).TableSchema(ctx, req.(*TableSchemaRequestProto))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RonDBREST_Databases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatabasesRequestProto)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RonDBRESTServer).Databases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RonDBREST/Databases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RonDBRESTServer).Databases(ctx, req.(*DatabasesRequestProto))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RonDBREST_Tables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TablesRequestProto)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RonDBRESTServer).Tables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RonDBREST/Tables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RonDBRESTServer).Tables(ctx, req.(*TablesRequestProto))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RonDBREST_FeatureVector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeatureVectorRequestProto)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RonDBRESTServer).FeatureVector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RonDBREST/FeatureVector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RonDBRESTServer).FeatureVector(ctx, req.(*FeatureVectorRequestProto))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RonDBREST_ServiceDesc is the grpc.ServiceDesc for RonDBREST service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -163,15 +503,54 @@ var RonDBREST_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PKRead",
 			Handler:    _RonDBREST_PKRead_Handler,
 		},
+		{
+			MethodName: "PKWrite",
+			Handler:    _RonDBREST_PKWrite_Handler,
+		},
+		{
+			MethodName: "PKDelete",
+			Handler:    _RonDBREST_PKDelete_Handler,
+		},
 		{
 			MethodName: "Batch",
 			Handler:    _RonDBREST_Batch_Handler,
 		},
+		{
+			MethodName: "BatchTx",
+			Handler:    _RonDBREST_BatchTx_Handler,
+		},
 		{
 			MethodName: "Stat",
 			Handler:    _RonDBREST_Stat_Handler,
 		},
+		{
+			MethodName: "TableSchema",
+			Handler:    _RonDBREST_TableSchema_Handler,
+		},
+		{
+			MethodName: "Databases",
+			Handler:    _RonDBREST_Databases_Handler,
+		},
+		{
+			MethodName: "Tables",
+			Handler:    _RonDBREST_Tables_Handler,
+		},
+		{
+			MethodName: "FeatureVector",
+			Handler:    _RonDBREST_FeatureVector_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "IndexScan",
+			Handler:       _RonDBREST_IndexScan_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TableScan",
+			Handler:       _RonDBREST_TableScan_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/rdrs.proto",
 }
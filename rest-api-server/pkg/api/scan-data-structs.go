@@ -0,0 +1,482 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+const (
+	INDEX_SCAN_DEFAULT_LIMIT = 100
+	INDEX_SCAN_MAX_LIMIT     = 10000
+
+	TABLE_SCAN_DEFAULT_LIMIT    = 100
+	TABLE_SCAN_MAX_LIMIT        = 10000
+	TABLE_SCAN_MAX_FILTER_NODES = 4096
+
+	// maximum number of rows read from the database, or sent to the
+	// client, at a time by streamed scans
+	SCAN_STREAM_PAGE_SIZE = 1000
+)
+
+// Scan filter operators
+const (
+	FILTER_AND         = "and"
+	FILTER_OR          = "or"
+	FILTER_NOT         = "not"
+	FILTER_EQ          = "eq"
+	FILTER_NE          = "ne"
+	FILTER_LT          = "lt"
+	FILTER_LE          = "le"
+	FILTER_GT          = "gt"
+	FILTER_GE          = "ge"
+	FILTER_BETWEEN     = "between"
+	FILTER_LIKE        = "like"
+	FILTER_IS_NULL     = "is-null"
+	FILTER_IS_NOT_NULL = "is-not-null"
+)
+
+// Request
+type IndexScanParams struct {
+	DB          *string       `json:"db"`
+	Table       *string       `json:"table"`
+	Index       *string       `json:"index"`
+	LowerBound  *IndexBound   `json:"lowerBound"`
+	UpperBound  *IndexBound   `json:"upperBound"`
+	Descending  *bool         `json:"descending"`
+	Limit       *uint32       `json:"limit"`
+	ReadColumns *[]ReadColumn `json:"readColumns"`
+	OperationID *string       `json:"operationId"`
+}
+
+type IndexScanBody struct {
+	Index       *string       `json:"index"          form:"index"           binding:"required,min=1,max=64"`
+	LowerBound  *IndexBound   `json:"lowerBound"     form:"lower-bound"     binding:"omitempty"`
+	UpperBound  *IndexBound   `json:"upperBound"     form:"upper-bound"     binding:"omitempty"`
+	Descending  *bool         `json:"descending"     form:"descending"      binding:"omitempty"`
+	Limit       *uint32       `json:"limit"          form:"limit"           binding:"omitempty,min=1,max=10000"`
+	ReadColumns *[]ReadColumn `json:"readColumns"    form:"read-columns"    binding:"omitempty,min=1,max=4096,unique"`
+	OperationID *string       `json:"operationId"    form:"operation-id"    binding:"omitempty,min=1,max=64"`
+}
+
+// IndexBound is the lower or upper bound of an index scan.
+// The bound columns must be a prefix of the index columns
+type IndexBound struct {
+	Columns *[]Filter `json:"columns"      form:"columns"      binding:"required,min=1,max=4096,dive"`
+
+	// bounds are inclusive by default
+	Inclusive *bool `json:"inclusive"    form:"inclusive"    binding:"omitempty"`
+}
+
+func (b *IndexBound) IsInclusive() bool {
+	return b.Inclusive == nil || *b.Inclusive
+}
+
+type TableScanParams struct {
+	DB                *string       `json:"db"`
+	Table             *string       `json:"table"`
+	Filter            *FilterExpr   `json:"filter"`
+	Limit             *uint32       `json:"limit"`
+	ContinuationToken *string       `json:"continuationToken"`
+	ReadColumns       *[]ReadColumn `json:"readColumns"`
+	OperationID       *string       `json:"operationId"`
+}
+
+type TableScanBody struct {
+	Filter            *FilterExpr   `json:"filter"              form:"filter"              binding:"omitempty"`
+	Limit             *uint32       `json:"limit"               form:"limit"               binding:"omitempty,min=1,max=10000"`
+	ContinuationToken *string       `json:"continuationToken"   form:"continuation-token"  binding:"omitempty,min=1"`
+	ReadColumns       *[]ReadColumn `json:"readColumns"         form:"read-columns"        binding:"omitempty,min=1,max=4096,unique"`
+	OperationID       *string       `json:"operationId"         form:"operation-id"        binding:"omitempty,min=1,max=64"`
+}
+
+// FilterExpr is a node of a scan filter expression tree. Logical operators
+// (and, or, not) have operands. Comparisons have a column and a value that
+// is encoded the same way as the pk-read filter values. The between operator
+// uses the lower and upper values, and is-null/is-not-null do not have a value
+type FilterExpr struct {
+	Op       *string          `json:"op"          form:"op"          binding:"required"`
+	Column   *string          `json:"column"      form:"column"      binding:"omitempty,min=1,max=64"`
+	Value    *json.RawMessage `json:"value"       form:"value"       binding:"omitempty"`
+	Lower    *json.RawMessage `json:"lower"       form:"lower"       binding:"omitempty"`
+	Upper    *json.RawMessage `json:"upper"       form:"upper"       binding:"omitempty"`
+	Operands *[]FilterExpr    `json:"operands"    form:"operands"    binding:"omitempty,dive"`
+}
+
+// Response
+type ScanResponse interface {
+	Init()
+	SetOperationID(opID *string)
+	AddRow()
+	SetColumnData(column, value *string, valueType uint32)
+	SetContinuationToken(token *string)
+}
+
+type ScanResponseJSON struct {
+	OperationID       *string                        `json:"operationId"                 form:"operation-id"          binding:"omitempty,min=1,max=64"`
+	Rows              *[]map[string]*json.RawMessage `json:"rows"                        form:"rows"                  binding:"omitempty"`
+	ContinuationToken *string                        `json:"continuationToken,omitempty" form:"continuation-token"    binding:"omitempty"`
+
+	// set by ScanResponseJSONWriter if the scan failed after the first rows were sent
+	Code  *int    `json:"code,omitempty"     form:"code"     binding:"omitempty"`
+	Error *string `json:"error,omitempty"    form:"error"    binding:"omitempty"`
+}
+
+func (r *ScanResponseJSON) Init() {
+	rows := make([]map[string]*json.RawMessage, 0)
+	r.Rows = &rows
+}
+
+func (r *ScanResponseJSON) SetOperationID(opID *string) {
+	r.OperationID = opID
+}
+
+func (r *ScanResponseJSON) AddRow() {
+	*r.Rows = append(*r.Rows, make(map[string]*json.RawMessage))
+}
+
+// SetColumnData sets the column data of the last row
+func (r *ScanResponseJSON) SetColumnData(column, value *string, dataType uint32) {
+	(*r.Rows)[len(*r.Rows)-1][*column] = jsonColumnValue(value, dataType)
+}
+
+func (r *ScanResponseJSON) SetContinuationToken(token *string) {
+	r.ContinuationToken = token
+}
+
+var _ ScanResponse = (*ScanResponseJSON)(nil)
+
+// ScanResponseJSONWriter writes each row to the client as soon as it is
+// complete instead of keeping the whole response in memory. The output
+// has the same format as ScanResponseJSON
+type ScanResponseJSONWriter struct {
+	w       io.Writer
+	opID    *string
+	token   *string
+	row     map[string]*json.RawMessage
+	rows    int
+	started bool
+	err     error
+}
+
+func NewScanResponseJSONWriter(w io.Writer) *ScanResponseJSONWriter {
+	return &ScanResponseJSONWriter{w: w}
+}
+
+func (r *ScanResponseJSONWriter) Init() {
+	r.row = nil
+	r.rows = 0
+}
+
+func (r *ScanResponseJSONWriter) SetOperationID(opID *string) {
+	r.opID = opID
+}
+
+func (r *ScanResponseJSONWriter) AddRow() {
+	r.writeRow()
+	r.row = make(map[string]*json.RawMessage)
+}
+
+func (r *ScanResponseJSONWriter) SetColumnData(column, value *string, dataType uint32) {
+	r.row[*column] = jsonColumnValue(value, dataType)
+}
+
+func (r *ScanResponseJSONWriter) SetContinuationToken(token *string) {
+	r.token = token
+}
+
+// Close writes the last row and the end of the response
+func (r *ScanResponseJSONWriter) Close() error {
+	r.writeRow()
+	r.start()
+	r.write([]byte("]"))
+	if r.token != nil {
+		r.write([]byte(`,"continuationToken":`))
+		r.writeJSON(r.token)
+	}
+	r.write([]byte("}"))
+	return r.err
+}
+
+// Written returns true if a part of the response was already sent. The
+// status code can not be changed after that
+func (r *ScanResponseJSONWriter) Written() bool {
+	return r.started
+}
+
+// CloseWithError ends a response that failed after it was partly sent. The
+// rows that were sent are followed by the code and the error, and the
+// incomplete row is dropped
+func (r *ScanResponseJSONWriter) CloseWithError(code int, err error) error {
+	msg := err.Error()
+	r.row = nil
+	r.start()
+	r.write([]byte(`],"code":`))
+	r.writeJSON(code)
+	r.write([]byte(`,"error":`))
+	r.writeJSON(msg)
+	r.write([]byte("}"))
+	return r.err
+}
+
+func (r *ScanResponseJSONWriter) start() {
+	if r.started {
+		return
+	}
+	r.started = true
+	r.write([]byte(`{"operationId":`))
+	r.writeJSON(r.opID)
+	r.write([]byte(`,"rows":[`))
+}
+
+func (r *ScanResponseJSONWriter) writeRow() {
+	if r.row == nil {
+		return
+	}
+	r.start()
+	if r.rows > 0 {
+		r.write([]byte(","))
+	}
+	r.writeJSON(r.row)
+	r.row = nil
+	r.rows++
+}
+
+func (r *ScanResponseJSONWriter) writeJSON(v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		if r.err == nil {
+			r.err = err
+		}
+		return
+	}
+	r.write(b)
+}
+
+// the first error is kept and the following writes are skipped
+func (r *ScanResponseJSONWriter) write(b []byte) {
+	if r.err != nil {
+		return
+	}
+	_, r.err = r.w.Write(b)
+}
+
+var _ ScanResponse = (*ScanResponseJSONWriter)(nil)
+
+// ScanStream is a scan response that is sent to the client in parts.
+// Flush sends the rows added so far. It blocks while the client is not
+// ready to receive more data, which limits the rows kept in memory
+type ScanStream interface {
+	ScanResponse
+	Flush() error
+}
+
+// ScanStreamTrailerJSON is the last line of a NDJSON scan response. Error is
+// set if the scan failed after the first rows were sent
+type ScanStreamTrailerJSON struct {
+	OperationID       *string `json:"operationId,omitempty"`
+	RowCount          *int    `json:"rowCount,omitempty"`
+	ContinuationToken *string `json:"continuationToken,omitempty"`
+	Code              *int    `json:"code,omitempty"`
+	Error             *string `json:"error,omitempty"`
+}
+
+// ScanStreamRowJSON is a row line of a NDJSON scan response
+type ScanStreamRowJSON struct {
+	Row *map[string]*json.RawMessage `json:"row"`
+}
+
+// ScanResponseNDJSONWriter writes the rows as newline delimited JSON,
+// one row per line, followed by a ScanStreamTrailerJSON line
+type ScanResponseNDJSONWriter struct {
+	w       io.Writer
+	opID    *string
+	token   *string
+	row     map[string]*json.RawMessage
+	rows    int
+	written bool
+	err     error
+}
+
+func NewScanResponseNDJSONWriter(w io.Writer) *ScanResponseNDJSONWriter {
+	return &ScanResponseNDJSONWriter{w: w}
+}
+
+func (r *ScanResponseNDJSONWriter) Init() {
+	r.row = nil
+	r.rows = 0
+}
+
+func (r *ScanResponseNDJSONWriter) SetOperationID(opID *string) {
+	r.opID = opID
+}
+
+func (r *ScanResponseNDJSONWriter) AddRow() {
+	r.writeRow()
+	r.row = make(map[string]*json.RawMessage)
+}
+
+func (r *ScanResponseNDJSONWriter) SetColumnData(column, value *string, dataType uint32) {
+	r.row[*column] = jsonColumnValue(value, dataType)
+}
+
+func (r *ScanResponseNDJSONWriter) SetContinuationToken(token *string) {
+	r.token = token
+}
+
+func (r *ScanResponseNDJSONWriter) Flush() error {
+	r.writeRow()
+	if f, ok := r.w.(http.Flusher); ok && r.err == nil {
+		f.Flush()
+	}
+	return r.err
+}
+
+// Written returns true if a part of the response was already sent. The
+// status code can not be changed after that
+func (r *ScanResponseNDJSONWriter) Written() bool {
+	return r.written
+}
+
+// Close writes the last row and the trailer
+func (r *ScanResponseNDJSONWriter) Close() error {
+	r.writeRow()
+	rows := r.rows
+	r.writeLine(&ScanStreamTrailerJSON{OperationID: r.opID, RowCount: &rows,
+		ContinuationToken: r.token})
+	return r.Flush()
+}
+
+// CloseWithError ends a response that failed after it was partly sent
+func (r *ScanResponseNDJSONWriter) CloseWithError(code int, err error) error {
+	msg := err.Error()
+	r.row = nil
+	r.writeLine(&ScanStreamTrailerJSON{OperationID: r.opID, Code: &code, Error: &msg})
+	return r.Flush()
+}
+
+func (r *ScanResponseNDJSONWriter) writeRow() {
+	if r.row == nil {
+		return
+	}
+	r.writeLine(&ScanStreamRowJSON{Row: &r.row})
+	r.row = nil
+	r.rows++
+}
+
+func (r *ScanResponseNDJSONWriter) writeLine(v interface{}) {
+	if r.err != nil {
+		return
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		r.err = err
+		return
+	}
+	r.written = true
+	_, r.err = r.w.Write(append(b, '\n'))
+}
+
+var _ ScanStream = (*ScanResponseNDJSONWriter)(nil)
+
+// ScanResponseGRPCStream sends the rows as a stream of ScanResponseProto
+// messages. Rows are sent when Flush is called, or when a message has
+// SCAN_STREAM_PAGE_SIZE rows. send blocks while the gRPC flow control
+// window of the client is full
+type ScanResponseGRPCStream struct {
+	send  func(*ScanResponseProto) error
+	opID  *string
+	token *string
+	rows  []*ScanRowProto
+	sent  bool
+	err   error
+}
+
+func NewScanResponseGRPCStream(send func(*ScanResponseProto) error) *ScanResponseGRPCStream {
+	return &ScanResponseGRPCStream{send: send}
+}
+
+func (r *ScanResponseGRPCStream) Init() {
+	r.rows = nil
+}
+
+func (r *ScanResponseGRPCStream) SetOperationID(opID *string) {
+	r.opID = opID
+}
+
+func (r *ScanResponseGRPCStream) AddRow() {
+	if len(r.rows) >= SCAN_STREAM_PAGE_SIZE {
+		r.Flush()
+	}
+	r.rows = append(r.rows, &ScanRowProto{Data: make(map[string]*ColumnValueProto)})
+}
+
+func (r *ScanResponseGRPCStream) SetColumnData(column, value *string, dataType uint32) {
+	r.rows[len(r.rows)-1].Data[*column] = NewColumnValueProto(value, dataType)
+}
+
+func (r *ScanResponseGRPCStream) SetContinuationToken(token *string) {
+	r.token = token
+}
+
+func (r *ScanResponseGRPCStream) Flush() error {
+	if len(r.rows) > 0 {
+		r.sendMsg(&ScanResponseProto{Rows: r.rows})
+		r.rows = nil
+	}
+	return r.err
+}
+
+// Close sends the remaining rows and the continuation token. A message is
+// always sent so that the client gets the operation ID of empty scans
+func (r *ScanResponseGRPCStream) Close() error {
+	if !r.sent || len(r.rows) > 0 || r.token != nil {
+		r.sendMsg(&ScanResponseProto{Rows: r.rows, ContinuationToken: r.token})
+		r.rows = nil
+	}
+	return r.err
+}
+
+func (r *ScanResponseGRPCStream) sendMsg(msg *ScanResponseProto) {
+	if r.err != nil {
+		return
+	}
+	if !r.sent {
+		msg.OperationID = r.opID
+	}
+	r.sent = true
+	r.err = r.send(msg)
+}
+
+var _ ScanStream = (*ScanResponseGRPCStream)(nil)
+
+// ScanResponseGRPC is a scan response received over gRPC
+type ScanResponseGRPC struct {
+	OperationID       *string
+	Rows              *[]map[string]*ColumnValueProto
+	ContinuationToken *string
+}
+
+// ContinuationKey collects the key columns of the row where a paged
+// scan continues. The values use the same format as the filter values
+type ContinuationKey []Filter
+
+func (k *ContinuationKey) SetColumnData(column, value *string, dataType uint32) {
+	*k = append(*k, Filter{Column: column, Value: jsonColumnValue(value, dataType)})
+}
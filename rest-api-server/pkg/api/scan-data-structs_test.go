@@ -0,0 +1,64 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// a scan that fails after the first rows were sent must still
+// return valid JSON, with the error after the rows
+func TestScanResponseJSONWriterCloseWithError(t *testing.T) {
+	var out bytes.Buffer
+	writer := NewScanResponseJSONWriter(&out)
+	writer.Init()
+
+	if writer.Written() {
+		t.Fatalf("Nothing was written yet")
+	}
+
+	opID := "op1"
+	writer.SetOperationID(&opID)
+	col := "name"
+	for _, value := range []string{"a", "b", "c"} {
+		v := value
+		writer.AddRow()
+		writer.SetColumnData(&col, &v, 0)
+	}
+
+	if !writer.Written() {
+		t.Fatalf("The first rows were written")
+	}
+	if err := writer.CloseWithError(http.StatusInternalServerError, fmt.Errorf("failed")); err != nil {
+		t.Fatalf("Failed to close the response. Error: %v", err)
+	}
+
+	var resp ScanResponseJSON
+	if err := json.Unmarshal(out.Bytes(), &resp); err != nil {
+		t.Fatalf("Response is not valid JSON. Error: %v. Response: %s", err, out.String())
+	}
+	// the last row may be incomplete and is dropped
+	if len(*resp.Rows) != 2 || resp.Code == nil || *resp.Code != http.StatusInternalServerError ||
+		resp.Error == nil || *resp.Error != "failed" || resp.ContinuationToken != nil {
+		t.Fatalf("Unexpected response: %s", out.String())
+	}
+}
@@ -0,0 +1,45 @@
+/*
+ * This file is part of the RonDB REST API Server
+ * Copyright (c) 2022 Hopsworks AB
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, version 3.
+ *
+ * This program is distributed in the hope that it will be useful, but
+ * WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+ * General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program. If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import "hopsworks.ai/rdrs/internal/dal"
+
+type TableSchemaParams struct {
+	DB    *string `json:"db"`
+	Table *string `json:"table"`
+}
+
+type TableSchemaResponse struct {
+	DB      string             `json:"db"`
+	Table   string             `json:"table"`
+	Columns []dal.ColumnSchema `json:"columns"`
+	Indexes []dal.IndexSchema  `json:"indexes"`
+}
+
+type DatabasesResponse struct {
+	Databases []string `json:"databases"`
+}
+
+type TablesParams struct {
+	DB *string `json:"db" uri:"db" binding:"required,min=1,max=64"`
+}
+
+type TablesResponse struct {
+	DB     string   `json:"db"`
+	Tables []string `json:"tables"`
+}
@@ -16,12 +16,17 @@
  */
 package api
 
-import "hopsworks.ai/rdrs/internal/dal"
+import (
+	"hopsworks.ai/rdrs/internal/dal"
+	"hopsworks.ai/rdrs/internal/metrics"
+)
 
 type StatRequest struct {
 }
 
 type StatResponse struct {
-	MemoryStats dal.MemoryStats
-	RonDBStats  dal.RonDBStats
+	MemoryStats     dal.MemoryStats
+	RonDBStats      dal.RonDBStats
+	DBStats         []metrics.DBStats
+	ConnectionStats []dal.ConnectionStats
 }